@@ -137,9 +137,10 @@ type AlluxioFuseSpec struct {
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
 	// CleanPolicy decides when to clean Alluxio Fuse pods.
-	// Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted
+	// Currently Fluid supports three policies: OnDemand, OnRuntimeDeleted and OnFuseChanged
 	// OnDemand cleans fuse pod once the fuse pod on some node is not needed
 	// OnRuntimeDeleted cleans fuse pod only when the cache runtime is deleted
+	// OnFuseChanged cleans fuse pod once the fuse pod on some node is not needed and the fuse in runtime is upgraded
 	// Defaults to OnRuntimeDeleted
 	// +optional
 	CleanPolicy FuseCleanPolicy `json:"cleanPolicy,omitempty"`
@@ -237,6 +238,14 @@ type AlluxioRuntimeSpec struct {
 	// +optional
 	RuntimeManagement RuntimeManagement `json:"management,omitempty"`
 
+	// ScaleInPolicy defines policies when scaling in the workers
+	// +optional
+	ScaleInPolicy ScaleInPolicy `json:"scaleInPolicy,omitempty"`
+
+	// UpgradePolicy defines policies when upgrading the runtime to a new version
+	// +optional
+	UpgradePolicy UpgradePolicy `json:"upgradePolicy,omitempty"`
+
 	// ImagePullSecrets that will be used to pull images
 	// +optional
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
@@ -58,6 +58,10 @@ type CacheRuntimeStatus struct {
 	// MountTime is the timestamp of the last successful mount operation.
 	// If MountTime is earlier than the master component's start time, a remount will be required.
 	MountTime *metav1.Time `json:"mountTime,omitempty"`
+
+	// RuntimeClassGeneration is the generation of the CacheRuntimeClass which the components are rendered from.
+	// +optional
+	RuntimeClassGeneration int64 `json:"runtimeClassGeneration,omitempty"`
 }
 
 // RuntimeComponentStatusCollection describes the status of all runtime components.
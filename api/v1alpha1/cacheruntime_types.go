@@ -133,6 +133,18 @@ type CacheRuntimeClientSpec struct {
 	CleanPolicy FuseCleanPolicy `json:"cleanPolicy,omitempty"`
 }
 
+// RuntimeClassUpdatePolicy is the policy to re-render the runtime components when the CacheRuntimeClass changes
+type RuntimeClassUpdatePolicy string
+
+const (
+	// ManualRuntimeClassUpdatePolicy re-renders the runtime components only when the runtime is explicitly bumped
+	// to the current generation of the CacheRuntimeClass with the annotation `cacheruntime.fluid.io/runtime-class-generation`.
+	ManualRuntimeClassUpdatePolicy RuntimeClassUpdatePolicy = "Manual"
+
+	// AutoRuntimeClassUpdatePolicy re-renders the runtime components once the CacheRuntimeClass changes
+	AutoRuntimeClassUpdatePolicy RuntimeClassUpdatePolicy = "Auto"
+)
+
 // CacheRuntimeSpec describes the desired state of CacheRuntime
 type CacheRuntimeSpec struct {
 	// RuntimeClassName is the name of the CacheRuntimeClass to use for this runtime.
@@ -140,6 +152,16 @@ type CacheRuntimeSpec struct {
 	// +kubebuilder:validation:Required
 	RuntimeClassName string `json:"runtimeClassName"`
 
+	// UpdatePolicy is the policy to re-render the master and worker components when the CacheRuntimeClass changes.
+	// Manual (default) keeps the components rendered from the recorded generation of the CacheRuntimeClass until the
+	// runtime is explicitly bumped, and Auto re-renders them once the CacheRuntimeClass changes. It covers the pod
+	// templates of the master and worker and the ConfigMaps in ExtraResources not mounted by the client. The client is
+	// never re-rendered, and ExecutionEntries and DataOperationSpecs always follow the current CacheRuntimeClass.
+	// +kubebuilder:validation:Enum=Manual;Auto
+	// +kubebuilder:default=Manual
+	// +optional
+	UpdatePolicy RuntimeClassUpdatePolicy `json:"updatePolicy,omitempty"`
+
 	// Master is the desired state of the master component.
 	// +optional
 	Master CacheRuntimeMasterSpec `json:"master,omitempty"`
@@ -179,6 +201,7 @@ type CacheRuntimeSpec struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.worker.replicas,statuspath=.status.worker.currentReplicas,selectorpath=.status.selector
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",priority=0
 // +kubebuilder:resource:scope=Namespaced
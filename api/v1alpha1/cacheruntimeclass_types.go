@@ -66,6 +66,22 @@ type ExecutionEntries struct {
 	// MountUFS defines the operations for mounting UFS. The command's stdout must be JSON matching CacheRuntimeMountUfsOutput.
 	MountUFS *ExecutionCommonEntry `json:"mountUFS,omitempty"`
 
+	// UnmountUFS defines the operations for unmounting UFS. The ufs paths to unmount are appended to the command as
+	// arguments. The command's stdout must be JSON matching CacheRuntimeUnmountUfsOutput.
+	UnmountUFS *ExecutionCommonEntry `json:"unmountUFS,omitempty"`
+
+	// SyncMetadata defines the operations for loading the metadata of UFS into the cache system.
+	// The command's stdout must be JSON matching CacheRuntimeSyncMetadataOutput.
+	SyncMetadata *ExecutionCommonEntry `json:"syncMetadata,omitempty"`
+
+	// HealthCheck defines the operations for checking whether the cache system is healthy.
+	// The command's stdout must be JSON matching CacheRuntimeHealthCheckOutput.
+	HealthCheck *ExecutionCommonEntry `json:"healthCheck,omitempty"`
+
+	// ReportCapacity defines the operations for getting the used and total cache capacity.
+	// The command's stdout must be JSON matching CacheRuntimeReportCapacityOutput.
+	ReportCapacity *ExecutionCommonEntry `json:"reportCapacity,omitempty"`
+
 	// ReportSummary it defines the operation how to get cache status like capacity, hit ratio etc.
 	ReportSummary *ExecutionCommonEntry `json:"reportSummary,omitempty"`
 }
@@ -194,7 +210,8 @@ type DataOperationSpec struct {
 	// +optional
 	Image string `json:"image,omitempty"`
 
-	// Command for data operation Pod container
+	// Command for data operation Pod container.
+	// For DataBackup, the command should write the backup files into the directory $FLUID_DATABACKUP_TARGET_DIR.
 	Command []string `json:"command,omitempty"`
 
 	// Args for data operation Pod container
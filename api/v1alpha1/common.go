@@ -20,6 +20,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 )
@@ -96,6 +97,44 @@ type RuntimeManagement struct {
 	// MetadataSyncPolicy defines the policy of syncing metadata when setting up the runtime. If not set,
 	// +optional
 	MetadataSyncPolicy MetadataSyncPolicy `json:"metadataSyncPolicy,omitempty"`
+
+	// HibernationPolicy defines the policy of hibernating the runtime when its dataset is idle. If not set,
+	// the runtime never hibernates.
+	// +optional
+	HibernationPolicy *HibernationPolicy `json:"hibernationPolicy,omitempty"`
+
+	// MetadataRestorePolicy defines the policy of restoring the metadata of the master when it's lost. If not set,
+	// the metadata is never restored automatically. Only AlluxioRuntime supports it for now, JuiceFSRuntime and
+	// ThinRuntime fail in validation if it's set.
+	// +optional
+	MetadataRestorePolicy *MetadataRestorePolicy `json:"metadataRestorePolicy,omitempty"`
+}
+
+// HibernationPolicy defines the policy of scaling the runtime to zero when no pod mounts its dataset, the runtime is
+// woken up automatically when a new pod mounting the dataset is created.
+type HibernationPolicy struct {
+	// IdleMinutes is the number of minutes without any pod mounting the dataset before the runtime hibernates
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	IdleMinutes int32 `json:"idleMinutes"`
+
+	// HibernateMaster scales the masters to zero as well when the runtime hibernates, which frees more resources
+	// but takes longer to wake up. Defaults to false.
+	// +optional
+	HibernateMaster bool `json:"hibernateMaster,omitempty"`
+}
+
+// MetadataRestorePolicy defines the policy of restoring the metadata of the master from the latest completed DataBackup
+// of the dataset when the master restarts with an empty journal.
+type MetadataRestorePolicy struct {
+	// AutoRestore enables restoring the metadata automatically once all the master pods are recreated and the
+	// journal is lost. Defaults to false.
+	// +optional
+	AutoRestore bool `json:"autoRestore,omitempty"`
+}
+
+func (mrp *MetadataRestorePolicy) AutoRestoreEnabled() bool {
+	return mrp != nil && mrp.AutoRestore
 }
 
 // InitUsersSpec is a description of the initialize the users for runtime
@@ -190,6 +229,60 @@ type CleanCachePolicy struct {
 	MaxRetryAttempts *int32 `json:"maxRetryAttempts,omitempty"`
 }
 
+// ScaleInPolicy defines policies when scaling in the workers
+type ScaleInPolicy struct {
+	// Graceful enables draining the workers to be removed before shrinking the workers. The workers are
+	// decommissioned by the cache engine so that their cached blocks are not read by the clients any more.
+	// The cached blocks are not copied to the remaining workers, they're dropped with the removed workers
+	// and read from the under file system again.
+	// +optional
+	Graceful bool `json:"graceful,omitempty"`
+
+	// Optional duration in seconds to wait for draining the workers. The workers are removed after the timeout
+	// even if draining is not finished. If this value is nil, 300 seconds will be used.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DrainTimeoutSeconds *int32 `json:"drainTimeoutSeconds,omitempty"`
+}
+
+// UpgradePolicy defines policies when upgrading the runtime. The master is upgraded first, then the workers
+// are upgraded in batches, and the fuse is upgraded at last.
+type UpgradePolicy struct {
+	// WorkerBatchSize is the max number of the workers restarted at the same time when upgrading the workers.
+	// If this value is nil, 1 will be used.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WorkerBatchSize *int32 `json:"workerBatchSize,omitempty"`
+}
+
+// FuseUpgradeStrategyType is the way to upgrade the fuse pods
+type FuseUpgradeStrategyType string
+
+const (
+	// RecreateFuseUpgradeStrategyType recreates the outdated fuse pod on a node once it's not used by any pod
+	RecreateFuseUpgradeStrategyType FuseUpgradeStrategyType = "Recreate"
+
+	// HotUpgradeFuseUpgradeStrategyType starts a new fuse pod beside the outdated one on each node, and the new fuse
+	// process takes over the fuse session from the old one, so the application pods keep their mount points.
+	HotUpgradeFuseUpgradeStrategyType FuseUpgradeStrategyType = "HotUpgrade"
+)
+
+// FuseUpgradeStrategy defines how the fuse pods are upgraded when the fuse is changed
+type FuseUpgradeStrategy struct {
+	// Type of the fuse upgrade, can be "Recreate" or "HotUpgrade". Defaults to Recreate.
+	// In HotUpgrade, the running fuse process hands off the /dev/fuse session to the new one through the unix socket
+	// given by the FLUID_FUSE_HANDOFF_SOCKET env, the fuse process must support it.
+	// +kubebuilder:validation:Enum=Recreate;HotUpgrade
+	// +optional
+	Type FuseUpgradeStrategyType `json:"type,omitempty"`
+
+	// MaxSurge is the max number of nodes on which the new fuse pods are started beside the old ones at the same
+	// time in HotUpgrade. Value can be an absolute number (ex: 5) or a percentage of the nodes (ex: 10%).
+	// If this value is nil, 1 will be used.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
 // MetadataSyncPolicy defines policies when syncing metadata
 type MetadataSyncPolicy struct {
 	// AutoSync enables automatic metadata sync when setting up a runtime. If not set, it defaults to true.
@@ -285,7 +378,7 @@ type ObjectRef struct {
 
 	// Kind specifies the type of the referent operation
 	// +required
-	// +kubebuilder:validation:Enum=DataLoad;DataBackup;DataMigrate;DataProcess
+	// +kubebuilder:validation:Enum=DataLoad;DataBackup;DataMigrate;DataProcess;DataEvict
 	Kind string `json:"kind"`
 
 	// Name specifies the name of the referent operation
@@ -324,6 +417,42 @@ type CacheRuntimeMountUfsOutput struct {
 	Mounted []string `json:"mounted,omitempty"`
 }
 
+// CacheRuntimeUnmountUfsOutput defines the return structure for UnmountUFS execution entry.
+type CacheRuntimeUnmountUfsOutput struct {
+	// Unmounted are the ufs paths that have been unmounted.
+	Unmounted []string `json:"unmounted,omitempty"`
+}
+
+// CacheRuntimeSyncMetadataOutput defines the return structure for SyncMetadata execution entry.
+type CacheRuntimeSyncMetadataOutput struct {
+	// FileNum represents the file numbers of the dataset
+	// +optional
+	FileNum string `json:"fileNum,omitempty"`
+
+	// UfsTotal is the total size of the dataset in the cluster, e.g. "10.00GiB".
+	// +optional
+	UfsTotal string `json:"ufsTotal,omitempty"`
+}
+
+// CacheRuntimeHealthCheckOutput defines the return structure for HealthCheck execution entry.
+type CacheRuntimeHealthCheckOutput struct {
+	// Healthy is true if the cache system is healthy.
+	Healthy bool `json:"healthy"`
+
+	// Message describes why the cache system is not healthy.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CacheRuntimeReportCapacityOutput defines the return structure for ReportCapacity execution entry.
+type CacheRuntimeReportCapacityOutput struct {
+	// UsedBytes is the used cache capacity, in bytes.
+	UsedBytes int64 `json:"usedBytes"`
+
+	// CapacityBytes is the total cache capacity, in bytes.
+	CapacityBytes int64 `json:"capacityBytes"`
+}
+
 // CacheRuntimeReportSummary defines the return structure for ReportSummary execution entry.
 // It contains cache status information such as capacity, hit ratio, and cached data size.
 type CacheRuntimeReportSummary struct {
@@ -37,6 +37,23 @@ type DataBackupSpec struct {
 	// TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// BackoffLimit specifies the number of retries before marking the data operation as failed, each retry
+	// re-creates the backup pod. Defaults to 0, which means no retry.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// RetryBackoff specifies the delay before retrying a failed data operation, which is doubled for each retry
+	// and capped at 10 minutes, e.g. 30s. Defaults to 10s.
+	// +optional
+	RetryBackoff *metav1.Duration `json:"retryBackoff,omitempty"`
+
+	// ActiveDeadlineSeconds specifies the duration in seconds relative to the time the data operation starts executing,
+	// retries included, before it's terminated and marked as failed.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
 }
 
 // +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset`
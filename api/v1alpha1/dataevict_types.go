@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EvictTargetPath defines the target path of the DataEvict
+type EvictTargetPath struct {
+	// Path defines path whose cache will be evicted
+	Path string `json:"path"`
+}
+
+// DataEvictSpec defines the desired state of DataEvict
+type DataEvictSpec struct {
+	// Dataset defines the target dataset of the DataEvict
+	Dataset TargetDataset `json:"dataset,omitempty"`
+
+	// Target defines target paths whose cache needs to be evicted, the whole cache of the dataset is evicted if not set
+	// +optional
+	Target []EvictTargetPath `json:"target,omitempty"`
+
+	//+kubebuilder:default:=Once
+	//+kubebuilder:validation:Enum=Once;Cron
+	// including Once, Cron
+	// +optional
+	Policy Policy `json:"policy,omitempty"`
+
+	// The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Specifies that the preceding operation in a workflow
+	// +optional
+	RunAfter *OperationRef `json:"runAfter,omitempty"`
+
+	// TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset.name`
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Evicted",type="string",JSONPath=`.status.infos.EvictedBytes`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=evict
+// +genclient
+
+// DataEvict is the Schema for the dataevicts API
+type DataEvict struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataEvictSpec   `json:"spec,omitempty"`
+	Status OperationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// DataEvictList contains a list of DataEvict
+type DataEvictList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataEvict `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataEvict{}, &DataEvictList{})
+}
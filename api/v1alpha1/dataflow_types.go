@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// DataFlowFailurePolicy specifies what the DataFlow does when one of its steps fails
+type DataFlowFailurePolicy string
+
+const (
+	// StopOnFailure stops starting new steps once a step fails, the running steps are not affected
+	StopOnFailure DataFlowFailurePolicy = "StopOnFailure"
+
+	// ContinueOnFailure keeps starting the steps that don't depend on the failed steps
+	ContinueOnFailure DataFlowFailurePolicy = "ContinueOnFailure"
+)
+
+// DataFlowAffinityStrategy defines the pod affinity strategy of a step with one of its dependencies
+type DataFlowAffinityStrategy struct {
+	// DependOn specifies the name of the dependency step whose data operation pods the affinity is generated with.
+	// Defaults to the first dependency of the step.
+	// +optional
+	DependOn string `json:"dependOn,omitempty"`
+
+	// Policy one of: "", "Require", "Prefer"
+	// +optional
+	Policy AffinityPolicy `json:"policy,omitempty"`
+
+	Prefers  []Prefer  `json:"prefers,omitempty"`
+	Requires []Require `json:"requires,omitempty"`
+}
+
+// DataFlowStep defines a step of the DataFlow, which creates a data operation from one of the templates
+type DataFlowStep struct {
+	// Name is the name of the step, which is unique in the DataFlow. The data operation created for the step
+	// is named as <dataflow name>-<step name>.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// Dependencies are the names of the steps that must complete before the step starts
+	// +optional
+	Dependencies []string `json:"dependencies,omitempty"`
+
+	// AffinityStrategy specifies the pod affinity strategy with the data operation of a dependency step
+	// +optional
+	AffinityStrategy *DataFlowAffinityStrategy `json:"affinityStrategy,omitempty"`
+
+	// DataLoad is the template of the DataLoad created for the step
+	// +optional
+	DataLoad *DataLoadSpec `json:"dataLoad,omitempty"`
+
+	// DataProcess is the template of the DataProcess created for the step
+	// +optional
+	DataProcess *DataProcessSpec `json:"dataProcess,omitempty"`
+
+	// DataMigrate is the template of the DataMigrate created for the step
+	// +optional
+	DataMigrate *DataMigrateSpec `json:"dataMigrate,omitempty"`
+}
+
+// DataFlowSpec defines the desired state of DataFlow
+type DataFlowSpec struct {
+	// Steps are the steps of the DataFlow, which form a directed acyclic graph by their dependencies.
+	// Exactly one template of DataLoad, DataProcess and DataMigrate must be set in each step. The template must use
+	// the Once policy, and can't set runAfter or ttlSecondsAfterFinished.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Steps []DataFlowStep `json:"steps"`
+
+	// FailurePolicy specifies what the DataFlow does when one of its steps fails, one of StopOnFailure and ContinueOnFailure.
+	// Defaults to StopOnFailure.
+	// +kubebuilder:default:=StopOnFailure
+	// +kubebuilder:validation:Enum=StopOnFailure;ContinueOnFailure
+	// +optional
+	FailurePolicy DataFlowFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// DataFlowStepStatus describes the status of a step in the DataFlow
+type DataFlowStepStatus struct {
+	// Name is the name of the step
+	Name string `json:"name"`
+
+	// Operation refers to the data operation created for the step
+	// +optional
+	Operation *ObjectRef `json:"operation,omitempty"`
+
+	// Phase is the phase of the data operation, it's empty if the data operation is not created yet
+	// +optional
+	Phase common.Phase `json:"phase,omitempty"`
+
+	// Duration is the time spent on the data operation
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
+// DataFlowStatus defines the observed state of DataFlow
+type DataFlowStatus struct {
+	// Phase describes the current phase of the DataFlow, one of Pending, Executing, Complete and Failed
+	Phase common.Phase `json:"phase,omitempty"`
+
+	// Duration tells how much time was spent on the DataFlow
+	Duration string `json:"duration,omitempty"`
+
+	// StartTime is the time when the DataFlow started executing
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the DataFlow finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Steps describes the status of each step
+	// +optional
+	Steps []DataFlowStepStatus `json:"steps,omitempty"`
+
+	// Conditions consists of transition information on the DataFlow's Phase
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=flow
+// +genclient
+
+// DataFlow is the Schema for the dataflows API
+type DataFlow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataFlowSpec   `json:"spec,omitempty"`
+	Status DataFlowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// DataFlowList contains a list of DataFlow
+type DataFlowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataFlow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataFlow{}, &DataFlowList{})
+}
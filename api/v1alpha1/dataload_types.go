@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -44,6 +45,22 @@ type TargetPath struct {
 	Replicas int32 `json:"replicas,omitempty"`
 }
 
+// DataLoadManifest defines a manifest listing the files to be loaded, one path relative to the
+// root of the dataset per line. Empty lines and lines starting with "#" are ignored.
+type DataLoadManifest struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the DataLoad as the manifest
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// Path is the path of the manifest file inside the dataset
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Replicas defines how many replicas will be loaded for the files in the manifest
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+}
+
 // DataLoadSpec defines the desired state of DataLoad
 type DataLoadSpec struct {
 	// Dataset defines the target dataset of the DataLoad
@@ -55,6 +72,24 @@ type DataLoadSpec struct {
 	// Target defines target paths that needs to be loaded
 	Target []TargetPath `json:"target,omitempty"`
 
+	// Manifest references a list of files to be loaded, which is useful when there are too many files to be listed in Target.
+	// Manifest, Include and Exclude are not supported by JindoRuntime, EFCRuntime and VineyardRuntime.
+	// +optional
+	Manifest *DataLoadManifest `json:"manifest,omitempty"`
+
+	// Include defines glob patterns of the files to be loaded, only the files under Target or in Manifest
+	// matching one of the patterns are loaded. All files are loaded if it's empty.
+	// A pattern is matched against the full path of the file as a shell glob, e.g. bash [[ path == pattern ]],
+	// where "*" matches any string including "/", "?" matches any single character and "[...]" matches any
+	// character in the brackets. So "/data/*.csv" matches both "/data/a.csv" and "/data/sub/b.csv".
+	// +optional
+	Include []string `json:"include,omitempty"`
+
+	// Exclude defines glob patterns of the files not to be loaded, it takes precedence over Include.
+	// The patterns are matched the same as Include.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+
 	// Options specifies the extra dataload properties for runtime
 	Options map[string]string `json:"options,omitempty"`
 
@@ -87,6 +122,30 @@ type DataLoadSpec struct {
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	// ConcurrencyPolicy specifies how to treat concurrent runs of the Cron policy, one of Allow, Forbid and Replace.
+	// Defaults to Allow.
+	// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+	// +optional
+	ConcurrencyPolicy batchv1.ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// StartingDeadlineSeconds specifies the deadline in seconds for starting a run of the Cron policy if it misses
+	// the scheduled time for any reason. Missed runs are counted as failed ones.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// SuccessfulJobsHistoryLimit specifies the number of successful finished jobs of the Cron policy to retain.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+
+	// FailedJobsHistoryLimit specifies the number of failed finished jobs of the Cron policy to retain.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+
 	// Specifies that the preceding operation in a workflow
 	// +optional
 	RunAfter *OperationRef `json:"runAfter,omitempty"`
@@ -95,6 +154,29 @@ type DataLoadSpec struct {
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 
+	// BackoffLimit specifies the number of retries before marking the data operation as failed, each retry
+	// re-creates the operation job. Defaults to 0, which means no retry. It's not applied to the Cron policy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// RetryBackoff specifies the delay before retrying a failed data operation, which is doubled for each retry
+	// and capped at 10 minutes, e.g. 30s. Defaults to 10s.
+	// +optional
+	RetryBackoff *metav1.Duration `json:"retryBackoff,omitempty"`
+
+	// ActiveDeadlineSeconds specifies the duration in seconds relative to the time the data operation starts executing,
+	// retries included, before it's terminated and marked as failed. It's not applied to the Cron policy.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Suspend specifies whether the data operation is suspended. A suspended data operation with Once or OnEvent
+	// policy doesn't start executing until it's resumed, and the running one is not affected. For the Cron policy,
+	// no more jobs are scheduled while it's suspended. Defaults to false.
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
 	// Resources that will be requested by the DataLoad job. <br>
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -102,6 +184,7 @@ type DataLoadSpec struct {
 
 // +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset.name`
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Progress",type="string",JSONPath=`.status.progress.percentage`
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
 // +kubebuilder:object:root=true
@@ -17,6 +17,7 @@
 package v1alpha1
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -58,6 +59,30 @@ type DataMigrateSpec struct {
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	// ConcurrencyPolicy specifies how to treat concurrent runs of the Cron policy, one of Allow, Forbid and Replace.
+	// Defaults to Allow.
+	// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+	// +optional
+	ConcurrencyPolicy batchv1.ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// StartingDeadlineSeconds specifies the deadline in seconds for starting a run of the Cron policy if it misses
+	// the scheduled time for any reason. Missed runs are counted as failed ones.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// SuccessfulJobsHistoryLimit specifies the number of successful finished jobs of the Cron policy to retain.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+
+	// FailedJobsHistoryLimit specifies the number of failed finished jobs of the Cron policy to retain.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+
 	// PodMetadata defines labels and annotations that will be propagated to DataMigrate pods
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
 
@@ -85,6 +110,29 @@ type DataMigrateSpec struct {
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 
+	// BackoffLimit specifies the number of retries before marking the data operation as failed, each retry
+	// re-creates the operation job. Defaults to 0, which means no retry. It's not applied to the Cron policy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// RetryBackoff specifies the delay before retrying a failed data operation, which is doubled for each retry
+	// and capped at 10 minutes, e.g. 30s. Defaults to 10s.
+	// +optional
+	RetryBackoff *metav1.Duration `json:"retryBackoff,omitempty"`
+
+	// ActiveDeadlineSeconds specifies the duration in seconds relative to the time the data operation starts executing,
+	// retries included, before it's terminated and marked as failed. It's not applied to the Cron policy.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Suspend specifies whether the data operation is suspended. A suspended data operation with Once or OnEvent
+	// policy doesn't start executing until it's resumed, and the running one is not affected. For the Cron policy,
+	// no more jobs are scheduled while it's suspended. Defaults to false.
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
 	// Resources that will be requested by the DataMigrate job. <br>
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -130,6 +178,7 @@ type ExternalStorage struct {
 }
 
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Progress",type="string",JSONPath=`.status.progress.percentage`
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
 // +kubebuilder:object:root=true
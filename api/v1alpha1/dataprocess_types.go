@@ -112,6 +112,23 @@ type DataProcessSpec struct {
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 
+	// BackoffLimit specifies the number of retries before marking the data operation as failed, each retry
+	// re-creates the operation job. Defaults to 0, which means no retry. It's not applied to the Cron policy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// RetryBackoff specifies the delay before retrying a failed data operation, which is doubled for each retry
+	// and capped at 10 minutes, e.g. 30s. Defaults to 10s.
+	// +optional
+	RetryBackoff *metav1.Duration `json:"retryBackoff,omitempty"`
+
+	// ActiveDeadlineSeconds specifies the duration in seconds relative to the time the data operation starts executing,
+	// retries included, before it's terminated and marked as failed. It's not applied to the Cron policy.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
 	//+kubebuilder:default:=Once
 	//+kubebuilder:validation:Enum=Once;Cron;OnEvent
 	// Policy defines the operation policy, including Once, Cron, OnEvent
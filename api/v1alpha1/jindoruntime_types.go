@@ -212,6 +212,10 @@ type JindoRuntimeSpec struct {
 	// +optional
 	CleanCachePolicy CleanCachePolicy `json:"cleanCachePolicy,omitempty"`
 
+	// ScaleInPolicy defines policies when scaling in the workers
+	// +optional
+	ScaleInPolicy ScaleInPolicy `json:"scaleInPolicy,omitempty"`
+
 	// Volumes is the list of Kubernetes volumes that can be mounted by the jindo runtime components and/or fuses.
 	// +optional
 	Volumes []corev1.Volume `json:"volumes,omitempty"`
@@ -85,6 +85,10 @@ type JuiceFSRuntimeSpec struct {
 	// RuntimeManagement defines policies when managing the runtime
 	// +optional
 	RuntimeManagement RuntimeManagement `json:"management,omitempty"`
+
+	// UpgradeStrategy defines how the fuse pods are upgraded when the fuse is changed
+	// +optional
+	UpgradeStrategy FuseUpgradeStrategy `json:"upgradeStrategy,omitempty"`
 }
 
 // JuiceFSCompTemplateSpec is a description of the JuiceFS components
@@ -39,17 +39,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClass":                 schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClass(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClassList":             schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClassList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClientSpec":            schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClientSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeHealthCheckOutput":     schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeHealthCheckOutput(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeList":                  schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeMasterSpec":            schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeMasterSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeMountUfsOutput":        schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeMountUfsOutput(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeReportCapacityOutput":  schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeReportCapacityOutput(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeReportSummary":         schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeReportSummary(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeSpec":                  schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeStatus":                schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeSyncMetadataOutput":    schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeSyncMetadataOutput(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeUnmountUfsOutput":      schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeUnmountUfsOutput(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeWorkerSpec":            schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeWorkerSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheableNodeAffinity":             schema_fluid_cloudnative_fluid_api_v1alpha1_CacheableNodeAffinity(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy":                  schema_fluid_cloudnative_fluid_api_v1alpha1_CleanCachePolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ClientMetrics":                     schema_fluid_cloudnative_fluid_api_v1alpha1_ClientMetrics(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ComponentServiceConfig":            schema_fluid_cloudnative_fluid_api_v1alpha1_ComponentServiceConfig(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ComponentVersionStatus":            schema_fluid_cloudnative_fluid_api_v1alpha1_ComponentVersionStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition":                         schema_fluid_cloudnative_fluid_api_v1alpha1_Condition(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ConfigMapDependencyConfig":         schema_fluid_cloudnative_fluid_api_v1alpha1_ConfigMapDependencyConfig(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ConfigMapRuntimeExtraResource":     schema_fluid_cloudnative_fluid_api_v1alpha1_ConfigMapRuntimeExtraResource(ref),
@@ -57,8 +62,19 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackup":                        schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackup(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupList":                    schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupSpec":                    schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataEvict":                         schema_fluid_cloudnative_fluid_api_v1alpha1_DataEvict(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataEvictList":                     schema_fluid_cloudnative_fluid_api_v1alpha1_DataEvictList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataEvictSpec":                     schema_fluid_cloudnative_fluid_api_v1alpha1_DataEvictSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlow":                          schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlow(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowAffinityStrategy":          schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowAffinityStrategy(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowList":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowSpec":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStatus":                    schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStep":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStep(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepStatus":                schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad":                          schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadList":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadManifest":                  schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadManifest(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate":                       schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateList":                   schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateList(ref),
@@ -83,13 +99,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EmptyDirMediumSource":              schema_fluid_cloudnative_fluid_api_v1alpha1_EmptyDirMediumSource(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption":                     schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOption(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource":               schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOptionSource(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EvictTargetPath":                   schema_fluid_cloudnative_fluid_api_v1alpha1_EvictTargetPath(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry":              schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionCommonEntry(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionEntries":                  schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionEntries(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalEndpointSpec":              schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalEndpointSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalStorage":                   schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalStorage(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExtraResourcesComponentDependency": schema_fluid_cloudnative_fluid_api_v1alpha1_ExtraResourcesComponentDependency(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStrategy":               schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeStrategy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.HCFSStatus":                        schema_fluid_cloudnative_fluid_api_v1alpha1_HCFSStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.HeadlessRuntimeComponentService":   schema_fluid_cloudnative_fluid_api_v1alpha1_HeadlessRuntimeComponentService(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.HibernationPolicy":                 schema_fluid_cloudnative_fluid_api_v1alpha1_HibernationPolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.HostPathMediumSource":              schema_fluid_cloudnative_fluid_api_v1alpha1_HostPathMediumSource(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.InitFuseSpec":                      schema_fluid_cloudnative_fluid_api_v1alpha1_InitFuseSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec":                     schema_fluid_cloudnative_fluid_api_v1alpha1_InitUsersSpec(ref),
@@ -107,11 +126,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Level":                             schema_fluid_cloudnative_fluid_api_v1alpha1_Level(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.MasterSpec":                        schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Metadata":                          schema_fluid_cloudnative_fluid_api_v1alpha1_Metadata(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.MetadataRestorePolicy":             schema_fluid_cloudnative_fluid_api_v1alpha1_MetadataRestorePolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.MetadataSyncPolicy":                schema_fluid_cloudnative_fluid_api_v1alpha1_MetadataSyncPolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount":                             schema_fluid_cloudnative_fluid_api_v1alpha1_Mount(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise":                          schema_fluid_cloudnative_fluid_api_v1alpha1_OSAdvise(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef":                         schema_fluid_cloudnative_fluid_api_v1alpha1_ObjectRef(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationProgress":                 schema_fluid_cloudnative_fluid_api_v1alpha1_OperationProgress(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef":                      schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRun":                      schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRun(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus":                   schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata":                       schema_fluid_cloudnative_fluid_api_v1alpha1_PodMetadata(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Prefer":                            schema_fluid_cloudnative_fluid_api_v1alpha1_Prefer(ref),
@@ -119,6 +141,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor":                         schema_fluid_cloudnative_fluid_api_v1alpha1_Processor(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Require":                           schema_fluid_cloudnative_fluid_api_v1alpha1_Require(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime":                           schema_fluid_cloudnative_fluid_api_v1alpha1_Runtime(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscaler":                 schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscaler(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerList":             schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerMetric":           schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerMetric(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerMetricStatus":     schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerMetricStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerRecommendation":   schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerRecommendation(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerSpec":             schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerStatus":           schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeComponentCommonSpec":        schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeComponentCommonSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeComponentDefinition":        schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeComponentDefinition(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeComponentDependencies":      schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeComponentDependencies(ref),
@@ -128,10 +157,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeCondition":                  schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeCondition(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeExtraResources":             schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeExtraResources(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement":                 schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeManagement(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeScaleTargetReference":       schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeScaleTargetReference(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus":                     schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTieredStore":                schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeTieredStore(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTieredStoreLevel":           schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeTieredStoreLevel(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTopology":                   schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeTopology(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeUpgradeStatus":              schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeUpgradeStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ScaleInPolicy":                     schema_fluid_cloudnative_fluid_api_v1alpha1_ScaleInPolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ScriptProcessor":                   schema_fluid_cloudnative_fluid_api_v1alpha1_ScriptProcessor(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector":                 schema_fluid_cloudnative_fluid_api_v1alpha1_SecretKeySelector(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretMountComponentDependency":    schema_fluid_cloudnative_fluid_api_v1alpha1_SecretMountComponentDependency(ref),
@@ -140,6 +172,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath":                        schema_fluid_cloudnative_fluid_api_v1alpha1_TargetPath(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec":              schema_fluid_cloudnative_fluid_api_v1alpha1_ThinCompTemplateSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec":                      schema_fluid_cloudnative_fluid_api_v1alpha1_ThinFuseSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinLoaderSpec":                    schema_fluid_cloudnative_fluid_api_v1alpha1_ThinLoaderSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntime":                       schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntime(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntimeList":                   schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntimeProfile":                schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeProfile(ref),
@@ -148,6 +181,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntimeProfileStatus":          schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeProfileStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinRuntimeSpec":                   schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore":                       schema_fluid_cloudnative_fluid_api_v1alpha1_TieredStore(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.UpgradePolicy":                     schema_fluid_cloudnative_fluid_api_v1alpha1_UpgradePolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.User":                              schema_fluid_cloudnative_fluid_api_v1alpha1_User(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec":                       schema_fluid_cloudnative_fluid_api_v1alpha1_VersionSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.VineyardClientSocketSpec":          schema_fluid_cloudnative_fluid_api_v1alpha1_VineyardClientSocketSpec(ref),
@@ -519,7 +553,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_AlluxioFuseSpec(ref common.Refe
 					},
 					"cleanPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CleanPolicy decides when to clean Alluxio Fuse pods. Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted OnDemand cleans fuse pod once the fuse pod on some node is not needed OnRuntimeDeleted cleans fuse pod only when the cache runtime is deleted Defaults to OnRuntimeDeleted",
+							Description: "CleanPolicy decides when to clean Alluxio Fuse pods. Currently Fluid supports three policies: OnDemand, OnRuntimeDeleted and OnFuseChanged OnDemand cleans fuse pod once the fuse pod on some node is not needed OnRuntimeDeleted cleans fuse pod only when the cache runtime is deleted OnFuseChanged cleans fuse pod once the fuse pod on some node is not needed and the fuse in runtime is upgraded Defaults to OnRuntimeDeleted",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -819,6 +853,20 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_AlluxioRuntimeSpec(ref common.R
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement"),
 						},
 					},
+					"scaleInPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleInPolicy defines policies when scaling in the workers",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ScaleInPolicy"),
+						},
+					},
+					"upgradePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradePolicy defines policies when upgrading the runtime to a new version",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.UpgradePolicy"),
+						},
+					},
 					"imagePullSecrets": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ImagePullSecrets that will be used to pull images",
@@ -837,7 +885,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_AlluxioRuntimeSpec(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Data", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Data", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ScaleInPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.UpgradePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -1176,6 +1224,35 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClientSpec(ref comm
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeHealthCheckOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheRuntimeHealthCheckOutput defines the return structure for HealthCheck execution entry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"healthy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Healthy is true if the cache system is healthy.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the cache system is not healthy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"healthy"},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1413,6 +1490,36 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeMountUfsOutput(ref
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeReportCapacityOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheRuntimeReportCapacityOutput defines the return structure for ReportCapacity execution entry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"usedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsedBytes is the used cache capacity, in bytes.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"capacityBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapacityBytes is the total cache capacity, in bytes.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"usedBytes", "capacityBytes"},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeReportSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1483,6 +1590,13 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeSpec(ref common.Ref
 							Format:      "",
 						},
 					},
+					"updatePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatePolicy is the policy to re-render the master and worker components when the CacheRuntimeClass changes. Manual (default) keeps the components rendered from the recorded generation of the CacheRuntimeClass until the runtime is explicitly bumped, and Auto re-renders them once the CacheRuntimeClass changes. It covers the pod templates of the master and worker and the ConfigMaps in ExtraResources not mounted by the client. The client is never re-rendered, and ExecutionEntries and DataOperationSpecs always follow the current CacheRuntimeClass.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"master": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Master is the desired state of the master component.",
@@ -1661,6 +1775,13 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeStatus(ref common.R
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"runtimeClassGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassGeneration is the generation of the CacheRuntimeClass which the components are rendered from.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -1669,6 +1790,61 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeStatus(ref common.R
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeSyncMetadataOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheRuntimeSyncMetadataOutput defines the return structure for SyncMetadata execution entry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fileNum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FileNum represents the file numbers of the dataset",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ufsTotal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UfsTotal is the total size of the dataset in the cluster, e.g. \"10.00GiB\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeUnmountUfsOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheRuntimeUnmountUfsOutput defines the return structure for UnmountUFS execution entry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unmounted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unmounted are the ufs paths that have been unmounted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeWorkerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1932,6 +2108,47 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ComponentServiceConfig(ref comm
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ComponentVersionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVersionStatus records the current and target versions of a runtime component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"currentVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentVersion is the image which all the pods of the component are running",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetVersion is the image which the component is upgraded to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedReplicas is the number of the pods running the target version",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of the pods of the component",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2218,19 +2435,39 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupSpec(ref common.Refer
 							Format:      "int32",
 						},
 					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackoffLimit specifies the number of retries before marking the data operation as failed, each retry re-creates the backup pod. Defaults to 0, which means no retry.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryBackoff specifies the delay before retrying a failed data operation, which is doubled for each retry and capped at 10 minutes, e.g. 30s. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds specifies the duration in seconds relative to the time the data operation starts executing, retries included, before it's terminated and marked as failed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataEvict(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataLoad is the Schema for the dataloads API",
+				Description: "DataEvict is the Schema for the dataevicts API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2256,7 +2493,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCa
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataEvictSpec"),
 						},
 					},
 					"status": {
@@ -2269,15 +2506,15 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataEvictSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataEvictList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataLoadList contains a list of DataLoad",
+				Description: "DataEvictList contains a list of DataEvict",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2307,7 +2544,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.Referen
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataEvict"),
 									},
 								},
 							},
@@ -2318,114 +2555,41 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataEvict", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataEvictSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataLoadSpec defines the desired state of DataLoad",
+				Description: "DataEvictSpec defines the desired state of DataEvict",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"dataset": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dataset defines the target dataset of the DataLoad",
+							Description: "Dataset defines the target dataset of the DataEvict",
 							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset"),
 						},
 					},
-					"loadMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LoadMetadata specifies if the dataload job should load metadata",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
 					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target defines target paths that needs to be loaded",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath"),
-									},
-								},
-							},
-						},
-					},
-					"options": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Options specifies the extra dataload properties for runtime",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"podMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to DataLoad pods",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
-						},
-					},
-					"affinity": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Affinity defines affinity for DataLoad pod",
-							Ref:         ref("k8s.io/api/core/v1.Affinity"),
-						},
-					},
-					"tolerations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Tolerations defines tolerations for DataLoad pod",
+							Description: "Target defines target paths whose cache needs to be evicted, the whole cache of the dataset is evicted if not set",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
-									},
-								},
-							},
-						},
-					},
-					"nodeSelector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector defiens node selector for DataLoad pod",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EvictTargetPath"),
 									},
 								},
 							},
 						},
 					},
-					"schedulerName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SchedulerName sets the scheduler to be used for DataLoad pod",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "including Once, Cron, OnEvent",
+							Description: "including Once, Cron",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2450,26 +2614,19 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref common.Referen
 							Format:      "int32",
 						},
 					},
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by the DataLoad job. <br>",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
-						},
-					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EvictTargetPath", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataMigrate is the Schema for the datamigrates API",
+				Description: "DataFlow is the Schema for the dataflows API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2495,20 +2652,749 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref common.Referenc
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowAffinityStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowAffinityStrategy defines the pod affinity strategy of a step with one of its dependencies",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dependOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependOn specifies the name of the dependency step whose data operation pods the affinity is generated with. Defaults to the first dependency of the step.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy one of: \"\", \"Require\", \"Prefer\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Prefer"),
+									},
+								},
+							},
+						},
+					},
+					"requires": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Require"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Prefer", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Require"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowList contains a list of DataFlow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlow"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlow", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowSpec defines the desired state of DataFlow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps are the steps of the DataFlow, which form a directed acyclic graph by their dependencies. Exactly one template of DataLoad, DataProcess and DataMigrate must be set in each step. The template must use the Once policy, and can't set runAfter or ttlSecondsAfterFinished.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStep"),
+									},
+								},
+							},
+						},
+					},
+					"failurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailurePolicy specifies what the DataFlow does when one of its steps fails, one of StopOnFailure and ContinueOnFailure. Defaults to StopOnFailure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"steps"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStep"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowStatus defines the observed state of DataFlow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase describes the current phase of the DataFlow, one of Pending, Executing, Complete and Failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration tells how much time was spent on the DataFlow",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the DataFlow started executing",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time when the DataFlow finished",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps describes the status of each step",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepStatus"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions consists of transition information on the DataFlow's Phase",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowStep defines a step of the DataFlow, which creates a data operation from one of the templates",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the step, which is unique in the DataFlow. The data operation created for the step is named as <dataflow name>-<step name>.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dependencies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependencies are the names of the steps that must complete before the step starts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"affinityStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AffinityStrategy specifies the pod affinity strategy with the data operation of a dependency step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowAffinityStrategy"),
+						},
+					},
+					"dataLoad": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataLoad is the template of the DataLoad created for the step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec"),
+						},
+					},
+					"dataProcess": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataProcess is the template of the DataProcess created for the step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec"),
+						},
+					},
+					"dataMigrate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataMigrate is the template of the DataMigrate created for the step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowAffinityStrategy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowStepStatus describes the status of a step in the DataFlow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation refers to the data operation created for the step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the data operation, it's empty if the data operation is not created yet",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the time spent on the data operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataLoad is the Schema for the dataloads API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataLoadList contains a list of DataLoad",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataLoadManifest defines a manifest listing the files to be loaded, one path relative to the root of the dataset per line. Empty lines and lines starting with \"#\" are ignored.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the DataLoad as the manifest",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the manifest file inside the dataset",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas defines how many replicas will be loaded for the files in the manifest",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ConfigMapKeySelector"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataLoadSpec defines the desired state of DataLoad",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dataset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dataset defines the target dataset of the DataLoad",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset"),
+						},
+					},
+					"loadMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadMetadata specifies if the dataload job should load metadata",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target defines target paths that needs to be loaded",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath"),
+									},
+								},
+							},
+						},
+					},
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manifest references a list of files to be loaded, which is useful when there are too many files to be listed in Target. Manifest, Include and Exclude are not supported by JindoRuntime, EFCRuntime and VineyardRuntime.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadManifest"),
+						},
+					},
+					"include": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Include defines glob patterns of the files to be loaded, only the files under Target or in Manifest matching one of the patterns are loaded. All files are loaded if it's empty. A pattern is matched against the full path of the file as a shell glob, e.g. bash [[ path == pattern ]], where \"*\" matches any string including \"/\", \"?\" matches any single character and \"[...]\" matches any character in the brackets. So \"/data/*.csv\" matches both \"/data/a.csv\" and \"/data/sub/b.csv\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"exclude": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exclude defines glob patterns of the files not to be loaded, it takes precedence over Include. The patterns are matched the same as Include.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options specifies the extra dataload properties for runtime",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to DataLoad pods",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Affinity defines affinity for DataLoad pod",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations defines tolerations for DataLoad pod",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector defiens node selector for DataLoad pod",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"schedulerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulerName sets the scheduler to be used for DataLoad pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "including Once, Cron, OnEvent",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrencyPolicy specifies how to treat concurrent runs of the Cron policy, one of Allow, Forbid and Replace. Defaults to Allow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startingDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartingDeadlineSeconds specifies the deadline in seconds for starting a run of the Cron policy if it misses the scheduled time for any reason. Missed runs are counted as failed ones.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"successfulJobsHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessfulJobsHistoryLimit specifies the number of successful finished jobs of the Cron policy to retain. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedJobsHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedJobsHistoryLimit specifies the number of failed finished jobs of the Cron policy to retain. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"runAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies that the preceding operation in a workflow",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackoffLimit specifies the number of retries before marking the data operation as failed, each retry re-creates the operation job. Defaults to 0, which means no retry. It's not applied to the Cron policy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryBackoff specifies the delay before retrying a failed data operation, which is doubled for each retry and capped at 10 minutes, e.g. 30s. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds specifies the duration in seconds relative to the time the data operation starts executing, retries included, before it's terminated and marked as failed. It's not applied to the Cron policy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend specifies whether the data operation is suspended. A suspended data operation with Once or OnEvent policy doesn't start executing until it's resumed, and the running one is not affected. For the Cron policy, no more jobs are scheduled while it's suspended. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources that will be requested by the DataLoad job. <br>",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadManifest", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataMigrate is the Schema for the datamigrates API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -2640,11 +3526,39 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 							Format:      "",
 						},
 					},
-					"schedule": {
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrencyPolicy specifies how to treat concurrent runs of the Cron policy, one of Allow, Forbid and Replace. Defaults to Allow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startingDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartingDeadlineSeconds specifies the deadline in seconds for starting a run of the Cron policy if it misses the scheduled time for any reason. Missed runs are counted as failed ones.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"successfulJobsHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessfulJobsHistoryLimit specifies the number of successful finished jobs of the Cron policy to retain. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedJobsHistoryLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FailedJobsHistoryLimit specifies the number of failed finished jobs of the Cron policy to retain. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 					"podMetadata": {
@@ -2710,6 +3624,33 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 							Format:      "int32",
 						},
 					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackoffLimit specifies the number of retries before marking the data operation as failed, each retry re-creates the operation job. Defaults to 0, which means no retry. It's not applied to the Cron policy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryBackoff specifies the delay before retrying a failed data operation, which is doubled for each retry and capped at 10 minutes, e.g. 30s. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds specifies the duration in seconds relative to the time the data operation starts executing, retries included, before it's terminated and marked as failed. It's not applied to the Cron policy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend specifies whether the data operation is suspended. A suspended data operation with Once or OnEvent policy doesn't start executing until it's resumed, and the running one is not affected. For the Cron policy, no more jobs are scheduled while it's suspended. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"resources": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Resources that will be requested by the DataMigrate job. <br>",
@@ -2745,7 +3686,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2772,7 +3713,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataOperationSpec(ref common.Re
 					},
 					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Command for data operation Pod container",
+							Description: "Command for data operation Pod container. For DataBackup, the command should write the backup files into the directory $FLUID_DATABACKUP_TARGET_DIR.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2937,6 +3878,26 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSpec(ref common.Refe
 							Format:      "int32",
 						},
 					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackoffLimit specifies the number of retries before marking the data operation as failed, each retry re-creates the operation job. Defaults to 0, which means no retry. It's not applied to the Cron policy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryBackoff specifies the delay before retrying a failed data operation, which is doubled for each retry and capped at 10 minutes, e.g. 30s. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds specifies the duration in seconds relative to the time the data operation starts executing, retries included, before it's terminated and marked as failed. It's not applied to the Cron policy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"policy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Policy defines the operation policy, including Once, Cron, OnEvent",
@@ -2956,7 +3917,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSpec(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -3917,6 +4878,28 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOptionSource(ref common.
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EvictTargetPath(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EvictTargetPath defines the target path of the DataEvict",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path defines path whose cache will be evicted",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionCommonEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3963,6 +4946,30 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionEntries(ref common.Ref
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"),
 						},
 					},
+					"unmountUFS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnmountUFS defines the operations for unmounting UFS. The ufs paths to unmount are appended to the command as arguments. The command's stdout must be JSON matching CacheRuntimeUnmountUfsOutput.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"),
+						},
+					},
+					"syncMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncMetadata defines the operations for loading the metadata of UFS into the cache system. The command's stdout must be JSON matching CacheRuntimeSyncMetadataOutput.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"),
+						},
+					},
+					"healthCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthCheck defines the operations for checking whether the cache system is healthy. The command's stdout must be JSON matching CacheRuntimeHealthCheckOutput.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"),
+						},
+					},
+					"reportCapacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReportCapacity defines the operations for getting the used and total cache capacity. The command's stdout must be JSON matching CacheRuntimeReportCapacityOutput.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"),
+						},
+					},
 					"reportSummary": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReportSummary it defines the operation how to get cache status like capacity, hit ratio etc.",
@@ -4096,6 +5103,34 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ExtraResourcesComponentDependen
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FuseUpgradeStrategy defines how the fuse pods are upgraded when the fuse is changed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the fuse upgrade, can be \"Recreate\" or \"HotUpgrade\". Defaults to Recreate. In HotUpgrade, the running fuse process hands off the /dev/fuse session to the new one through the unix socket given by the FLUID_FUSE_HANDOFF_SOCKET env, the fuse process must support it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is the max number of nodes on which the new fuse pods are started beside the old ones at the same time in HotUpgrade. Value can be an absolute number (ex: 5) or a percentage of the nodes (ex: 10%). If this value is nil, 1 will be used.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_HCFSStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4134,6 +5169,35 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_HeadlessRuntimeComponentService
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_HibernationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationPolicy defines the policy of scaling the runtime to zero when no pod mounts its dataset, the runtime is woken up automatically when a new pod mounting the dataset is created.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"idleMinutes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleMinutes is the number of minutes without any pod mounting the dataset before the runtime hibernates",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"hibernateMaster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernateMaster scales the masters to zero as well when the runtime hibernates, which frees more resources but takes longer to wake up. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"idleMinutes"},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_HostPathMediumSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4861,6 +5925,13 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeSpec(ref common.Ref
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy"),
 						},
 					},
+					"scaleInPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleInPolicy defines policies when scaling in the workers",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ScaleInPolicy"),
+						},
+					},
 					"volumes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Volumes is the list of Kubernetes volumes that can be mounted by the jindo runtime components and/or fuses.",
@@ -4893,7 +5964,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeSpec(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ScaleInPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -5398,11 +6469,18 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntimeSpec(ref common.R
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement"),
 						},
 					},
+					"upgradeStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeStrategy defines how the fuse pods are upgraded when the fuse is changed",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStrategy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStrategy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -5681,6 +6759,26 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_Metadata(ref common.ReferenceCa
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_MetadataRestorePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetadataRestorePolicy defines the policy of restoring the metadata of the master from the latest completed DataBackup of the dataset when the master restarts with an empty journal.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"autoRestore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoRestore enables restoring the metadata automatically once all the master pods are recreated and the journal is lost. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_MetadataSyncPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5853,6 +6951,76 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ObjectRef(ref common.ReferenceC
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperationProgress describes the progress reported by the operation pod",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"processedFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProcessedFiles is the number of files which have been processed",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalFiles is the number of files to process, 0 if unknown",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"processedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProcessedBytes is the number of bytes which have been processed",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytes is the number of bytes to process, 0 if unknown",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"percentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percentage is the processed percentage of the total bytes, or total files if total bytes is unknown, e.g. 42.0%",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"throughput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Throughput is the average bytes processed per second, e.g. 10.00MiB/s",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eta": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETA is the estimated time to finish the operation, e.g. 1h2m3s",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the last time the progress was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5905,6 +7073,57 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref common.Referen
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRun(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperationRun describes a run of the cron operation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobName is the name of the job created for the run",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the run, one of Executing, Complete and Failed",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the run started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time when the run finished",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the time spent on the run",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"jobName", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5970,6 +7189,20 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref common.Refe
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"recentRuns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecentRuns records the recent runs of the cron operation, the newest run comes first",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRun"),
+									},
+								},
+							},
+						},
+					},
 					"waitingFor": {
 						SchemaProps: spec.SchemaProps{
 							Description: "WaitingStatus stores information about waiting operation.",
@@ -5977,10 +7210,29 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref common.Refe
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus"),
 						},
 					},
-					"nodeAffinity": {
+					"nodeAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeAffinity records the node affinity for operation pods",
+							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress reports the live progress of the operation while it's executing",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationProgress"),
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the number of times the operation has been executed, retries included",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeAffinity records the node affinity for operation pods",
-							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
+							Description: "StartTime is the time when the operation started executing, it's kept across retries",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
@@ -5988,7 +7240,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationProgress", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRun", "github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6184,16 +7436,338 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_Runtime(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
-					"masterReplicas": {
+					"masterReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Runtime master replicas",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeAutoscaler is the Schema for the runtimeautoscalers API, it scales the workers of a runtime by the cache states of the runtime and the consumers of its dataset.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeAutoscalerList contains a list of RuntimeAutoscaler",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscaler"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscaler", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeAutoscalerMetric defines a metric and its target value used to compute the desired worker replicas",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the metric, one of CacheUsage, CacheHitRatio and DatasetConsumers",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetValue is the target value of the metric. It's a percentage for CacheUsage and CacheHitRatio, and the number of consumer pods per worker for DatasetConsumers.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"type", "targetValue"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerMetricStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeAutoscalerMetricStatus describes the observed value of a metric",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the metric",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentValue is the observed value of the metric, with the same unit as the target value",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"type", "currentValue"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerRecommendation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeAutoscalerRecommendation is the worker replicas recommended by the metrics at a point of time",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the recommended worker replicas",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp is the time when the recommendation is computed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"replicas", "timestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeAutoscalerSpec defines the desired state of RuntimeAutoscaler",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scaleTargetRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleTargetRef refers to the runtime whose worker replicas are scaled",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeScaleTargetReference"),
+						},
+					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the lower limit of the worker replicas. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the upper limit of the worker replicas, it cannot be less than MinReplicas.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics are used to compute the desired worker replicas, the largest replicas computed from the metrics is used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerMetric"),
+									},
+								},
+							},
+						},
+					},
+					"stabilizationWindowSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StabilizationWindowSeconds is the number of seconds for which the past recommendations are considered when scaling in, the workers are scaled in to the largest recommendation in the window. Defaults to 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"scaleTargetRef", "maxReplicas", "metrics"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerMetric", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeScaleTargetReference"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeAutoscalerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeAutoscalerStatus defines the observed state of RuntimeAutoscaler",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"currentReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentReplicas is the worker replicas of the runtime observed by the autoscaler",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"desiredReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DesiredReplicas is the worker replicas last computed by the autoscaler",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastScaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScaleTime is the last time the autoscaler changed the worker replicas of the runtime",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentMetrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentMetrics are the last observed values of the metrics, the metrics without available values are omitted",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerMetricStatus"),
+									},
+								},
+							},
+						},
+					},
+					"recommendations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recommendations are the recommended worker replicas in the stabilization window",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerRecommendation"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Runtime master replicas",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Conditions consists of the information on whether the autoscaler is able to compute and apply the worker replicas",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerMetricStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeAutoscalerRecommendation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6672,11 +8246,60 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeManagement(ref common.Re
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.MetadataSyncPolicy"),
 						},
 					},
+					"hibernationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernationPolicy defines the policy of hibernating the runtime when its dataset is idle. If not set, the runtime never hibernates.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.HibernationPolicy"),
+						},
+					},
+					"metadataRestorePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetadataRestorePolicy defines the policy of restoring the metadata of the master when it's lost. If not set, the metadata is never restored automatically. Only AlluxioRuntime supports it for now, JuiceFSRuntime and ThinRuntime fail in validation if it's set.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.MetadataRestorePolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.MetadataSyncPolicy"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.HibernationPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.MetadataRestorePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.MetadataSyncPolicy"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeScaleTargetReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeScaleTargetReference refers to the runtime to scale, the runtime must expose the scale subresource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the API version of the runtime. Defaults to data.fluid.io/v1alpha1.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the runtime, e.g. AlluxioRuntime",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the runtime, which is the same as the name of its dataset",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
 	}
 }
 
@@ -6890,6 +8513,18 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeStatus(ref common.Refere
 							Format:      "",
 						},
 					},
+					"idleSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleSince is the time since when no pod mounts the dataset of the runtime, it's only recorded when the hibernation policy of the runtime is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"upgrade": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Upgrade records the current and target versions of the runtime components. Only AlluxioRuntime supports the ordered upgrade and sets it, it's always empty for the other runtimes.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeUpgradeStatus"),
+						},
+					},
 					"apiGateway": {
 						SchemaProps: spec.SchemaProps{
 							Description: "APIGatewayStatus represents rest api gateway status",
@@ -6927,7 +8562,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeStatus(ref common.Refere
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.APIGatewayStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeCondition", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.APIGatewayStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeCondition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeUpgradeStatus", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -7040,6 +8675,69 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeTopology(ref common.Refe
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeUpgradeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeUpgradeStatus records the versions of the runtime components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"master": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Master is the version of the master",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ComponentVersionStatus"),
+						},
+					},
+					"worker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Worker is the version of the workers",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ComponentVersionStatus"),
+						},
+					},
+					"fuse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fuse is the version of the fuse",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ComponentVersionStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ComponentVersionStatus"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ScaleInPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScaleInPolicy defines policies when scaling in the workers",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"graceful": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Graceful enables draining the workers to be removed before shrinking the workers. The workers are decommissioned by the cache engine so that their cached blocks are not read by the clients any more. The cached blocks are not copied to the remaining workers, they're dropped with the removed workers and read from the under file system again.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"drainTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional duration in seconds to wait for draining the workers. The workers are removed after the timeout even if draining is not finished. If this value is nil, 300 seconds will be used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_ScriptProcessor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7355,6 +9053,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinCompTemplateSpec(ref common
 						},
 					},
 					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "containerPort",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
 							Description: "Ports used thinRuntime",
 							Type:        []string{"array"},
@@ -7376,6 +9080,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinCompTemplateSpec(ref common
 						},
 					},
 					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
 							Description: "Environment variables that will be used by thinRuntime component.",
 							Type:        []string{"array"},
@@ -7413,6 +9123,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinCompTemplateSpec(ref common
 						},
 					},
 					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "mountPath",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into runtime component's filesystem.",
 							Type:        []string{"array"},
@@ -7495,6 +9211,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinFuseSpec(ref common.Referen
 						},
 					},
 					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "containerPort",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
 							Description: "Ports used thinRuntime",
 							Type:        []string{"array"},
@@ -7509,6 +9231,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinFuseSpec(ref common.Referen
 						},
 					},
 					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
 							Description: "Environment variables that will be used by thinRuntime Fuse",
 							Type:        []string{"array"},
@@ -7618,6 +9346,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinFuseSpec(ref common.Referen
 						},
 					},
 					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "mountPath",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into the thinruntime component's filesystem.",
 							Type:        []string{"array"},
@@ -7652,6 +9386,79 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinFuseSpec(ref common.Referen
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinLoaderSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ThinLoaderSpec defines the native warm-up tooling used by DataLoad on thinRuntime. The DataLoad job mounts the dataset at $FLUID_DATALOAD_MOUNT_PATH, and the targets in DataLoad.spec.target are passed by the env FLUID_DATALOAD_DATA_PATH and FLUID_DATALOAD_PATH_REPLICAS separated by colons, so the command and args can refer to them, e.g. [\"sh\", \"-c\", \"warmup --paths $FLUID_DATALOAD_DATA_PATH\"].",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image for the DataLoad job, defaults to the image of thinRuntime fuse",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image tag for the DataLoad job",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command that will be passed to the DataLoad job",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments that will be passed to the DataLoad job",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into the DataLoad job.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7851,10 +9658,16 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeProfileSpec(ref comm
 				Description: "ThinRuntimeProfileSpec defines the desired state of ThinRuntimeProfile",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"parentProfileName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParentProfileName is the name of the ThinRuntimeProfile to inherit from. The fields not set in this profile are inherited from the parent profile, and the env, ports and volume mounts of worker and fuse are merged with the ones of the parent profile by their keys.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"fileSystemType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "file system of thinRuntime",
-							Default:     "",
+							Description: "file system of thinRuntime, it's required unless it's inherited from the parent profile",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -7888,6 +9701,12 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeProfileSpec(ref comm
 						},
 					},
 					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
 							Description: "Volumes is the list of Kubernetes volumes that can be mounted by runtime components and/or fuses.",
 							Type:        []string{"array"},
@@ -7908,12 +9727,24 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeProfileSpec(ref comm
 							Format:      "",
 						},
 					},
+					"loader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Loader defines how to load data into the file system of thinRuntime, DataLoad is not supported if it is not set.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinLoaderSpec"),
+						},
+					},
+					"upgradeStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeStrategy defines how the fuse pods are upgraded when the fuse is changed. Set it to HotUpgrade only if the fuse process of the file system supports taking over the fuse session.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStrategy"),
+						},
+					},
 				},
-				Required: []string{"fileSystemType"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStrategy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinLoaderSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -8004,6 +9835,13 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeSpec(ref common.Refe
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement"),
 						},
 					},
+					"upgradeStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeStrategy defines how the fuse pods are upgraded when the fuse is changed, the one in the ThinRuntimeProfile is used if it's not set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStrategy"),
+						},
+					},
 					"imagePullSecrets": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ImagePullSecrets that will be used to pull images",
@@ -8022,7 +9860,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeSpec(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStrategy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -8055,6 +9893,26 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_TieredStore(ref common.Referenc
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_UpgradePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UpgradePolicy defines policies when upgrading the runtime. The master is upgraded first, then the workers are upgraded in batches, and the fuse is upgraded at last.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workerBatchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerBatchSize is the max number of the workers restarted at the same time when upgrading the workers. If this value is nil, 1 will be used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_User(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
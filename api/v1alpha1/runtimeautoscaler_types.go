@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RuntimeAutoscalerMetricType is the type of the metric used to compute the desired worker replicas
+type RuntimeAutoscalerMetricType string
+
+const (
+	// CacheUsageMetric scales the workers to keep the percentage of the used cache capacity around the target
+	CacheUsageMetric RuntimeAutoscalerMetricType = "CacheUsage"
+
+	// CacheHitRatioMetric scales out the workers when the cache hit ratio is lower than the target percentage
+	CacheHitRatioMetric RuntimeAutoscalerMetricType = "CacheHitRatio"
+
+	// DatasetConsumersMetric scales the workers to serve the target number of pods consuming the dataset per worker
+	DatasetConsumersMetric RuntimeAutoscalerMetricType = "DatasetConsumers"
+)
+
+// RuntimeScaleTargetReference refers to the runtime to scale, the runtime must expose the scale subresource
+type RuntimeScaleTargetReference struct {
+	// APIVersion is the API version of the runtime. Defaults to data.fluid.io/v1alpha1.
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind is the kind of the runtime, e.g. AlluxioRuntime
+	// +required
+	Kind string `json:"kind"`
+
+	// Name is the name of the runtime, which is the same as the name of its dataset
+	// +required
+	Name string `json:"name"`
+}
+
+// RuntimeAutoscalerMetric defines a metric and its target value used to compute the desired worker replicas
+type RuntimeAutoscalerMetric struct {
+	// Type is the type of the metric, one of CacheUsage, CacheHitRatio and DatasetConsumers
+	// +kubebuilder:validation:Enum=CacheUsage;CacheHitRatio;DatasetConsumers
+	// +required
+	Type RuntimeAutoscalerMetricType `json:"type"`
+
+	// TargetValue is the target value of the metric. It's a percentage for CacheUsage and CacheHitRatio,
+	// and the number of consumer pods per worker for DatasetConsumers.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	TargetValue int32 `json:"targetValue"`
+}
+
+// RuntimeAutoscalerSpec defines the desired state of RuntimeAutoscaler
+type RuntimeAutoscalerSpec struct {
+	// ScaleTargetRef refers to the runtime whose worker replicas are scaled
+	// +required
+	ScaleTargetRef RuntimeScaleTargetReference `json:"scaleTargetRef"`
+
+	// MinReplicas is the lower limit of the worker replicas. Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit of the worker replicas, it cannot be less than MinReplicas.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Metrics are used to compute the desired worker replicas, the largest replicas computed from the metrics is used.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Metrics []RuntimeAutoscalerMetric `json:"metrics"`
+
+	// StabilizationWindowSeconds is the number of seconds for which the past recommendations are considered
+	// when scaling in, the workers are scaled in to the largest recommendation in the window. Defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StabilizationWindowSeconds *int32 `json:"stabilizationWindowSeconds,omitempty"`
+}
+
+// RuntimeAutoscalerMetricStatus describes the observed value of a metric
+type RuntimeAutoscalerMetricStatus struct {
+	// Type is the type of the metric
+	Type RuntimeAutoscalerMetricType `json:"type"`
+
+	// CurrentValue is the observed value of the metric, with the same unit as the target value
+	CurrentValue int32 `json:"currentValue"`
+}
+
+// RuntimeAutoscalerRecommendation is the worker replicas recommended by the metrics at a point of time
+type RuntimeAutoscalerRecommendation struct {
+	// Replicas is the recommended worker replicas
+	Replicas int32 `json:"replicas"`
+
+	// Timestamp is the time when the recommendation is computed
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// RuntimeAutoscalerStatus defines the observed state of RuntimeAutoscaler
+type RuntimeAutoscalerStatus struct {
+	// CurrentReplicas is the worker replicas of the runtime observed by the autoscaler
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+
+	// DesiredReplicas is the worker replicas last computed by the autoscaler
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+
+	// LastScaleTime is the last time the autoscaler changed the worker replicas of the runtime
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// CurrentMetrics are the last observed values of the metrics, the metrics without available values are omitted
+	// +optional
+	CurrentMetrics []RuntimeAutoscalerMetricStatus `json:"currentMetrics,omitempty"`
+
+	// Recommendations are the recommended worker replicas in the stabilization window
+	// +optional
+	Recommendations []RuntimeAutoscalerRecommendation `json:"recommendations,omitempty"`
+
+	// Conditions consists of the information on whether the autoscaler is able to compute and apply the worker replicas
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=`.spec.scaleTargetRef.kind`
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=`.spec.scaleTargetRef.name`
+// +kubebuilder:printcolumn:name="MinReplicas",type="integer",JSONPath=`.spec.minReplicas`
+// +kubebuilder:printcolumn:name="MaxReplicas",type="integer",JSONPath=`.spec.maxReplicas`
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=`.status.currentReplicas`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=rtas
+// +genclient
+
+// RuntimeAutoscaler is the Schema for the runtimeautoscalers API, it scales the workers of a runtime
+// by the cache states of the runtime and the consumers of its dataset.
+type RuntimeAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuntimeAutoscalerSpec   `json:"spec,omitempty"`
+	Status RuntimeAutoscalerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// RuntimeAutoscalerList contains a list of RuntimeAutoscaler
+type RuntimeAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RuntimeAutoscaler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RuntimeAutoscaler{}, &RuntimeAutoscalerList{})
+}
@@ -119,6 +119,16 @@ type RuntimeStatus struct {
 	// Selector is used for auto-scaling
 	Selector string `json:"selector,omitempty"` // this must be the string form of the selector
 
+	// IdleSince is the time since when no pod mounts the dataset of the runtime, it's only recorded
+	// when the hibernation policy of the runtime is set.
+	// +optional
+	IdleSince *metav1.Time `json:"idleSince,omitempty"`
+
+	// Upgrade records the current and target versions of the runtime components.
+	// Only AlluxioRuntime supports the ordered upgrade and sets it, it's always empty for the other runtimes.
+	// +optional
+	Upgrade *RuntimeUpgradeStatus `json:"upgrade,omitempty"`
+
 	// APIGatewayStatus represents rest api gateway status
 	APIGatewayStatus *APIGatewayStatus `json:"apiGateway,omitempty"`
 
@@ -134,6 +144,40 @@ type RuntimeStatus struct {
 	CacheAffinity *corev1.NodeAffinity `json:"cacheAffinity,omitempty"`
 }
 
+// RuntimeUpgradeStatus records the versions of the runtime components
+type RuntimeUpgradeStatus struct {
+	// Master is the version of the master
+	// +optional
+	Master ComponentVersionStatus `json:"master,omitempty"`
+
+	// Worker is the version of the workers
+	// +optional
+	Worker ComponentVersionStatus `json:"worker,omitempty"`
+
+	// Fuse is the version of the fuse
+	// +optional
+	Fuse ComponentVersionStatus `json:"fuse,omitempty"`
+}
+
+// ComponentVersionStatus records the current and target versions of a runtime component
+type ComponentVersionStatus struct {
+	// CurrentVersion is the image which all the pods of the component are running
+	// +optional
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// TargetVersion is the image which the component is upgraded to
+	// +optional
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// UpdatedReplicas is the number of the pods running the target version
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// Replicas is the number of the pods of the component
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+}
+
 // OperationStatus defines the observed state of operation
 type OperationStatus struct {
 	// Phase describes current phase of operation
@@ -150,11 +194,78 @@ type OperationStatus struct {
 	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
 	// LastSuccessfulTime is the last time the cron operation successfully completed
 	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// RecentRuns records the recent runs of the cron operation, the newest run comes first
+	// +optional
+	RecentRuns []OperationRun `json:"recentRuns,omitempty"`
 	// WaitingStatus stores information about waiting operation.
 	WaitingFor WaitingStatus `json:"waitingFor,omitempty"`
 
 	// NodeAffinity records the node affinity for operation pods
 	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty"`
+
+	// Progress reports the live progress of the operation while it's executing
+	// +optional
+	Progress *OperationProgress `json:"progress,omitempty"`
+
+	// Attempts is the number of times the operation has been executed, retries included
+	// +optional
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// StartTime is the time when the operation started executing, it's kept across retries
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// OperationRun describes a run of the cron operation
+type OperationRun struct {
+	// JobName is the name of the job created for the run
+	JobName string `json:"jobName"`
+	// Phase is the phase of the run, one of Executing, Complete and Failed
+	Phase common.Phase `json:"phase"`
+	// StartTime is the time when the run started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time when the run finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Duration is the time spent on the run
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
+// OperationProgress describes the progress reported by the operation pod
+type OperationProgress struct {
+	// ProcessedFiles is the number of files which have been processed
+	// +optional
+	ProcessedFiles int64 `json:"processedFiles,omitempty"`
+
+	// TotalFiles is the number of files to process, 0 if unknown
+	// +optional
+	TotalFiles int64 `json:"totalFiles,omitempty"`
+
+	// ProcessedBytes is the number of bytes which have been processed
+	// +optional
+	ProcessedBytes int64 `json:"processedBytes,omitempty"`
+
+	// TotalBytes is the number of bytes to process, 0 if unknown
+	// +optional
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+
+	// Percentage is the processed percentage of the total bytes, or total files if total bytes is unknown, e.g. 42.0%
+	// +optional
+	Percentage string `json:"percentage,omitempty"`
+
+	// Throughput is the average bytes processed per second, e.g. 10.00MiB/s
+	// +optional
+	Throughput string `json:"throughput,omitempty"`
+
+	// ETA is the estimated time to finish the operation, e.g. 1h2m3s
+	// +optional
+	ETA string `json:"eta,omitempty"`
+
+	// LastUpdateTime is the last time the progress was updated
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
 }
 
 type RuntimePhase string
@@ -164,6 +275,7 @@ const (
 	RuntimePhaseNotReady     RuntimePhase = "NotReady"
 	RuntimePhasePartialReady RuntimePhase = "PartialReady"
 	RuntimePhaseReady        RuntimePhase = "Ready"
+	RuntimePhaseHibernated   RuntimePhase = "Hibernated"
 )
 
 // RuntimeConditionType indicates valid conditions type of a runtime
@@ -191,6 +303,16 @@ const (
 	RuntimeFusesScaledIn RuntimeConditionType = "FusesScaledIn"
 	// RuntimeFusesScaledOut means the fuses of runtime just scaled out
 	RuntimeFusesScaledOut RuntimeConditionType = "FusesScaledOut"
+	// RuntimeHibernated means the runtime is scaled to zero because its dataset is idle
+	RuntimeHibernated RuntimeConditionType = "Hibernated"
+	// RuntimeWorkersScalingIn means the workers to be removed are being drained before scaling in
+	RuntimeWorkersScalingIn RuntimeConditionType = "ScalingIn"
+	// RuntimeUpgrading means the runtime components are being upgraded to a new version
+	RuntimeUpgrading RuntimeConditionType = "Upgrading"
+	// RuntimeProfileResolved means the inheritance chain of the runtime profile is resolved
+	RuntimeProfileResolved RuntimeConditionType = "ProfileResolved"
+	// RuntimeMetadataRestoring means the metadata of the master is being restored from a DataBackup
+	RuntimeMetadataRestoring RuntimeConditionType = "MetadataRestoring"
 )
 
 const (
@@ -214,6 +336,42 @@ const (
 	RuntimeFusesScaledInReason = "Fuses scaled in"
 	// RuntimeFusesScaledInReason means the fuses of runtime just scaled out
 	RuntimeFusesScaledOutReason = "Fuses scaled out"
+	// RuntimeHibernatedReason means the runtime hibernates because its dataset is idle
+	RuntimeHibernatedReason = "Dataset is idle"
+	// RuntimeWokenUpReason means the runtime is woken up because its dataset is used again
+	RuntimeWokenUpReason = "Dataset is used"
+	// RuntimeWorkersDrainingReason means the workers to be removed are being drained
+	RuntimeWorkersDrainingReason = "Draining workers"
+	// RuntimeWorkersDrainedReason means the workers to be removed are drained and scaled in
+	RuntimeWorkersDrainedReason = "Workers drained"
+	// RuntimeWorkersDrainTimeoutReason means the workers are scaled in before they are drained because of the timeout
+	RuntimeWorkersDrainTimeoutReason = "Draining workers timed out"
+	// RuntimeWorkersScaleInCancelledReason means the scale-in is cancelled because the replicas are changed
+	RuntimeWorkersScaleInCancelledReason = "Scaling in cancelled"
+	// RuntimeUpgradingMasterReason means the master of runtime is being upgraded
+	RuntimeUpgradingMasterReason = "Upgrading master"
+	// RuntimeUpgradingWorkersReason means the workers of runtime are being upgraded
+	RuntimeUpgradingWorkersReason = "Upgrading workers"
+	// RuntimeUpgradePausedReason means the upgrade is paused because the runtime is not healthy
+	RuntimeUpgradePausedReason = "Upgrade paused"
+	// RuntimeUpgradedReason means the master and workers are upgraded and the fuse is marked as outdated
+	RuntimeUpgradedReason = "Upgrade completed"
+	// RuntimeProfileResolvedReason means the runtime profile is merged with all its parent profiles
+	RuntimeProfileResolvedReason = "Profile resolved"
+	// RuntimeParentProfileNotFoundReason means a parent profile in the inheritance chain is not found
+	RuntimeParentProfileNotFoundReason = "Parent profile not found"
+	// RuntimeProfileCycleReason means the inheritance chain of the runtime profile has a cycle
+	RuntimeProfileCycleReason = "Profile inheritance cycle"
+	// RuntimeProfileNoFileSystemTypeReason means no profile in the inheritance chain sets the file system type
+	RuntimeProfileNoFileSystemTypeReason = "Profile without file system type"
+	// RuntimeRestoringMetadataReason means the master is restarted to restore the metadata from a DataBackup
+	RuntimeRestoringMetadataReason = "Restoring metadata"
+	// RuntimeMetadataRestoredReason means the master is restarted with the metadata restored from a DataBackup
+	RuntimeMetadataRestoredReason = "Metadata restored"
+	// RuntimeNoDataBackupToRestoreReason means the metadata of the master is lost and no DataBackup can restore it
+	RuntimeNoDataBackupToRestoreReason = "No backup to restore"
+	// RuntimeMetadataRestoreFailedReason means the metadata of the master is lost and the DataBackup can't restore it
+	RuntimeMetadataRestoreFailedReason = "Metadata restore failed"
 )
 
 // Condition describes the state of the cache at a certain point.
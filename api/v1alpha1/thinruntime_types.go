@@ -64,6 +64,11 @@ type ThinRuntimeSpec struct {
 	// +optional
 	RuntimeManagement RuntimeManagement `json:"management,omitempty"`
 
+	// UpgradeStrategy defines how the fuse pods are upgraded when the fuse is changed,
+	// the one in the ThinRuntimeProfile is used if it's not set.
+	// +optional
+	UpgradeStrategy FuseUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+
 	// ImagePullSecrets that will be used to pull images
 	// +optional
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
@@ -93,14 +98,18 @@ type ThinCompTemplateSpec struct {
 
 	// Ports used thinRuntime
 	// +optional
-	Ports []corev1.ContainerPort `json:"ports,omitempty"`
+	// +patchMergeKey=containerPort
+	// +patchStrategy=merge
+	Ports []corev1.ContainerPort `json:"ports,omitempty" patchStrategy:"merge" patchMergeKey:"containerPort"`
 
 	// Resources that will be requested by thinRuntime component.
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 
 	// Environment variables that will be used by thinRuntime component.
-	Env []corev1.EnvVar `json:"env,omitempty"`
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Env []corev1.EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// Enabled or Disabled for the components.
 	// +optional
@@ -112,7 +121,9 @@ type ThinCompTemplateSpec struct {
 
 	// VolumeMounts specifies the volumes listed in ".spec.volumes" to mount into runtime component's filesystem.
 	// +optional
-	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// +patchMergeKey=mountPath
+	// +patchStrategy=merge
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty" patchStrategy:"merge" patchMergeKey:"mountPath"`
 
 	// livenessProbe of thin fuse pod
 	// +optional
@@ -144,10 +155,14 @@ type ThinFuseSpec struct {
 
 	// Ports used thinRuntime
 	// +optional
-	Ports []corev1.ContainerPort `json:"ports,omitempty"`
+	// +patchMergeKey=containerPort
+	// +patchStrategy=merge
+	Ports []corev1.ContainerPort `json:"ports,omitempty" patchStrategy:"merge" patchMergeKey:"containerPort"`
 
 	// Environment variables that will be used by thinRuntime Fuse
-	Env []corev1.EnvVar `json:"env,omitempty"`
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Env []corev1.EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// Command that will be passed to thinRuntime Fuse
 	Command []string `json:"command,omitempty"`
@@ -190,7 +205,9 @@ type ThinFuseSpec struct {
 
 	// VolumeMounts specifies the volumes listed in ".spec.volumes" to mount into the thinruntime component's filesystem.
 	// +optional
-	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// +patchMergeKey=mountPath
+	// +patchStrategy=merge
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty" patchStrategy:"merge" patchMergeKey:"mountPath"`
 
 	// Lifecycle describes actions that the management system should take in response to container lifecycle events.
 	Lifecycle *corev1.Lifecycle `json:"lifecycle,omitempty"`
@@ -202,6 +219,7 @@ type ThinFuseSpec struct {
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.currentWorkerNumberScheduled,selectorpath=.status.selector
 // +genclient
 
 // ThinRuntime is the Schema for the thinruntimes API
@@ -31,9 +31,15 @@ const (
 
 // ThinRuntimeProfileSpec defines the desired state of ThinRuntimeProfile
 type ThinRuntimeProfileSpec struct {
-	// file system of thinRuntime
-	// +required
-	FileSystemType string `json:"fileSystemType"`
+	// ParentProfileName is the name of the ThinRuntimeProfile to inherit from. The fields not set in this profile
+	// are inherited from the parent profile, and the env, ports and volume mounts of worker and fuse are merged
+	// with the ones of the parent profile by their keys.
+	// +optional
+	ParentProfileName string `json:"parentProfileName,omitempty"`
+
+	// file system of thinRuntime, it's required unless it's inherited from the parent profile
+	// +optional
+	FileSystemType string `json:"fileSystemType,omitempty"`
 
 	// ImagePullSecrets that will be used to pull images
 	// +optional
@@ -47,12 +53,49 @@ type ThinRuntimeProfileSpec struct {
 
 	// Volumes is the list of Kubernetes volumes that can be mounted by runtime components and/or fuses.
 	// +optional
-	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Volumes []corev1.Volume `json:"volumes,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// NodePublishSecretPolicy describes the policy to decide which to do with node publish secret when mounting an existing persistent volume.
 	// +kubebuilder:default=MountNodePublishSecretIfExists
 	// +kubebuilder:validation:Enum=NotMountNodePublishSecret;MountNodePublishSecretIfExists;CopyNodePublishSecretAndMountIfNotExists
 	NodePublishSecretPolicy NodePublishSecretPolicy `json:"nodePublishSecretPolicy,omitempty"`
+
+	// Loader defines how to load data into the file system of thinRuntime, DataLoad is not supported if it is not set.
+	// +optional
+	Loader *ThinLoaderSpec `json:"loader,omitempty"`
+
+	// UpgradeStrategy defines how the fuse pods are upgraded when the fuse is changed. Set it to HotUpgrade
+	// only if the fuse process of the file system supports taking over the fuse session.
+	// +optional
+	UpgradeStrategy FuseUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+}
+
+// ThinLoaderSpec defines the native warm-up tooling used by DataLoad on thinRuntime.
+// The DataLoad job mounts the dataset at $FLUID_DATALOAD_MOUNT_PATH, and the targets in DataLoad.spec.target are
+// passed by the env FLUID_DATALOAD_DATA_PATH and FLUID_DATALOAD_PATH_REPLICAS separated by colons,
+// so the command and args can refer to them, e.g. ["sh", "-c", "warmup --paths $FLUID_DATALOAD_DATA_PATH"].
+type ThinLoaderSpec struct {
+	// Image for the DataLoad job, defaults to the image of thinRuntime fuse
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Image tag for the DataLoad job
+	// +optional
+	ImageTag string `json:"imageTag,omitempty"`
+
+	// Command that will be passed to the DataLoad job
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Arguments that will be passed to the DataLoad job
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// VolumeMounts specifies the volumes listed in ".spec.volumes" to mount into the DataLoad job.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
 }
 
 // ThinRuntimeProfileStatus defines the observed state of ThinRuntimeProfile
@@ -8,7 +8,9 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -276,6 +278,8 @@ func (in *AlluxioRuntimeSpec) DeepCopyInto(out *AlluxioRuntimeSpec) {
 	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
 	in.RuntimeManagement.DeepCopyInto(&out.RuntimeManagement)
+	in.ScaleInPolicy.DeepCopyInto(&out.ScaleInPolicy)
+	in.UpgradePolicy.DeepCopyInto(&out.UpgradePolicy)
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
 		*out = make([]v1.LocalObjectReference, len(*in))
@@ -407,6 +411,21 @@ func (in *CacheRuntimeClientSpec) DeepCopy() *CacheRuntimeClientSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheRuntimeHealthCheckOutput) DeepCopyInto(out *CacheRuntimeHealthCheckOutput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheRuntimeHealthCheckOutput.
+func (in *CacheRuntimeHealthCheckOutput) DeepCopy() *CacheRuntimeHealthCheckOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheRuntimeHealthCheckOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheRuntimeList) DeepCopyInto(out *CacheRuntimeList) {
 	*out = *in
@@ -475,6 +494,36 @@ func (in *CacheRuntimeMountUfsOutput) DeepCopy() *CacheRuntimeMountUfsOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheRuntimeReportCapacityOutput) DeepCopyInto(out *CacheRuntimeReportCapacityOutput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheRuntimeReportCapacityOutput.
+func (in *CacheRuntimeReportCapacityOutput) DeepCopy() *CacheRuntimeReportCapacityOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheRuntimeReportCapacityOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheRuntimeReportSummary) DeepCopyInto(out *CacheRuntimeReportSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheRuntimeReportSummary.
+func (in *CacheRuntimeReportSummary) DeepCopy() *CacheRuntimeReportSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheRuntimeReportSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheRuntimeSpec) DeepCopyInto(out *CacheRuntimeSpec) {
 	*out = *in
@@ -545,6 +594,41 @@ func (in *CacheRuntimeStatus) DeepCopy() *CacheRuntimeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheRuntimeSyncMetadataOutput) DeepCopyInto(out *CacheRuntimeSyncMetadataOutput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheRuntimeSyncMetadataOutput.
+func (in *CacheRuntimeSyncMetadataOutput) DeepCopy() *CacheRuntimeSyncMetadataOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheRuntimeSyncMetadataOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheRuntimeUnmountUfsOutput) DeepCopyInto(out *CacheRuntimeUnmountUfsOutput) {
+	*out = *in
+	if in.Unmounted != nil {
+		in, out := &in.Unmounted, &out.Unmounted
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheRuntimeUnmountUfsOutput.
+func (in *CacheRuntimeUnmountUfsOutput) DeepCopy() *CacheRuntimeUnmountUfsOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheRuntimeUnmountUfsOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheRuntimeWorkerSpec) DeepCopyInto(out *CacheRuntimeWorkerSpec) {
 	*out = *in
@@ -642,6 +726,21 @@ func (in *ComponentServiceConfig) DeepCopy() *ComponentServiceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVersionStatus) DeepCopyInto(out *ComponentVersionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersionStatus.
+func (in *ComponentVersionStatus) DeepCopy() *ComponentVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -788,6 +887,21 @@ func (in *DataBackupSpec) DeepCopyInto(out *DataBackupSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataBackupSpec.
@@ -800,6 +914,299 @@ func (in *DataBackupSpec) DeepCopy() *DataBackupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataEvict) DeepCopyInto(out *DataEvict) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEvict.
+func (in *DataEvict) DeepCopy() *DataEvict {
+	if in == nil {
+		return nil
+	}
+	out := new(DataEvict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataEvict) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataEvictList) DeepCopyInto(out *DataEvictList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataEvict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEvictList.
+func (in *DataEvictList) DeepCopy() *DataEvictList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataEvictList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataEvictList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataEvictSpec) DeepCopyInto(out *DataEvictSpec) {
+	*out = *in
+	out.Dataset = in.Dataset
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make([]EvictTargetPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.RunAfter != nil {
+		in, out := &in.RunAfter, &out.RunAfter
+		*out = new(OperationRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataEvictSpec.
+func (in *DataEvictSpec) DeepCopy() *DataEvictSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataEvictSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlow) DeepCopyInto(out *DataFlow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlow.
+func (in *DataFlow) DeepCopy() *DataFlow {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataFlow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowAffinityStrategy) DeepCopyInto(out *DataFlowAffinityStrategy) {
+	*out = *in
+	if in.Prefers != nil {
+		in, out := &in.Prefers, &out.Prefers
+		*out = make([]Prefer, len(*in))
+		copy(*out, *in)
+	}
+	if in.Requires != nil {
+		in, out := &in.Requires, &out.Requires
+		*out = make([]Require, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowAffinityStrategy.
+func (in *DataFlowAffinityStrategy) DeepCopy() *DataFlowAffinityStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowAffinityStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowList) DeepCopyInto(out *DataFlowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataFlow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowList.
+func (in *DataFlowList) DeepCopy() *DataFlowList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataFlowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowSpec) DeepCopyInto(out *DataFlowSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]DataFlowStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowSpec.
+func (in *DataFlowSpec) DeepCopy() *DataFlowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowStatus) DeepCopyInto(out *DataFlowStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]DataFlowStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStatus.
+func (in *DataFlowStatus) DeepCopy() *DataFlowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowStep) DeepCopyInto(out *DataFlowStep) {
+	*out = *in
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AffinityStrategy != nil {
+		in, out := &in.AffinityStrategy, &out.AffinityStrategy
+		*out = new(DataFlowAffinityStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataLoad != nil {
+		in, out := &in.DataLoad, &out.DataLoad
+		*out = new(DataLoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataProcess != nil {
+		in, out := &in.DataProcess, &out.DataProcess
+		*out = new(DataProcessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataMigrate != nil {
+		in, out := &in.DataMigrate, &out.DataMigrate
+		*out = new(DataMigrateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStep.
+func (in *DataFlowStep) DeepCopy() *DataFlowStep {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowStepStatus) DeepCopyInto(out *DataFlowStepStatus) {
+	*out = *in
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(ObjectRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStepStatus.
+func (in *DataFlowStepStatus) DeepCopy() *DataFlowStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataLoad) DeepCopyInto(out *DataLoad) {
 	*out = *in
@@ -859,6 +1266,26 @@ func (in *DataLoadList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLoadManifest) DeepCopyInto(out *DataLoadManifest) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLoadManifest.
+func (in *DataLoadManifest) DeepCopy() *DataLoadManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLoadManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataLoadSpec) DeepCopyInto(out *DataLoadSpec) {
 	*out = *in
@@ -868,6 +1295,21 @@ func (in *DataLoadSpec) DeepCopyInto(out *DataLoadSpec) {
 		*out = make([]TargetPath, len(*in))
 		copy(*out, *in)
 	}
+	if in.Manifest != nil {
+		in, out := &in.Manifest, &out.Manifest
+		*out = new(DataLoadManifest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Options != nil {
 		in, out := &in.Options, &out.Options
 		*out = make(map[string]string, len(*in))
@@ -895,6 +1337,21 @@ func (in *DataLoadSpec) DeepCopyInto(out *DataLoadSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessfulJobsHistoryLimit != nil {
+		in, out := &in.SuccessfulJobsHistoryLimit, &out.SuccessfulJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedJobsHistoryLimit != nil {
+		in, out := &in.FailedJobsHistoryLimit, &out.FailedJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	if in.RunAfter != nil {
 		in, out := &in.RunAfter, &out.RunAfter
 		*out = new(OperationRef)
@@ -905,6 +1362,26 @@ func (in *DataLoadSpec) DeepCopyInto(out *DataLoadSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
 	in.Resources.DeepCopyInto(&out.Resources)
 }
 
@@ -990,6 +1467,21 @@ func (in *DataMigrateSpec) DeepCopyInto(out *DataMigrateSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessfulJobsHistoryLimit != nil {
+		in, out := &in.SuccessfulJobsHistoryLimit, &out.SuccessfulJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedJobsHistoryLimit != nil {
+		in, out := &in.FailedJobsHistoryLimit, &out.FailedJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
@@ -1020,6 +1512,26 @@ func (in *DataMigrateSpec) DeepCopyInto(out *DataMigrateSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
 	in.Resources.DeepCopyInto(&out.Resources)
 	if in.ParallelOptions != nil {
 		in, out := &in.ParallelOptions, &out.ParallelOptions
@@ -1139,6 +1651,21 @@ func (in *DataProcessSpec) DeepCopyInto(out *DataProcessSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataProcessSpec.
@@ -1607,6 +2134,21 @@ func (in *EncryptOptionSource) DeepCopy() *EncryptOptionSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictTargetPath) DeepCopyInto(out *EvictTargetPath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictTargetPath.
+func (in *EvictTargetPath) DeepCopy() *EvictTargetPath {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictTargetPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionCommonEntry) DeepCopyInto(out *ExecutionCommonEntry) {
 	*out = *in
@@ -1635,6 +2177,26 @@ func (in *ExecutionEntries) DeepCopyInto(out *ExecutionEntries) {
 		*out = new(ExecutionCommonEntry)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UnmountUFS != nil {
+		in, out := &in.UnmountUFS, &out.UnmountUFS
+		*out = new(ExecutionCommonEntry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyncMetadata != nil {
+		in, out := &in.SyncMetadata, &out.SyncMetadata
+		*out = new(ExecutionCommonEntry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(ExecutionCommonEntry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReportCapacity != nil {
+		in, out := &in.ReportCapacity, &out.ReportCapacity
+		*out = new(ExecutionCommonEntry)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ReportSummary != nil {
 		in, out := &in.ReportSummary, &out.ReportSummary
 		*out = new(ExecutionCommonEntry)
@@ -1719,6 +2281,26 @@ func (in *ExtraResourcesComponentDependency) DeepCopy() *ExtraResourcesComponent
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FuseUpgradeStrategy) DeepCopyInto(out *FuseUpgradeStrategy) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FuseUpgradeStrategy.
+func (in *FuseUpgradeStrategy) DeepCopy() *FuseUpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(FuseUpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HCFSStatus) DeepCopyInto(out *HCFSStatus) {
 	*out = *in
@@ -1749,6 +2331,21 @@ func (in *HeadlessRuntimeComponentService) DeepCopy() *HeadlessRuntimeComponentS
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationPolicy) DeepCopyInto(out *HibernationPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationPolicy.
+func (in *HibernationPolicy) DeepCopy() *HibernationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostPathMediumSource) DeepCopyInto(out *HostPathMediumSource) {
 	*out = *in
@@ -2056,6 +2653,7 @@ func (in *JindoRuntimeSpec) DeepCopyInto(out *JindoRuntimeSpec) {
 		}
 	}
 	in.CleanCachePolicy.DeepCopyInto(&out.CleanCachePolicy)
+	in.ScaleInPolicy.DeepCopyInto(&out.ScaleInPolicy)
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]v1.Volume, len(*in))
@@ -2294,6 +2892,7 @@ func (in *JuiceFSRuntimeSpec) DeepCopyInto(out *JuiceFSRuntimeSpec) {
 	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
 	in.RuntimeManagement.DeepCopyInto(&out.RuntimeManagement)
+	in.UpgradeStrategy.DeepCopyInto(&out.UpgradeStrategy)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JuiceFSRuntimeSpec.
@@ -2351,12 +2950,27 @@ func (in *Metadata) DeepCopyInto(out *Metadata) {
 	out.Selector = in.Selector
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metadata.
-func (in *Metadata) DeepCopy() *Metadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metadata.
+func (in *Metadata) DeepCopy() *Metadata {
+	if in == nil {
+		return nil
+	}
+	out := new(Metadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataRestorePolicy) DeepCopyInto(out *MetadataRestorePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataRestorePolicy.
+func (in *MetadataRestorePolicy) DeepCopy() *MetadataRestorePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(Metadata)
+	out := new(MetadataRestorePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2438,6 +3052,25 @@ func (in *ObjectRef) DeepCopy() *ObjectRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationProgress) DeepCopyInto(out *OperationProgress) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationProgress.
+func (in *OperationProgress) DeepCopy() *OperationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationRef) DeepCopyInto(out *OperationRef) {
 	*out = *in
@@ -2455,6 +3088,29 @@ func (in *OperationRef) DeepCopy() *OperationRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationRun) DeepCopyInto(out *OperationRun) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationRun.
+func (in *OperationRun) DeepCopy() *OperationRun {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationStatus) DeepCopyInto(out *OperationStatus) {
 	*out = *in
@@ -2480,12 +3136,28 @@ func (in *OperationStatus) DeepCopyInto(out *OperationStatus) {
 		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
 		*out = (*in).DeepCopy()
 	}
+	if in.RecentRuns != nil {
+		in, out := &in.RecentRuns, &out.RecentRuns
+		*out = make([]OperationRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.WaitingFor.DeepCopyInto(&out.WaitingFor)
 	if in.NodeAffinity != nil {
 		in, out := &in.NodeAffinity, &out.NodeAffinity
 		*out = new(v1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(OperationProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationStatus.
@@ -2614,6 +3286,180 @@ func (in *Runtime) DeepCopy() *Runtime {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeAutoscaler) DeepCopyInto(out *RuntimeAutoscaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeAutoscaler.
+func (in *RuntimeAutoscaler) DeepCopy() *RuntimeAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuntimeAutoscaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeAutoscalerList) DeepCopyInto(out *RuntimeAutoscalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RuntimeAutoscaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeAutoscalerList.
+func (in *RuntimeAutoscalerList) DeepCopy() *RuntimeAutoscalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeAutoscalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuntimeAutoscalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeAutoscalerMetric) DeepCopyInto(out *RuntimeAutoscalerMetric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeAutoscalerMetric.
+func (in *RuntimeAutoscalerMetric) DeepCopy() *RuntimeAutoscalerMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeAutoscalerMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeAutoscalerMetricStatus) DeepCopyInto(out *RuntimeAutoscalerMetricStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeAutoscalerMetricStatus.
+func (in *RuntimeAutoscalerMetricStatus) DeepCopy() *RuntimeAutoscalerMetricStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeAutoscalerMetricStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeAutoscalerRecommendation) DeepCopyInto(out *RuntimeAutoscalerRecommendation) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeAutoscalerRecommendation.
+func (in *RuntimeAutoscalerRecommendation) DeepCopy() *RuntimeAutoscalerRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeAutoscalerRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeAutoscalerSpec) DeepCopyInto(out *RuntimeAutoscalerSpec) {
+	*out = *in
+	out.ScaleTargetRef = in.ScaleTargetRef
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]RuntimeAutoscalerMetric, len(*in))
+		copy(*out, *in)
+	}
+	if in.StabilizationWindowSeconds != nil {
+		in, out := &in.StabilizationWindowSeconds, &out.StabilizationWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeAutoscalerSpec.
+func (in *RuntimeAutoscalerSpec) DeepCopy() *RuntimeAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeAutoscalerStatus) DeepCopyInto(out *RuntimeAutoscalerStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentMetrics != nil {
+		in, out := &in.CurrentMetrics, &out.CurrentMetrics
+		*out = make([]RuntimeAutoscalerMetricStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make([]RuntimeAutoscalerRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeAutoscalerStatus.
+func (in *RuntimeAutoscalerStatus) DeepCopy() *RuntimeAutoscalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeAutoscalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeComponentCommonSpec) DeepCopyInto(out *RuntimeComponentCommonSpec) {
 	*out = *in
@@ -2820,6 +3666,16 @@ func (in *RuntimeManagement) DeepCopyInto(out *RuntimeManagement) {
 	*out = *in
 	in.CleanCachePolicy.DeepCopyInto(&out.CleanCachePolicy)
 	in.MetadataSyncPolicy.DeepCopyInto(&out.MetadataSyncPolicy)
+	if in.HibernationPolicy != nil {
+		in, out := &in.HibernationPolicy, &out.HibernationPolicy
+		*out = new(HibernationPolicy)
+		**out = **in
+	}
+	if in.MetadataRestorePolicy != nil {
+		in, out := &in.MetadataRestorePolicy, &out.MetadataRestorePolicy
+		*out = new(MetadataRestorePolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeManagement.
@@ -2832,6 +3688,21 @@ func (in *RuntimeManagement) DeepCopy() *RuntimeManagement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeScaleTargetReference) DeepCopyInto(out *RuntimeScaleTargetReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeScaleTargetReference.
+func (in *RuntimeScaleTargetReference) DeepCopy() *RuntimeScaleTargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeScaleTargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeStatus) DeepCopyInto(out *RuntimeStatus) {
 	*out = *in
@@ -2849,6 +3720,15 @@ func (in *RuntimeStatus) DeepCopyInto(out *RuntimeStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.IdleSince != nil {
+		in, out := &in.IdleSince, &out.IdleSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(RuntimeUpgradeStatus)
+		**out = **in
+	}
 	if in.APIGatewayStatus != nil {
 		in, out := &in.APIGatewayStatus, &out.APIGatewayStatus
 		*out = new(APIGatewayStatus)
@@ -2964,6 +3844,44 @@ func (in *RuntimeTopology) DeepCopy() *RuntimeTopology {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeUpgradeStatus) DeepCopyInto(out *RuntimeUpgradeStatus) {
+	*out = *in
+	out.Master = in.Master
+	out.Worker = in.Worker
+	out.Fuse = in.Fuse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeUpgradeStatus.
+func (in *RuntimeUpgradeStatus) DeepCopy() *RuntimeUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleInPolicy) DeepCopyInto(out *ScaleInPolicy) {
+	*out = *in
+	if in.DrainTimeoutSeconds != nil {
+		in, out := &in.DrainTimeoutSeconds, &out.DrainTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleInPolicy.
+func (in *ScaleInPolicy) DeepCopy() *ScaleInPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleInPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptProcessor) DeepCopyInto(out *ScriptProcessor) {
 	*out = *in
@@ -3220,6 +4138,38 @@ func (in *ThinFuseSpec) DeepCopy() *ThinFuseSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThinLoaderSpec) DeepCopyInto(out *ThinLoaderSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThinLoaderSpec.
+func (in *ThinLoaderSpec) DeepCopy() *ThinLoaderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThinLoaderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThinRuntime) DeepCopyInto(out *ThinRuntime) {
 	*out = *in
@@ -3355,6 +4305,12 @@ func (in *ThinRuntimeProfileSpec) DeepCopyInto(out *ThinRuntimeProfileSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Loader != nil {
+		in, out := &in.Loader, &out.Loader
+		*out = new(ThinLoaderSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.UpgradeStrategy.DeepCopyInto(&out.UpgradeStrategy)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThinRuntimeProfileSpec.
@@ -3401,6 +4357,7 @@ func (in *ThinRuntimeSpec) DeepCopyInto(out *ThinRuntimeSpec) {
 		}
 	}
 	in.RuntimeManagement.DeepCopyInto(&out.RuntimeManagement)
+	in.UpgradeStrategy.DeepCopyInto(&out.UpgradeStrategy)
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
 		*out = make([]v1.LocalObjectReference, len(*in))
@@ -3440,6 +4397,26 @@ func (in *TieredStore) DeepCopy() *TieredStore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePolicy) DeepCopyInto(out *UpgradePolicy) {
+	*out = *in
+	if in.WorkerBatchSize != nil {
+		in, out := &in.WorkerBatchSize, &out.WorkerBatchSize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePolicy.
+func (in *UpgradePolicy) DeepCopy() *UpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
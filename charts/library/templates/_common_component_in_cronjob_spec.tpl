@@ -0,0 +1,17 @@
+{{/*
+Common policies in CronJob.Spec of data operations. The values of the data operation, e.g. .Values.dataloader, should be passed as the context.
+*/}}
+{{- define "library.fluid.dataoperation.cronJobPolicySpec" -}}
+{{- with .concurrencyPolicy }}
+concurrencyPolicy: {{ . }}
+{{- end }}
+{{- if hasKey . "startingDeadlineSeconds" }}
+startingDeadlineSeconds: {{ .startingDeadlineSeconds | int64 }}
+{{- end }}
+{{- if hasKey . "successfulJobsHistoryLimit" }}
+successfulJobsHistoryLimit: {{ .successfulJobsHistoryLimit | int }}
+{{- end }}
+{{- if hasKey . "failedJobsHistoryLimit" }}
+failedJobsHistoryLimit: {{ .failedJobsHistoryLimit | int }}
+{{- end }}
+{{- end }}
@@ -41,11 +41,13 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
 	databackupctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/databackup"
+	dataevictctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataevict"
 	dataflowctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataflow"
 	dataloadctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataload"
 	datamigratectl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/datamigrate"
 	dataprocessctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataprocess"
 	datasetctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataset"
+	runtimeautoscalerctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/runtimeautoscaler"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
@@ -217,6 +219,18 @@ func handle() {
 		}
 	}
 
+	if fluidDiscovery.ResourceEnabled("dataevict") {
+		setupLog.Info("Registering DataEvict reconciler to Fluid controller manager.")
+		if err = (dataevictctl.NewDataEvictReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("dataevictctl").WithName("DataEvict"),
+			mgr.GetScheme(),
+			mgr.GetEventRecorderFor("DataEvict"),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DataEvict")
+			os.Exit(1)
+		}
+	}
+
 	if dataflowctl.DataFlowEnabled() {
 		setupLog.Info("Registering DataFlow reconciler to Fluid controller manager.")
 		if err = (dataflowctl.NewDataFlowReconciler(mgr.GetClient(),
@@ -229,6 +243,30 @@ func handle() {
 		}
 	}
 
+	if fluidDiscovery.ResourceEnabled("dataflow") {
+		setupLog.Info("Registering DataFlow object reconciler to Fluid controller manager.")
+		if err = (dataflowctl.NewDataFlowObjectReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("dataflowctl").WithName("DataFlowObject"),
+			mgr.GetEventRecorderFor("DataFlow"),
+			time.Duration(5*time.Second),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DataFlowObject")
+			os.Exit(1)
+		}
+	}
+
+	if fluidDiscovery.ResourceEnabled("runtimeautoscaler") {
+		setupLog.Info("Registering RuntimeAutoscaler reconciler to Fluid controller manager.")
+		if err = (runtimeautoscalerctl.NewRuntimeAutoscalerReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("runtimeautoscalerctl"),
+			mgr.GetEventRecorderFor("RuntimeAutoscaler"),
+			time.Duration(15*time.Second),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "RuntimeAutoscaler")
+			os.Exit(1)
+		}
+	}
+
 	setupLog.Info("starting dataset-controller")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running dataset-controller")
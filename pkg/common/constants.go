@@ -45,6 +45,36 @@ const (
 	RuntimeWithSecretNotSupported = "RuntimeWithSecretNotSupported"
 
 	RuntimeMountUfsFailed = "RuntimeMountUfsFailed"
+
+	RuntimeUnhealthy = "RuntimeUnhealthy"
+
+	RuntimeClassRerendered = "RuntimeClassRerendered"
+
+	RuntimeHibernated = "RuntimeHibernated"
+
+	RuntimeWokenUp = "RuntimeWokenUp"
+
+	RuntimeHibernationFailed = "RuntimeHibernationFailed"
+
+	WorkersDraining = "WorkersDraining"
+
+	WorkersDrainTimeout = "WorkersDrainTimeout"
+
+	RuntimeUpgrading = "RuntimeUpgrading"
+
+	RuntimeUpgradePaused = "RuntimeUpgradePaused"
+
+	RuntimeUpgraded = "RuntimeUpgraded"
+
+	RuntimeMigrationWaiting = "RuntimeMigrationWaiting"
+
+	RuntimeMigrationCompleted = "RuntimeMigrationCompleted"
+
+	MetadataRestoring = "MetadataRestoring"
+
+	MetadataRestored = "MetadataRestored"
+
+	MetadataRestoreFailed = "MetadataRestoreFailed"
 )
 
 // Events related to all type of Data Operations
@@ -68,6 +98,14 @@ const (
 	DataOperationCollision = "DataOperationCollision"
 
 	TargetSSHSecretNameNotSet = "TargetSSHSecretNameNotSet"
+
+	DataOperationRetrying = "DataOperationRetrying"
+
+	DataOperationDeadlineExceeded = "DeadlineExceeded"
+
+	DataOperationCancelled = "DataOperationCancelled"
+
+	DataOperationSuspended = "DataOperationSuspended"
 )
 
 // Events related to dataflow
@@ -77,6 +115,30 @@ const (
 	DataOperationWaiting = "DataOperationWaiting"
 )
 
+// Events related to DataFlow
+const (
+	DataFlowNotValid = "DataFlowNotValid"
+
+	DataFlowStepCreated = "DataFlowStepCreated"
+
+	DataFlowSucceed = "DataFlowSucceed"
+
+	DataFlowFailed = "DataFlowFailed"
+)
+
+// Events related to RuntimeAutoscaler
+const (
+	RuntimeAutoscalerNotValid = "RuntimeAutoscalerNotValid"
+
+	RuntimeAutoscalerFailedGetScale = "RuntimeAutoscalerFailedGetScale"
+
+	RuntimeAutoscalerFailedGetMetrics = "RuntimeAutoscalerFailedGetMetrics"
+
+	RuntimeAutoscalerFailedRescale = "RuntimeAutoscalerFailedRescale"
+
+	RuntimeAutoscalerSucceededRescale = "RuntimeAutoscalerSucceededRescale"
+)
+
 // Events related to DataLoad
 const (
 	DataLoadCollision = "DataLoadCollision"
@@ -88,6 +150,8 @@ const (
 	DataLoadJobFailed = "DataLoadJobFailed"
 
 	DataLoadJobComplete = "DataLoadJobComplete"
+
+	DataLoadInvalidTargetFilters = "InvalidTargetFilters"
 )
 
 // Events related to DataMigrate
@@ -111,6 +175,11 @@ const (
 	DataProcessScheduleNotSpecified = "ScheduleNotSpecified"
 )
 
+// Events related to DataEvict
+const (
+	DataEvictInvalidSchedule = "InvalidSchedule"
+)
+
 type CacheStoreType string
 
 const (
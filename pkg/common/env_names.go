@@ -35,3 +35,9 @@ const (
 const (
 	EnvFuseSidecarInjectionMode = "FUSE_SIDECAR_INJECTION_MODE"
 )
+
+const (
+	// EnvFuseHandOffSocket is the path of the unix socket through which the running fuse process hands off
+	// the fuse session to the new one in fuse hot upgrade
+	EnvFuseHandOffSocket = "FLUID_FUSE_HANDOFF_SOCKET"
+)
@@ -122,6 +122,58 @@ const (
 	AnnotationDataFlowCustomizedAffinityPrefix = "affinity.dataflow.fluid.io."
 )
 
+const (
+	// AnnotationDataOperationCancel is an annotation key on a data operation to cancel it, the value should be "true".
+	// i.e. data.fluid.io/cancel
+	AnnotationDataOperationCancel = "data." + LabelAnnotationPrefix + "cancel"
+
+	// LabelDataFlowName is a label key on the data operations created by a DataFlow, the value is the name of the DataFlow.
+	// i.e. data.fluid.io/dataflow
+	LabelDataFlowName = "data." + LabelAnnotationPrefix + "dataflow"
+)
+
+const (
+	// AnnotationHibernatedReplicas is an annotation key on the statefulsets of a hibernated runtime, the value is
+	// the replicas of the statefulset before hibernation.
+	// i.e. hibernation.runtime.fluid.io/replicas
+	AnnotationHibernatedReplicas = "hibernation.runtime." + LabelAnnotationPrefix + "replicas"
+
+	// AnnotationWakeUpRequested is an annotation key on a hibernated runtime to request waking it up, the value is
+	// the time of the request.
+	// i.e. hibernation.runtime.fluid.io/wake-up-requested
+	AnnotationWakeUpRequested = "hibernation.runtime." + LabelAnnotationPrefix + "wake-up-requested"
+
+	// SchedulingGateRuntimeWakeUp is the scheduling gate of the pods waiting for the hibernated runtimes to wake up
+	// i.e. hibernation.runtime.fluid.io/wake-up
+	SchedulingGateRuntimeWakeUp = "hibernation.runtime." + LabelAnnotationPrefix + "wake-up"
+)
+
+const (
+	// AnnotationRuntimeMigrationTarget is an annotation key on a dataset to migrate it to the runtime of another type,
+	// the value is the runtime type to migrate to, e.g. "juicefs".
+	// i.e. migration.runtime.fluid.io/target
+	AnnotationRuntimeMigrationTarget = "migration.runtime." + LabelAnnotationPrefix + "target"
+)
+
+const (
+	// AnnotationMasterJournalPods is an annotation key on the master statefulset of a runtime restoring the metadata
+	// automatically, the value is the comma separated uids of the master pods holding the journal.
+	// i.e. restore.runtime.fluid.io/journal-pods
+	AnnotationMasterJournalPods = "restore.runtime." + LabelAnnotationPrefix + "journal-pods"
+
+	// AnnotationMetadataRestoringFrom is an annotation key on the master statefulset of a runtime, the value is the
+	// name of the DataBackup the metadata is being restored from.
+	// i.e. restore.runtime.fluid.io/restoring-from
+	AnnotationMetadataRestoringFrom = "restore.runtime." + LabelAnnotationPrefix + "restoring-from"
+)
+
+const (
+	// AnnotationWorkersDrainingTo is an annotation key on the worker statefulset of a runtime draining the workers
+	// before scaling in, the value is the replicas the workers are being scaled in to.
+	// i.e. scale-in.runtime.fluid.io/draining-to
+	AnnotationWorkersDrainingTo = "scale-in.runtime." + LabelAnnotationPrefix + "draining-to"
+)
+
 const (
 	// AnnotationServerlessPlatform is an annotation key name for the platform type of serverless.
 	// i.e. serverless.fluid.io/platform
@@ -154,6 +206,11 @@ const (
 	LabelCacheRuntimeName = CacheRuntimeLabelAnnotationPrefix + "name"
 
 	LabelCacheRuntimeComponentName = CacheRuntimeLabelAnnotationPrefix + "component-name"
+
+	// AnnotationRuntimeClassGeneration is an annotation key on a CacheRuntime to bump it to the given generation of
+	// the CacheRuntimeClass, the components are re-rendered if the value is the current generation of the class.
+	// i.e. cacheruntime.fluid.io/runtime-class-generation
+	AnnotationRuntimeClassGeneration = CacheRuntimeLabelAnnotationPrefix + "runtime-class-generation"
 )
 
 // LabelToModify modifies the labelKey in operationType.
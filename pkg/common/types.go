@@ -122,6 +122,7 @@ const (
 	PhaseExecuting Phase = "Executing"
 	PhaseComplete  Phase = "Complete"
 	PhaseFailed    Phase = "Failed"
+	PhaseCancelled Phase = "Cancelled"
 )
 
 // ConditionType is a valid value for Condition.Type
@@ -133,8 +134,28 @@ const (
 	Complete ConditionType = "Complete"
 	// Failed means the task has failed its execution.
 	Failed ConditionType = "Failed"
+	// Cancelled means the task has been cancelled before it finished.
+	Cancelled ConditionType = "Cancelled"
 )
 
+// These are valid conditions of a RuntimeAutoscaler
+const (
+	// ScalingActive means the autoscaler is able to compute the desired replicas from the metrics and scale the runtime.
+	ScalingActive ConditionType = "ScalingActive"
+)
+
+// CronJobPolicy defines the policies of the CronJob created for the data operation with Cron policy
+type CronJobPolicy struct {
+	// ConcurrencyPolicy specifies how to treat concurrent runs, one of Allow, Forbid and Replace
+	ConcurrencyPolicy string `json:"concurrencyPolicy,omitempty" yaml:"concurrencyPolicy,omitempty"`
+	// StartingDeadlineSeconds specifies the deadline in seconds for starting a run if it misses the scheduled time
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty" yaml:"startingDeadlineSeconds,omitempty"`
+	// SuccessfulJobsHistoryLimit specifies the number of successful finished jobs to retain
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty" yaml:"successfulJobsHistoryLimit,omitempty"`
+	// FailedJobsHistoryLimit specifies the number of failed finished jobs to retain
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty" yaml:"failedJobsHistoryLimit,omitempty"`
+}
+
 type OwnerReference struct {
 	Enabled bool `json:"enabled" yaml:"enabled"`
 	// API version of the referent.
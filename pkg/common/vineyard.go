@@ -27,6 +27,8 @@ const (
 
 	VineyardFuseImageEnv = "VINEYARD_FUSE_IMAGE_ENV"
 
+	VineyardLoaderImageEnv = "VINEYARD_LOADER_IMAGE_ENV"
+
 	VineyardFuseIsGlobal = true
 
 	DefaultVineyardMasterImage = "registry.aliyuncs.com/vineyard/vineyardd:v0.22.2"
@@ -35,6 +37,8 @@ const (
 
 	DefultVineyardFuseImage = "registry.aliyuncs.com/vineyard/vineyard-fluid-fuse:v0.22.2"
 
+	DefaultVineyardLoaderImage = "registry.aliyuncs.com/vineyard/vineyard-python:v0.22.2"
+
 	VineyardEngineImpl = VineyardRuntime
 )
 
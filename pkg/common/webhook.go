@@ -26,6 +26,8 @@ const (
 	WebhookServiceName     = "fluid-pod-admission-webhook"
 	WebhookSchedulePodPath = "mutate-fluid-io-v1alpha1-schedulepod"
 
+	WebhookValidateCacheRuntimeClassPath = "validate-data-fluid-io-v1alpha1-cacheruntimeclass"
+
 	CertSecretName = "fluid-webhook-certs"
 
 	WebhookPluginFilePath = "/etc/fluid/plugins.profile"
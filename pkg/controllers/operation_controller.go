@@ -120,6 +120,58 @@ func (o *OperationReconciler) ReconcileDeletion(ctx dataoperation.ReconcileReque
 	return utils.NoRequeue()
 }
 
+// ReconcileCancel cancels the unfinished data operation, it tears down the running job and releases the lock on the target dataset.
+func (o *OperationReconciler) ReconcileCancel(ctx dataoperation.ReconcileRequestContext,
+	implement dataoperation.OperationInterface) (ctrl.Result, error) {
+	log := ctx.Log.WithName("ReconcileCancel")
+
+	// 1. Delete helm release if exists
+	namespacedName := implement.GetReleaseNameSpacedName()
+	err := helm.DeleteReleaseIfExists(namespacedName.Name, namespacedName.Namespace)
+	if err != nil {
+		log.Error(err, "can't delete release", "releaseName", namespacedName.Name)
+		return utils.RequeueIfError(err)
+	}
+
+	// 2. Release lock on target dataset if necessary
+	err = base.ReleaseTargetDataset(ctx.ReconcileRequestContext, implement)
+	if utils.IgnoreNotFound(err) != nil {
+		log.Error(err, "can't release lock on target dataset")
+		return utils.RequeueIfError(err)
+	}
+
+	// 3. Update phase to Cancelled
+	object := implement.GetOperationObject()
+	now := metav1.Now()
+	opStatus := ctx.OpStatus.DeepCopy()
+	opStatus.Phase = common.PhaseCancelled
+	opStatus.Conditions = []datav1alpha1.Condition{
+		{
+			Type:               common.Cancelled,
+			Status:             v1.ConditionTrue,
+			Reason:             common.DataOperationCancelled,
+			Message:            fmt.Sprintf("%s is cancelled by the annotation %s", implement.GetOperationType(), common.AnnotationDataOperationCancel),
+			LastProbeTime:      now,
+			LastTransitionTime: now,
+		},
+	}
+	if opStatus.StartTime != nil {
+		opStatus.Duration = utils.CalculateDuration(opStatus.StartTime.Time, now.Time)
+	} else {
+		// the data operation is cancelled before executing
+		opStatus.Duration = "-"
+	}
+	if err = implement.UpdateOperationApiStatus(opStatus); err != nil {
+		log.Error(err, "failed to update api status")
+		return utils.RequeueIfError(err)
+	}
+	o.Recorder.Eventf(object, v1.EventTypeNormal, common.DataOperationCancelled, "%s %s is cancelled", implement.GetOperationType(), object.GetName())
+	log.Info("data operation is cancelled")
+
+	// update operation status would trigger requeue, no need to requeue here
+	return utils.NoRequeue()
+}
+
 func (o *OperationReconciler) ReconcileInternal(ctx dataoperation.ReconcileRequestContext) (ctrl.Result, error) {
 	var object = ctx.DataObject
 	implement, err := o.implementBuilder.Build(object)
@@ -134,7 +186,12 @@ func (o *OperationReconciler) ReconcileInternal(ctx dataoperation.ReconcileReque
 		return o.ReconcileDeletion(ctx, implement)
 	}
 
-	// 2. set target dataset
+	// 2. Cancel the data operation if requested, no engine is needed to tear it down
+	if utils.IsDataOperationCancelRequested(object) && utils.IsDataOperationCancellable(ctx.OpStatus.Phase) {
+		return o.ReconcileCancel(ctx, implement)
+	}
+
+	// 3. set target dataset
 	targetDataset, err := implement.GetTargetDataset()
 	if err != nil {
 		if utils.IgnoreNotFound(err) == nil {
@@ -159,7 +216,7 @@ func (o *OperationReconciler) ReconcileInternal(ctx dataoperation.ReconcileReque
 	}
 	ctx.Dataset = targetDataset
 
-	// 3. set target runtime and runtimeType
+	// 4. set target runtime and runtimeType
 	index, boundedRuntime := utils.GetRuntimeByCategory(targetDataset.Status.Runtimes, common.AccelerateCategory)
 	if index == -1 {
 		ctx.Log.Info("bounded runtime with Accelerate Category is not found on the target dataset", "targetDataset", targetDataset)
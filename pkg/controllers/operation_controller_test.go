@@ -20,7 +20,9 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/agiledragon/gomonkey/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -41,6 +43,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	jindoutils "github.com/fluid-cloudnative/fluid/pkg/utils/jindo"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -71,6 +74,7 @@ type mockOperationInterface struct {
 	targetDatasetErr                     error
 	possibleTargetDatasetNamespacedNames []types.NamespacedName
 	operationType                        dataoperation.OperationType
+	updatedStatus                        *datav1alpha1.OperationStatus
 }
 
 func (m *mockOperationInterface) HasPrecedingOperation() bool { return false }
@@ -91,6 +95,7 @@ func (m *mockOperationInterface) GetOperationType() dataoperation.OperationType
 	return m.operationType
 }
 func (m *mockOperationInterface) UpdateOperationApiStatus(opStatus *datav1alpha1.OperationStatus) error {
+	m.updatedStatus = opStatus
 	return nil
 }
 func (m *mockOperationInterface) Validate(ctx cruntime.ReconcileRequestContext) ([]datav1alpha1.Condition, error) {
@@ -108,6 +113,10 @@ func (m *mockOperationInterface) RemoveTargetDatasetStatusInProgress(dataset *da
 func (m *mockOperationInterface) GetStatusHandler() dataoperation.StatusHandler { return nil }
 func (m *mockOperationInterface) GetTTL() (ttl *int32, err error)               { return nil, nil }
 func (m *mockOperationInterface) GetParallelTaskNumber() int32                  { return 1 }
+func (m *mockOperationInterface) GetRetryPolicy() dataoperation.RetryPolicy {
+	return dataoperation.RetryPolicy{}
+}
+func (m *mockOperationInterface) IsSuspended() bool { return false }
 
 var _ = Describe("NewDataOperationReconciler", func() {
 	It("should create an OperationReconciler with the provided parameters", func() {
@@ -638,3 +647,107 @@ var _ = Describe("OperationReconciler ReconcileInternal", func() {
 		Expect(result).To(Equal(ctrl.Result{}))
 	})
 })
+
+var _ = Describe("OperationReconciler ReconcileCancel", func() {
+	var (
+		s              *runtime.Scheme
+		patches        *gomonkey.Patches
+		deletedRelease string
+	)
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		deletedRelease = ""
+		patches = gomonkey.ApplyFunc(helm.DeleteReleaseIfExists, func(name, namespace string) error {
+			deletedRelease = name
+			return nil
+		})
+	})
+
+	AfterEach(func() {
+		patches.Reset()
+	})
+
+	It("should tear down the release, release the dataset and set the phase to Cancelled", func() {
+		obj := &datav1alpha1.DataLoad{ObjectMeta: metav1.ObjectMeta{
+			Name:        testDataloadName,
+			Namespace:   "default",
+			Annotations: map[string]string{common.AnnotationDataOperationCancel: "true"},
+		}}
+		dataset := &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: testDatasetName, Namespace: "default"},
+			Status: datav1alpha1.DatasetStatus{
+				OperationRef: map[string]string{string(dataoperation.DataLoadType): testDataloadName},
+			},
+		}
+		fakeClient := fakeclient.NewClientBuilder().WithScheme(s).WithObjects(dataset).WithStatusSubresource(dataset).Build()
+		operation := &mockOperationInterface{
+			operationObject:       obj,
+			operationType:         dataoperation.DataLoadType,
+			targetDataset:         dataset,
+			releaseNamespacedName: types.NamespacedName{Name: "test-dataload-loader", Namespace: "default"},
+		}
+		reconciler := NewDataOperationReconciler(&mockOperationInterfaceBuilder{
+			buildFunc: func(object client.Object) (dataoperation.OperationInterface, error) {
+				return operation, nil
+			},
+		}, fakeClient, fake.NullLogger(), record.NewFakeRecorder(10))
+
+		ctx := dataoperation.ReconcileRequestContext{
+			ReconcileRequestContext: cruntime.ReconcileRequestContext{Context: context.Background(), Client: fakeClient},
+			DataObject:              obj,
+			OpStatus: &datav1alpha1.OperationStatus{
+				Phase:     common.PhaseExecuting,
+				StartTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			},
+		}
+		ctx.Log = fake.NullLogger()
+
+		result, err := reconciler.ReconcileInternal(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+		Expect(deletedRelease).To(Equal("test-dataload-loader"))
+
+		Expect(operation.updatedStatus).NotTo(BeNil())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseCancelled))
+		Expect(operation.updatedStatus.Conditions).To(HaveLen(1))
+		Expect(operation.updatedStatus.Conditions[0].Type).To(Equal(common.Cancelled))
+		Expect(operation.updatedStatus.Duration).NotTo(Equal("Unfinished"))
+
+		var updatedDataset datav1alpha1.Dataset
+		Expect(fakeClient.Get(context.Background(), client.ObjectKeyFromObject(dataset), &updatedDataset)).To(Succeed())
+		Expect(updatedDataset.Status.OperationRef).NotTo(HaveKey(string(dataoperation.DataLoadType)))
+	})
+
+	It("should not cancel the finished data operation", func() {
+		obj := &datav1alpha1.DataLoad{ObjectMeta: metav1.ObjectMeta{
+			Name:        testDataloadName,
+			Namespace:   "default",
+			Annotations: map[string]string{common.AnnotationDataOperationCancel: "true"},
+		}}
+		fakeClient := fakeclient.NewClientBuilder().WithScheme(s).Build()
+		operation := &mockOperationInterface{
+			operationObject:  obj,
+			operationType:    dataoperation.DataLoadType,
+			targetDatasetErr: apierrors.NewNotFound(schema.GroupResource{Group: "data.fluid.io", Resource: "datasets"}, "missing"),
+		}
+		reconciler := NewDataOperationReconciler(&mockOperationInterfaceBuilder{
+			buildFunc: func(object client.Object) (dataoperation.OperationInterface, error) {
+				return operation, nil
+			},
+		}, fakeClient, fake.NullLogger(), record.NewFakeRecorder(10))
+
+		ctx := dataoperation.ReconcileRequestContext{
+			ReconcileRequestContext: cruntime.ReconcileRequestContext{Context: context.Background(), Client: fakeClient},
+			DataObject:              obj,
+			OpStatus:                &datav1alpha1.OperationStatus{Phase: common.PhaseComplete},
+		}
+		ctx.Log = fake.NullLogger()
+
+		_, err := reconciler.ReconcileInternal(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deletedRelease).To(BeEmpty())
+		Expect(operation.updatedStatus).To(BeNil())
+	})
+})
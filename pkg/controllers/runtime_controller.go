@@ -156,6 +156,26 @@ func (r *RuntimeReconciler) ReconcileInternal(ctx cruntime.ReconcileRequestConte
 				dataset.Name)
 			return utils.RequeueAfterInterval(time.Duration(20 * time.Second))
 		}
+		// check runtime migration between runtime types, the runtimes of different types have the same name as the
+		// dataset, so they can't run alongside
+		if boundType := utils.GetBoundRuntimeType(dataset, ctx.Category); boundType == ctx.RuntimeType {
+			if utils.IsMigratingFromRuntime(dataset, ctx.RuntimeType) {
+				return r.ReconcileRuntimeMigration(ctx)
+			}
+		} else if len(boundType) > 0 {
+			if utils.GetRuntimeMigrationTarget(dataset) == ctx.RuntimeType {
+				r.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.RuntimeMigrationWaiting,
+					"Waiting for the %s runtime to be torn down before migrating the dataset to the runtime", boundType)
+			} else {
+				ctx.Log.Info("the dataset can't be bound to the runtime, because it's already bound to another type of runtime",
+					"dataset", dataset.Name, "runtimeType", boundType)
+				r.Recorder.Eventf(runtime, corev1.EventTypeWarning,
+					common.ErrorProcessRuntimeReason,
+					"the dataset can't be bound to the runtime, because it's already bound to %s runtime",
+					boundType)
+			}
+			return utils.RequeueAfterInterval(time.Duration(20 * time.Second))
+		}
 		// check reference dataset support
 		isSupport, err := r.CheckIfReferenceDatasetIsSupported(ctx)
 		if !isSupport {
@@ -186,17 +206,40 @@ func (r *RuntimeReconciler) ReconcileRuntimeDeletion(engine base.Engine, ctx cru
 	log := ctx.Log.WithName("reconcileRuntimeDeletion")
 	log.V(1).Info("process the Runtime Deletion", "Runtime", ctx.NamespacedName)
 
+	// The dataset is bound to the runtime of another type, or it's migrated from the runtime already, so the volume,
+	// the engine and the binding of the dataset don't belong to the runtime
+	migrating := utils.IsMigratingFromRuntime(ctx.Dataset, ctx.RuntimeType)
+	if boundType := utils.GetBoundRuntimeType(ctx.Dataset, ctx.Category); boundType != ctx.RuntimeType &&
+		(len(boundType) > 0 || migrating) {
+		log.Info("The dataset is not bound to the runtime, skip tearing down the runtime", "boundRuntimeType", boundType)
+		r.implement.RemoveEngine(ctx)
+		return r.removeFinalizer(ctx)
+	}
+
 	// 0. Delete the volume
-	err := engine.DeleteVolume(ctx)
-	if err != nil {
-		r.Recorder.Eventf(ctx.Runtime, corev1.EventTypeWarning, common.ErrorProcessRuntimeReason, "Failed to delete volume %v", err)
-		// return utils.RequeueIfError(errors.Wrap(err, "Failed to delete volume"))
-		log.Error(err, "Failed to delete volume", "Runtime", ctx.NamespacedName)
-		return utils.RequeueAfterInterval(time.Duration(20 * time.Second))
+	if migrating {
+		// keep the PVC referenced by the applications for the runtime migrated to
+		deleted, err := r.deleteVolumeForMigration(ctx)
+		if err != nil {
+			r.Recorder.Eventf(ctx.Runtime, corev1.EventTypeWarning, common.ErrorProcessRuntimeReason, "Failed to delete volume %v", err)
+			log.Error(err, "Failed to delete volume", "Runtime", ctx.NamespacedName)
+			return utils.RequeueAfterInterval(time.Duration(20 * time.Second))
+		}
+		if !deleted {
+			return utils.RequeueAfterInterval(time.Duration(20 * time.Second))
+		}
+	} else {
+		err := engine.DeleteVolume(ctx)
+		if err != nil {
+			r.Recorder.Eventf(ctx.Runtime, corev1.EventTypeWarning, common.ErrorProcessRuntimeReason, "Failed to delete volume %v", err)
+			// return utils.RequeueIfError(errors.Wrap(err, "Failed to delete volume"))
+			log.Error(err, "Failed to delete volume", "Runtime", ctx.NamespacedName)
+			return utils.RequeueAfterInterval(time.Duration(20 * time.Second))
+		}
 	}
 
 	// 1. Delete the implementation of the runtime
-	err = engine.Shutdown()
+	err := engine.Shutdown()
 	if err != nil {
 		r.Recorder.Eventf(ctx.Runtime, corev1.EventTypeWarning, common.ErrorProcessRuntimeReason, "Failed to shutdown engine %v", err)
 		// return utils.RequeueIfError(errors.Wrap(err, "Failed to shutdown the engine"))
@@ -229,7 +272,18 @@ func (r *RuntimeReconciler) ReconcileRuntimeDeletion(engine base.Engine, ctx cru
 		}
 	}
 
+	if migrating {
+		r.Recorder.Eventf(ctx.Runtime, corev1.EventTypeNormal, common.RuntimeMigrationCompleted,
+			"The runtime is torn down, the dataset can be bound to %s runtime", utils.GetRuntimeMigrationTarget(ctx.Dataset))
+	}
+
 	// 3. Remove finalizer
+	return r.removeFinalizer(ctx)
+}
+
+// removeFinalizer removes the finalizer of the runtime being deleted
+func (r *RuntimeReconciler) removeFinalizer(ctx cruntime.ReconcileRequestContext) (ctrl.Result, error) {
+	log := ctx.Log.WithName("removeFinalizer")
 	r.Log.Info("before clean up finalizer", "runtime", ctx.Runtime)
 	objectMeta, err := r.implement.GetRuntimeObjectMeta(ctx)
 	if err != nil {
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// ReconcileRuntimeMigration starts to tear down the runtime which the dataset is requested to migrate from, once no
+// pods use the dataset. The runtime is deleted, and its deletion keeps the PVC of the dataset, see
+// ReconcileRuntimeDeletion. The runtime migrated to binds the dataset and recreates the PV for the PVC afterwards.
+func (r *RuntimeReconciler) ReconcileRuntimeMigration(ctx cruntime.ReconcileRequestContext) (ctrl.Result, error) {
+	log := ctx.Log.WithName("reconcileRuntimeMigration")
+	log.V(1).Info("process the runtime migration", "to", utils.GetRuntimeMigrationTarget(ctx.Dataset))
+
+	waiting, err := r.waitForPodsUsingDataset(ctx)
+	if err != nil {
+		return utils.RequeueIfError(err)
+	}
+	if waiting {
+		return utils.RequeueAfterInterval(time.Duration(20 * time.Second))
+	}
+
+	if err = r.Delete(ctx, ctx.Runtime); err != nil {
+		return utils.RequeueIfError(client.IgnoreNotFound(err))
+	}
+	log.Info("The runtime is deleted to migrate the dataset", "to", utils.GetRuntimeMigrationTarget(ctx.Dataset))
+
+	return utils.NoRequeue()
+}
+
+// deleteVolumeForMigration deletes the PV of the dataset and keeps the PVC, once no pods use the dataset.
+// deleted is false if the dataset is still in use.
+func (r *RuntimeReconciler) deleteVolumeForMigration(ctx cruntime.ReconcileRequestContext) (deleted bool, err error) {
+	waiting, err := r.waitForPodsUsingDataset(ctx)
+	if err != nil || waiting {
+		return false, err
+	}
+
+	runtimeInfo, err := base.BuildRuntimeInfo(ctx.Name, ctx.Namespace, ctx.RuntimeType)
+	if err != nil {
+		return false, err
+	}
+
+	err = volume.DeletePersistentVolumeForMigration(ctx, r.Client, runtimeInfo, ctx.Log)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// waitForPodsUsingDataset checks if any active pod mounts the PVC of the dataset, these pods use the fuse of the
+// runtime, so the runtime can't be torn down until they are completed.
+func (r *RuntimeReconciler) waitForPodsUsingDataset(ctx cruntime.ReconcileRequestContext) (waiting bool, err error) {
+	pods, err := kubeclient.GetPvcMountPodsWithContext(ctx, r.Client, ctx.Name, ctx.Namespace)
+	if err != nil {
+		return false, err
+	}
+
+	activePods := []string{}
+	for i := range pods {
+		if !kubeclient.IsCompletePod(&pods[i]) {
+			activePods = append(activePods, pods[i].Name)
+		}
+	}
+	if len(activePods) == 0 {
+		return false, nil
+	}
+
+	r.Recorder.Eventf(ctx.Runtime, corev1.EventTypeNormal, common.RuntimeMigrationWaiting,
+		"The dataset is migrated to %s runtime, waiting for %d pods (e.g. %s) to stop using the dataset before tearing down the runtime",
+		utils.GetRuntimeMigrationTarget(ctx.Dataset), len(activePods), activePods[0])
+	return true, nil
+}
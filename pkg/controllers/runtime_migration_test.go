@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+)
+
+var _ = Describe("Runtime migration", func() {
+	var (
+		dataset *datav1alpha1.Dataset
+		pv      *corev1.PersistentVolume
+		pvc     *corev1.PersistentVolumeClaim
+	)
+
+	BeforeEach(func() {
+		// the test request context is for an AlluxioRuntime
+		dataset = newBoundDataset(defaultNamespace, demoRuntimeName)
+		dataset.Status.Runtimes[0].Type = common.AlluxioRuntime
+		dataset.Annotations = map[string]string{common.AnnotationRuntimeMigrationTarget: common.JuiceFSRuntime}
+		pv = &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        defaultNamespace + "-" + demoRuntimeName,
+				Annotations: common.GetExpectedFluidAnnotations(),
+				Finalizers:  []string{"kubernetes.io/pv-protection"},
+			},
+		}
+		pvc = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   defaultNamespace,
+				Name:        demoRuntimeName,
+				Annotations: common.GetExpectedFluidAnnotations(),
+			},
+		}
+	})
+
+	mountPod := func(phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: defaultNamespace, Name: "app"},
+			Spec: corev1.PodSpec{
+				Volumes: []corev1.Volume{{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: demoRuntimeName},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	getDataset := func(r *RuntimeReconciler) *datav1alpha1.Dataset {
+		updated := &datav1alpha1.Dataset{}
+		Expect(r.Get(context.Background(), types.NamespacedName{Namespace: dataset.Namespace, Name: dataset.Name}, updated)).To(Succeed())
+		return updated
+	}
+
+	Describe("ReconcileInternal", func() {
+		var (
+			runtimeObj *datav1alpha1.AlluxioRuntime
+			impl       *testRuntimeReconcilerImplement
+		)
+
+		BeforeEach(func() {
+			runtimeObj = newRuntimeWithOwnersAndFinalizers(defaultNamespace, demoRuntimeName, dataset, runtimeProtectionFinalizer)
+			impl = &testRuntimeReconcilerImplement{
+				getOrCreateEngine: func(cruntime.ReconcileRequestContext) (base.Engine, error) {
+					return &testEngine{setupReady: true}, nil
+				},
+			}
+		})
+
+		It("waits when the dataset is bound to another type of runtime", func() {
+			dataset.Status.Runtimes[0].Type = common.JuiceFSRuntime
+			dataset.Annotations = nil
+			r := newTestRuntimeReconcilerWithImplement(impl, dataset, runtimeObj)
+
+			result, err := r.ReconcileInternal(newTestRequestContext(r.Client, runtimeObj, dataset))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(20 * time.Second))
+			Expect(impl.reconcileRuntimeCalls).To(Equal(0))
+		})
+
+		It("waits for the runtime migrated from to be torn down", func() {
+			dataset.Status.Runtimes[0].Type = common.JuiceFSRuntime
+			dataset.Annotations = map[string]string{common.AnnotationRuntimeMigrationTarget: common.AlluxioRuntime}
+			r := newTestRuntimeReconcilerWithImplement(impl, dataset, runtimeObj)
+
+			result, err := r.ReconcileInternal(newTestRequestContext(r.Client, runtimeObj, dataset))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(20 * time.Second))
+			Expect(impl.reconcileRuntimeCalls).To(Equal(0))
+			Expect(getDataset(r).Status.Runtimes[0].Type).To(Equal(common.JuiceFSRuntime))
+		})
+
+		It("waits for the pods using the dataset before deleting the runtime migrated from", func() {
+			r := newTestRuntimeReconcilerWithImplement(impl, dataset, runtimeObj, mountPod(corev1.PodRunning))
+
+			result, err := r.ReconcileInternal(newTestRequestContext(r.Client, runtimeObj, dataset))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(20 * time.Second))
+			Expect(impl.reconcileRuntimeCalls).To(Equal(0))
+			got := &datav1alpha1.AlluxioRuntime{}
+			Expect(r.Get(context.Background(), types.NamespacedName{Namespace: runtimeObj.Namespace, Name: runtimeObj.Name}, got)).To(Succeed())
+			Expect(got.DeletionTimestamp).To(BeNil())
+		})
+
+		It("deletes the runtime migrated from once no pods use the dataset", func() {
+			r := newTestRuntimeReconcilerWithImplement(impl, dataset, runtimeObj, mountPod(corev1.PodSucceeded))
+
+			result, err := r.ReconcileInternal(newTestRequestContext(r.Client, runtimeObj, dataset))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(impl.reconcileRuntimeCalls).To(Equal(0))
+			got := &datav1alpha1.AlluxioRuntime{}
+			err = r.Get(context.Background(), types.NamespacedName{Namespace: runtimeObj.Namespace, Name: runtimeObj.Name}, got)
+			if err == nil {
+				Expect(got.DeletionTimestamp).NotTo(BeNil())
+			} else {
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			}
+		})
+	})
+
+	Describe("ReconcileRuntimeDeletion", func() {
+		var (
+			runtimeObj *datav1alpha1.AlluxioRuntime
+			objects    []runtime.Object
+		)
+
+		BeforeEach(func() {
+			runtimeObj = newDeletingRuntime(defaultNamespace, demoRuntimeName, runtimeProtectionFinalizer)
+			objects = []runtime.Object{runtimeObj, dataset, pv, pvc}
+		})
+
+		It("deletes the PV and keeps the PVC of the dataset migrated from the runtime", func() {
+			r := newTestRuntimeReconciler(objects...)
+			engine := &testEngine{}
+
+			result, err := r.ReconcileRuntimeDeletion(engine, newTestRequestContext(r.Client, runtimeObj, dataset))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(engine.deleteVolumeCalls).To(Equal(0))
+			Expect(engine.shutdownCalls).To(Equal(1))
+			err = r.Get(context.Background(), types.NamespacedName{Name: pv.Name}, &corev1.PersistentVolume{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(r.Get(context.Background(), types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}, &corev1.PersistentVolumeClaim{})).To(Succeed())
+			Expect(getDataset(r).Status.Runtimes).To(BeEmpty())
+		})
+
+		It("waits for the pods using the dataset before tearing down the runtime", func() {
+			r := newTestRuntimeReconciler(append(objects, mountPod(corev1.PodRunning))...)
+			engine := &testEngine{}
+
+			result, err := r.ReconcileRuntimeDeletion(engine, newTestRequestContext(r.Client, runtimeObj, dataset))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(20 * time.Second))
+			Expect(engine.shutdownCalls).To(Equal(0))
+			Expect(r.Get(context.Background(), types.NamespacedName{Name: pv.Name}, &corev1.PersistentVolume{})).To(Succeed())
+			Expect(getDataset(r).Status.Runtimes[0].Type).To(Equal(common.AlluxioRuntime))
+		})
+
+		It("keeps everything when the dataset is bound to another type of runtime", func() {
+			dataset.Status.Runtimes[0].Type = common.JuiceFSRuntime
+			r := newTestRuntimeReconciler(objects...)
+			engine := &testEngine{}
+
+			result, err := r.ReconcileRuntimeDeletion(engine, newTestRequestContext(r.Client, runtimeObj, dataset))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(engine.deleteVolumeCalls).To(Equal(0))
+			Expect(engine.shutdownCalls).To(Equal(0))
+			Expect(r.Get(context.Background(), types.NamespacedName{Name: pv.Name}, &corev1.PersistentVolume{})).To(Succeed())
+			Expect(getDataset(r).Status.Runtimes[0].Type).To(Equal(common.JuiceFSRuntime))
+		})
+
+		It("keeps the PVC when the dataset is already migrated from the runtime", func() {
+			dataset.Status.Runtimes = nil
+			r := newTestRuntimeReconciler(objects...)
+			engine := &testEngine{}
+
+			result, err := r.ReconcileRuntimeDeletion(engine, newTestRequestContext(r.Client, runtimeObj, dataset))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+			Expect(engine.deleteVolumeCalls).To(Equal(0))
+			Expect(engine.shutdownCalls).To(Equal(0))
+			Expect(r.Get(context.Background(), types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}, &corev1.PersistentVolumeClaim{})).To(Succeed())
+		})
+	})
+})
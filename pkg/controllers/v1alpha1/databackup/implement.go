@@ -137,7 +137,7 @@ func (r *dataBackupOperation) UpdateOperationApiStatus(opStatus *datav1alpha1.Op
 }
 
 func (r *dataBackupOperation) GetStatusHandler() dataoperation.StatusHandler {
-	return &OnceHandler{}
+	return &OnceHandler{dataBackup: r.dataBackup}
 }
 
 // GetTTL implements dataoperation.OperationInterface.
@@ -149,3 +149,11 @@ func (r *dataBackupOperation) GetTTL() (ttl *int32, err error) {
 func (r *dataBackupOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
+
+func (r *dataBackupOperation) GetRetryPolicy() dataoperation.RetryPolicy {
+	return dataoperation.NewRetryPolicy(datav1alpha1.Once, r.dataBackup.Spec.BackoffLimit, r.dataBackup.Spec.RetryBackoff, r.dataBackup.Spec.ActiveDeadlineSeconds)
+}
+
+func (r *dataBackupOperation) IsSuspended() bool {
+	return false
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataevict
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/controllers"
+	cdataevict "github.com/fluid-cloudnative/fluid/pkg/dataevict"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const controllerName string = "DataEvictReconciler"
+
+// DataEvictReconciler reconciles a DataEvict object
+type DataEvictReconciler struct {
+	Scheme *runtime.Scheme
+	*controllers.OperationReconciler
+}
+
+var _ dataoperation.OperationInterfaceBuilder = &DataEvictReconciler{}
+
+// NewDataEvictReconciler returns a DataEvictReconciler
+func NewDataEvictReconciler(client client.Client,
+	log logr.Logger,
+	scheme *runtime.Scheme,
+	recorder record.EventRecorder) *DataEvictReconciler {
+	r := &DataEvictReconciler{
+		Scheme: scheme,
+	}
+	r.OperationReconciler = controllers.NewDataOperationReconciler(r, client, log, recorder)
+	return r
+}
+
+func (r *DataEvictReconciler) Build(object client.Object) (dataoperation.OperationInterface, error) {
+	dataEvict, ok := object.(*datav1alpha1.DataEvict)
+	if !ok {
+		return nil, fmt.Errorf("object %v is not a DataEvict", object)
+	}
+
+	return &dataEvictOperation{
+		Client:    r.Client,
+		Log:       r.Log,
+		Recorder:  r.Recorder,
+		dataEvict: dataEvict,
+	}, nil
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataevicts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataevicts/status,verbs=get;update;patch
+// Reconcile reconciles the DataEvict object
+func (r *DataEvictReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := dataoperation.ReconcileRequestContext{
+		// used for create engine
+		ReconcileRequestContext: cruntime.ReconcileRequestContext{
+			Context:  context,
+			Log:      r.Log.WithValues("DataEvict", req.NamespacedName),
+			Recorder: r.Recorder,
+			Client:   r.Client,
+			Category: common.AccelerateCategory,
+		},
+		DataOpFinalizerName: cdataevict.DataEvictFinalizer,
+	}
+
+	// 1. Get DataEvict object
+	dataEvict, err := utils.GetDataEvict(r.Client, req.Name, req.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			ctx.Log.Info("DataEvict not found")
+			return utils.NoRequeue()
+		} else {
+			ctx.Log.Error(err, "failed to get DataEvict")
+			return utils.RequeueIfError(errors.Wrap(err, "failed to get DataEvict info"))
+		}
+	}
+	ctx.DataObject = dataEvict
+	ctx.OpStatus = &dataEvict.Status
+
+	if dataEvict.Spec.Policy == datav1alpha1.Cron && dataEvict.GetDeletionTimestamp().IsZero() && !utils.IsDataOperationCancelRequested(dataEvict) {
+		return r.reconcileCron(ctx, dataEvict)
+	}
+
+	return r.ReconcileInternal(ctx)
+}
+
+// reconcileCron reconciles the DataEvict with Cron policy. There is no cronjob for DataEvict because the cache is evicted
+// by the engine in the runtime pods, so the DataEvict is requeued until its next schedule time.
+func (r *DataEvictReconciler) reconcileCron(ctx dataoperation.ReconcileRequestContext, dataEvict *datav1alpha1.DataEvict) (ctrl.Result, error) {
+	nextScheduleTime, err := cdataevict.GetNextScheduleTime(dataEvict)
+	if err != nil {
+		// the invalid schedule is reported when validating the DataEvict
+		return r.ReconcileInternal(ctx)
+	}
+	waitTime := time.Until(nextScheduleTime)
+
+	// not the time to evict the cache yet, wait without locking the target dataset
+	status := dataEvict.Status
+	waitingForPrecedingOperation := status.WaitingFor.OperationComplete != nil && *status.WaitingFor.OperationComplete
+	if status.Phase == common.PhasePending && !waitingForPrecedingOperation && waitTime > 0 {
+		ctx.Log.V(1).Info("wait for the next schedule time to evict the cache", "nextScheduleTime", nextScheduleTime)
+		return utils.RequeueAfterInterval(waitTime)
+	}
+
+	result, err := r.ReconcileInternal(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	// requeue at the next schedule time to set the finished DataEvict back to pending
+	if (status.Phase == common.PhaseComplete || status.Phase == common.PhaseFailed) && waitTime > 0 {
+		if result.RequeueAfter == 0 || result.RequeueAfter > waitTime {
+			result.RequeueAfter = waitTime
+		}
+	}
+	return result, nil
+}
+
+// SetupWithManager sets up the controller with the given controller manager
+func (r *DataEvictReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DataEvict{}).
+		Complete(r)
+}
+
+func (r *DataEvictReconciler) ControllerName() string {
+	return controllerName
+}
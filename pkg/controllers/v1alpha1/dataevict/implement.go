@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataevict
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataevict "github.com/fluid-cloudnative/fluid/pkg/dataevict"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+type dataEvictOperation struct {
+	client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+
+	dataEvict *datav1alpha1.DataEvict
+}
+
+var _ dataoperation.OperationInterface = &dataEvictOperation{}
+
+func (r *dataEvictOperation) GetOperationObject() client.Object {
+	return r.dataEvict
+}
+
+func (r *dataEvictOperation) HasPrecedingOperation() bool {
+	return r.dataEvict.Spec.RunAfter != nil
+}
+
+func (r *dataEvictOperation) GetPossibleTargetDatasetNamespacedNames() []types.NamespacedName {
+	return []types.NamespacedName{
+		{Namespace: r.dataEvict.Spec.Dataset.Namespace, Name: r.dataEvict.Spec.Dataset.Name},
+	}
+}
+
+func (r *dataEvictOperation) GetTargetDataset() (*datav1alpha1.Dataset, error) {
+	return utils.GetDataset(r.Client, r.dataEvict.Spec.Dataset.Name, r.dataEvict.Spec.Dataset.Namespace)
+}
+
+func (r *dataEvictOperation) GetReleaseNameSpacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.dataEvict.GetNamespace(),
+		Name:      utils.GetDataEvictReleaseName(r.dataEvict.GetName()),
+	}
+}
+
+func (r *dataEvictOperation) GetChartsDirectory() string {
+	// DataEvict is executed by the engine in the runtime pods, no helm chart is needed.
+	return ""
+}
+
+func (r *dataEvictOperation) GetOperationType() dataoperation.OperationType {
+	return dataoperation.DataEvictType
+}
+
+func (r *dataEvictOperation) UpdateOperationApiStatus(opStatus *datav1alpha1.OperationStatus) error {
+	var dataEvictCpy = r.dataEvict.DeepCopy()
+	dataEvictCpy.Status = *opStatus.DeepCopy()
+	return r.Status().Update(context.Background(), dataEvictCpy)
+}
+
+func (r *dataEvictOperation) Validate(ctx cruntime.ReconcileRequestContext) ([]datav1alpha1.Condition, error) {
+	dataEvict := r.dataEvict
+
+	// 1. Check dataEvict namespace and dataset namespace need to be same
+	if dataEvict.Namespace != dataEvict.Spec.Dataset.Namespace {
+		r.Recorder.Eventf(dataEvict,
+			v1.EventTypeWarning,
+			common.TargetDatasetNamespaceNotSame,
+			"dataEvict(%s) namespace is not same as dataset",
+			dataEvict.Name)
+		err := fmt.Errorf("dataEvict(%s) namespace is not same as dataset", dataEvict.Name)
+
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             v1.ConditionTrue,
+				Reason:             common.TargetDatasetNamespaceNotSame,
+				Message:            "dataEvict namespace is not same as dataset",
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(time.Now()),
+			},
+		}, err
+	}
+
+	// 2. DataEvict with Cron policy must specify a valid schedule
+	if dataEvict.Spec.Policy == datav1alpha1.Cron {
+		if _, err := cdataevict.GetNextScheduleTime(dataEvict); err != nil {
+			r.Recorder.Eventf(dataEvict,
+				v1.EventTypeWarning,
+				common.DataEvictInvalidSchedule,
+				"dataEvict(%s)'s policy is Cron but spec.schedule is invalid: %v",
+				dataEvict.Name, err)
+
+			return []datav1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             v1.ConditionTrue,
+					Reason:             common.DataEvictInvalidSchedule,
+					Message:            fmt.Sprintf("dataEvict's policy is Cron but spec.schedule is invalid: %v", err),
+					LastProbeTime:      metav1.NewTime(time.Now()),
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			}, err
+		}
+	}
+	return nil, nil
+}
+
+func (r *dataEvictOperation) UpdateStatusInfoForCompleted(infos map[string]string) error {
+	// The evicted bytes are set by the engine right after the cache is evicted.
+	return nil
+}
+
+func (r *dataEvictOperation) SetTargetDatasetStatusInProgress(dataset *datav1alpha1.Dataset) {
+	// DataEvict does not need to update Dataset other field except for DataOperationRef.
+}
+
+func (r *dataEvictOperation) RemoveTargetDatasetStatusInProgress(dataset *datav1alpha1.Dataset) {
+	// DataEvict does not need to update Dataset other field except for DataOperationRef.
+}
+
+func (r *dataEvictOperation) GetStatusHandler() dataoperation.StatusHandler {
+	policy := r.dataEvict.Spec.Policy
+
+	switch policy {
+	case datav1alpha1.Once:
+		return &OnceStatusHandler{dataEvict: r.dataEvict}
+	case datav1alpha1.Cron:
+		return &CronStatusHandler{dataEvict: r.dataEvict}
+	default:
+		return nil
+	}
+}
+
+// GetTTL implements dataoperation.OperationInterface.
+func (r *dataEvictOperation) GetTTL() (ttl *int32, err error) {
+	dataEvict := r.dataEvict
+
+	policy := dataEvict.Spec.Policy
+	switch policy {
+	case datav1alpha1.Once:
+		ttl = dataEvict.Spec.TTLSecondsAfterFinished
+	case datav1alpha1.Cron:
+		// For Cron policy, no TTL is provided
+		ttl = nil
+	default:
+		err = fmt.Errorf("unknown policy type: %s", policy)
+	}
+
+	return
+}
+
+func (r *dataEvictOperation) GetParallelTaskNumber() int32 {
+	return 1
+}
+
+func (r *dataEvictOperation) GetRetryPolicy() dataoperation.RetryPolicy {
+	// DataEvict is executed by the engine in place, no job to retry or terminate.
+	return dataoperation.RetryPolicy{}
+}
+
+func (r *dataEvictOperation) IsSuspended() bool {
+	return false
+}
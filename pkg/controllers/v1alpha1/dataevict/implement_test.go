@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataevict
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("dataEvictOperation", func() {
+	var (
+		dataEvict *datav1alpha1.DataEvict
+		operation *dataEvictOperation
+		ctx       cruntime.ReconcileRequestContext
+	)
+
+	BeforeEach(func() {
+		dataEvict = &datav1alpha1.DataEvict{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-evict", Namespace: "default"},
+			Spec: datav1alpha1.DataEvictSpec{
+				Dataset:                 datav1alpha1.TargetDataset{Name: "hadoop", Namespace: "default"},
+				Policy:                  datav1alpha1.Once,
+				TTLSecondsAfterFinished: ptr.To[int32](30),
+			},
+		}
+		operation = &dataEvictOperation{
+			Log:       fake.NullLogger(),
+			Recorder:  record.NewFakeRecorder(10),
+			dataEvict: dataEvict,
+		}
+		ctx = cruntime.ReconcileRequestContext{Log: fake.NullLogger()}
+	})
+
+	Describe("Validate", func() {
+		It("should pass with a valid DataEvict", func() {
+			conditions, err := operation.Validate(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conditions).To(BeEmpty())
+		})
+
+		It("should fail when the namespace is not same as dataset", func() {
+			dataEvict.Spec.Dataset.Namespace = "other"
+
+			conditions, err := operation.Validate(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].Reason).To(Equal(common.TargetDatasetNamespaceNotSame))
+		})
+
+		It("should fail when the schedule of Cron policy is invalid", func() {
+			dataEvict.Spec.Policy = datav1alpha1.Cron
+			dataEvict.Spec.Schedule = "invalid"
+
+			conditions, err := operation.Validate(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].Reason).To(Equal(common.DataEvictInvalidSchedule))
+		})
+
+		It("should pass when the schedule of Cron policy is valid", func() {
+			dataEvict.Spec.Policy = datav1alpha1.Cron
+			dataEvict.Spec.Schedule = "*/5 * * * *"
+
+			conditions, err := operation.Validate(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conditions).To(BeEmpty())
+		})
+	})
+
+	Describe("GetStatusHandler", func() {
+		It("should return OnceStatusHandler for Once policy", func() {
+			Expect(operation.GetStatusHandler()).To(BeAssignableToTypeOf(&OnceStatusHandler{}))
+		})
+
+		It("should return CronStatusHandler for Cron policy", func() {
+			dataEvict.Spec.Policy = datav1alpha1.Cron
+			Expect(operation.GetStatusHandler()).To(BeAssignableToTypeOf(&CronStatusHandler{}))
+		})
+
+		It("should return nil for unknown policy", func() {
+			dataEvict.Spec.Policy = datav1alpha1.OnEvent
+			Expect(operation.GetStatusHandler()).To(BeNil())
+		})
+	})
+
+	Describe("GetTTL", func() {
+		It("should return the ttl for Once policy", func() {
+			ttl, err := operation.GetTTL()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*ttl).To(Equal(int32(30)))
+		})
+
+		It("should return nil for Cron policy", func() {
+			dataEvict.Spec.Policy = datav1alpha1.Cron
+			ttl, err := operation.GetTTL()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ttl).To(BeNil())
+		})
+
+		It("should return error for unknown policy", func() {
+			dataEvict.Spec.Policy = datav1alpha1.OnEvent
+			_, err := operation.GetTTL()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
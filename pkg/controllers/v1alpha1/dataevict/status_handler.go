@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataevict
+
+import (
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataevict "github.com/fluid-cloudnative/fluid/pkg/dataevict"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+)
+
+type OnceStatusHandler struct {
+	dataEvict *datav1alpha1.DataEvict
+}
+
+var _ dataoperation.StatusHandler = &OnceStatusHandler{}
+
+type CronStatusHandler struct {
+	dataEvict *datav1alpha1.DataEvict
+}
+
+var _ dataoperation.StatusHandler = &CronStatusHandler{}
+
+// GetOperationStatus returns the status as it is, because the status of DataEvict is updated
+// by the engine right after the cache is evicted and there is no job to check.
+func (o *OnceStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
+	return opStatus.DeepCopy(), nil
+}
+
+// GetOperationStatus sets the finished DataEvict back to pending when it's time to evict the cache again.
+func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
+	result = opStatus.DeepCopy()
+	if opStatus.Phase != common.PhaseComplete && opStatus.Phase != common.PhaseFailed {
+		return
+	}
+
+	dataEvict := c.dataEvict.DeepCopy()
+	dataEvict.Status = *opStatus
+	nextScheduleTime, parseErr := cdataevict.GetNextScheduleTime(dataEvict)
+	if parseErr != nil {
+		// the invalid schedule has been reported when validating, no need to retry
+		ctx.Log.Error(parseErr, "failed to get the next schedule time of DataEvict")
+		return
+	}
+	if time.Now().Before(nextScheduleTime) {
+		return
+	}
+
+	// dataset will be locked only when DataEvict pending
+	result.Phase = common.PhasePending
+	result.Duration = "-"
+	return
+}
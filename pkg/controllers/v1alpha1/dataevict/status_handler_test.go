@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataevict
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("StatusHandler", func() {
+	var (
+		dataEvict *datav1alpha1.DataEvict
+		ctx       cruntime.ReconcileRequestContext
+	)
+
+	BeforeEach(func() {
+		dataEvict = &datav1alpha1.DataEvict{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "test-evict",
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+			Spec: datav1alpha1.DataEvictSpec{
+				Dataset:  datav1alpha1.TargetDataset{Name: "hadoop", Namespace: "default"},
+				Policy:   datav1alpha1.Cron,
+				Schedule: "*/5 * * * *",
+			},
+		}
+		ctx = cruntime.ReconcileRequestContext{Log: fake.NullLogger()}
+	})
+
+	Describe("OnceStatusHandler", func() {
+		It("should return the status as it is", func() {
+			opStatus := &datav1alpha1.OperationStatus{Phase: common.PhaseComplete, Duration: "1s"}
+			handler := &OnceStatusHandler{dataEvict: dataEvict}
+
+			result, err := handler.GetOperationStatus(ctx, opStatus)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(opStatus))
+		})
+	})
+
+	Describe("CronStatusHandler", func() {
+		It("should set the finished DataEvict to pending when it's time to evict again", func() {
+			lastScheduleTime := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+			opStatus := &datav1alpha1.OperationStatus{
+				Phase:            common.PhaseComplete,
+				Duration:         "1s",
+				LastScheduleTime: &lastScheduleTime,
+			}
+			handler := &CronStatusHandler{dataEvict: dataEvict}
+
+			result, err := handler.GetOperationStatus(ctx, opStatus)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Phase).To(Equal(common.PhasePending))
+			Expect(result.Duration).To(Equal("-"))
+		})
+
+		It("should keep the status when it's not time to evict again", func() {
+			lastScheduleTime := metav1.NewTime(time.Now())
+			dataEvict.Spec.Schedule = "0 0 1 1 *"
+			opStatus := &datav1alpha1.OperationStatus{
+				Phase:            common.PhaseFailed,
+				Duration:         "1s",
+				LastScheduleTime: &lastScheduleTime,
+			}
+			handler := &CronStatusHandler{dataEvict: dataEvict}
+
+			result, err := handler.GetOperationStatus(ctx, opStatus)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Phase).To(Equal(common.PhaseFailed))
+			Expect(result.Duration).To(Equal("1s"))
+		})
+
+		It("should keep the status when DataEvict is not finished", func() {
+			opStatus := &datav1alpha1.OperationStatus{Phase: common.PhaseExecuting}
+			handler := &CronStatusHandler{dataEvict: dataEvict}
+
+			result, err := handler.GetOperationStatus(ctx, opStatus)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Phase).To(Equal(common.PhaseExecuting))
+		})
+
+		It("should keep the status when the schedule is invalid", func() {
+			dataEvict.Spec.Schedule = "invalid"
+			opStatus := &datav1alpha1.OperationStatus{Phase: common.PhaseComplete, Duration: "1s"}
+			handler := &CronStatusHandler{dataEvict: dataEvict}
+
+			result, err := handler.GetOperationStatus(ctx, opStatus)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Phase).To(Equal(common.PhaseComplete))
+		})
+	})
+})
@@ -59,6 +59,7 @@ var reconcileKinds = map[string]client.Object{
 	"dataload":    &datav1alpha1.DataLoad{},
 	"datamigrate": &datav1alpha1.DataMigrate{},
 	"dataprocess": &datav1alpha1.DataProcess{},
+	"dataevict":   &datav1alpha1.DataEvict{},
 }
 
 func setupWatches(bld *builder.Builder, handler *handler.EnqueueRequestForObject, predicates builder.Predicates) *builder.Builder {
@@ -103,6 +104,7 @@ var reconcileFuncs = []func(reconcileRequestContext) (bool, error){
 	reconcileDataMigrate,
 	reconcileDataProcess,
 	reconcileDataBackup,
+	reconcileDataEvict,
 }
 
 func (r *DataFlowReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
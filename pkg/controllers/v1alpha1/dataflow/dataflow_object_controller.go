@@ -0,0 +1,296 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataflow "github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/discovery"
+)
+
+const dataFlowObjectControllerName string = "DataFlowObjectReconciler"
+
+// stepOperationKinds are the kinds of data operations that can be created by the steps of DataFlow
+var stepOperationKinds = map[string]client.Object{
+	"dataload":    &datav1alpha1.DataLoad{},
+	"datamigrate": &datav1alpha1.DataMigrate{},
+	"dataprocess": &datav1alpha1.DataProcess{},
+}
+
+// DataFlowObjectReconciler reconciles a DataFlow object, it creates the data operations of the steps
+// in the order of their dependencies and aggregates their status.
+type DataFlowObjectReconciler struct {
+	client.Client
+	Recorder     record.EventRecorder
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+}
+
+// NewDataFlowObjectReconciler returns a DataFlowObjectReconciler
+func NewDataFlowObjectReconciler(client client.Client,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	resyncPeriod time.Duration) *DataFlowObjectReconciler {
+	return &DataFlowObjectReconciler{
+		Client:       client,
+		Recorder:     recorder,
+		Log:          log,
+		ResyncPeriod: resyncPeriod,
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataflows,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataflows/status,verbs=get;update;patch
+// Reconcile reconciles the DataFlow object
+func (r *DataFlowObjectReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("dataflow", req.NamespacedName)
+	log.V(1).Info("reconcile starts")
+	defer log.V(1).Info("reconcile ends")
+
+	dataFlow, err := utils.GetDataFlow(r.Client, req.Name, req.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			return utils.NoRequeue()
+		}
+		return utils.RequeueIfError(errors.Wrap(err, "failed to get dataflow"))
+	}
+
+	// the data operations of the steps are garbage collected with the DataFlow by their owner references
+	if !dataFlow.GetDeletionTimestamp().IsZero() {
+		return utils.NoRequeue()
+	}
+
+	if dataFlow.Status.Phase == common.PhaseComplete || dataFlow.Status.Phase == common.PhaseFailed {
+		return utils.NoRequeue()
+	}
+
+	toUpdate := dataFlow.DeepCopy()
+	if toUpdate.Status.StartTime == nil {
+		toUpdate.Status.StartTime = &metav1.Time{Time: time.Now()}
+	}
+
+	if err := cdataflow.ValidateDataFlowSteps(dataFlow.Spec.Steps); err != nil {
+		log.Error(err, "invalid steps of dataflow")
+		r.Recorder.Eventf(dataFlow, corev1.EventTypeWarning, common.DataFlowNotValid, "DataFlow %s is not valid: %v", dataFlow.Name, err)
+		setDataFlowFinished(toUpdate, common.PhaseFailed, common.DataFlowNotValid, err.Error())
+		return r.updateStatus(dataFlow, toUpdate)
+	}
+
+	stepStatuses, err := r.reconcileSteps(log, toUpdate)
+	if err != nil {
+		return utils.RequeueIfError(err)
+	}
+	toUpdate.Status.Steps = stepStatuses
+
+	switch phase, message := aggregateDataFlowPhase(stepStatuses); phase {
+	case common.PhaseComplete:
+		setDataFlowFinished(toUpdate, common.PhaseComplete, common.DataFlowSucceed, message)
+		r.Recorder.Eventf(dataFlow, corev1.EventTypeNormal, common.DataFlowSucceed, "DataFlow %s succeeded", dataFlow.Name)
+	case common.PhaseFailed:
+		setDataFlowFinished(toUpdate, common.PhaseFailed, common.DataFlowFailed, message)
+		r.Recorder.Eventf(dataFlow, corev1.EventTypeWarning, common.DataFlowFailed, "DataFlow %s failed: %s", dataFlow.Name, message)
+	default:
+		toUpdate.Status.Phase = phase
+		toUpdate.Status.Duration = "Unfinished"
+	}
+
+	result, err := r.updateStatus(dataFlow, toUpdate)
+	if err != nil || toUpdate.Status.Phase == common.PhaseComplete || toUpdate.Status.Phase == common.PhaseFailed {
+		return result, err
+	}
+	return utils.RequeueAfterInterval(r.ResyncPeriod)
+}
+
+// reconcileSteps gets the data operations of the steps, and creates the data operations of the steps whose
+// dependencies are all complete. It returns the status of the steps in the order of the steps.
+// The data operation of a step is created only once, the step recorded in the status of the DataFlow keeps its
+// recorded phase if its data operation is deleted after finishing, and it fails if deleted before finishing.
+func (r *DataFlowObjectReconciler) reconcileSteps(log logr.Logger, dataFlow *datav1alpha1.DataFlow) ([]datav1alpha1.DataFlowStepStatus, error) {
+	steps := dataFlow.Spec.Steps
+	stepStatuses := make([]datav1alpha1.DataFlowStepStatus, len(steps))
+	stepIndex := make(map[string]int, len(steps))
+	recordedSteps := make(map[string]datav1alpha1.DataFlowStepStatus, len(dataFlow.Status.Steps))
+	for _, stepStatus := range dataFlow.Status.Steps {
+		recordedSteps[stepStatus.Name] = stepStatus
+	}
+	hasFailedStep := false
+	for i, step := range steps {
+		stepIndex[step.Name] = i
+		stepStatuses[i].Name = step.Name
+
+		opRef, err := getStepOperationRef(dataFlow, step)
+		if err != nil {
+			return nil, err
+		}
+		opStatus, err := utils.GetPrecedingOperationStatus(r.Client, opRef, dataFlow.Namespace)
+		if err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				if recorded, found := recordedSteps[step.Name]; found && recorded.Operation != nil {
+					stepStatuses[i] = recorded
+					if recorded.Phase != common.PhaseComplete && !isStepFailed(recorded.Phase) {
+						log.Info("the data operation of step is deleted before finishing", "step", step.Name, "kind", opRef.Kind, "name", opRef.Name)
+						stepStatuses[i].Phase = common.PhaseFailed
+					}
+					hasFailedStep = hasFailedStep || isStepFailed(stepStatuses[i].Phase)
+				}
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get the %s of step %s", opRef.Kind, step.Name)
+		}
+
+		stepStatuses[i].Operation = opRef
+		stepStatuses[i].Phase = opStatus.Phase
+		if stepStatuses[i].Phase == common.PhaseNone {
+			stepStatuses[i].Phase = common.PhasePending
+		}
+		stepStatuses[i].Duration = opStatus.Duration
+		hasFailedStep = hasFailedStep || isStepFailed(stepStatuses[i].Phase)
+	}
+
+	if hasFailedStep && dataFlow.Spec.FailurePolicy != datav1alpha1.ContinueOnFailure {
+		return stepStatuses, nil
+	}
+
+	for i, step := range steps {
+		if stepStatuses[i].Operation != nil || !isStepReady(step, stepStatuses, stepIndex) {
+			continue
+		}
+
+		opRef, err := getStepOperationRef(dataFlow, step)
+		if err != nil {
+			return nil, err
+		}
+		operation, err := newStepOperation(dataFlow, step)
+		if err != nil {
+			return nil, err
+		}
+		if err = r.Client.Create(context.TODO(), operation); err != nil && utils.IgnoreAlreadyExists(err) != nil {
+			return nil, errors.Wrapf(err, "failed to create the %s of step %s", opRef.Kind, step.Name)
+		}
+		log.Info("created the data operation of step", "step", step.Name, "kind", opRef.Kind, "name", opRef.Name)
+		r.Recorder.Eventf(dataFlow, corev1.EventTypeNormal, common.DataFlowStepCreated, "Created %s %s for step %s", opRef.Kind, opRef.Name, step.Name)
+
+		stepStatuses[i].Operation = opRef
+		stepStatuses[i].Phase = common.PhasePending
+	}
+
+	return stepStatuses, nil
+}
+
+func (r *DataFlowObjectReconciler) updateStatus(dataFlow, toUpdate *datav1alpha1.DataFlow) (ctrl.Result, error) {
+	if reflect.DeepEqual(dataFlow.Status, toUpdate.Status) {
+		return utils.NoRequeue()
+	}
+	if err := r.Client.Status().Update(context.TODO(), toUpdate); err != nil {
+		return utils.RequeueIfError(errors.Wrap(err, "failed to update dataflow status"))
+	}
+	return utils.NoRequeue()
+}
+
+func (r *DataFlowObjectReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	bld := ctrl.NewControllerManagedBy(mgr).WithOptions(options).For(&datav1alpha1.DataFlow{})
+	for kind, obj := range stepOperationKinds {
+		if discovery.GetFluidDiscovery().ResourceEnabled(kind) {
+			bld = bld.Owns(obj)
+		}
+	}
+	return bld.Complete(r)
+}
+
+func (r *DataFlowObjectReconciler) ControllerName() string {
+	return dataFlowObjectControllerName
+}
+
+// isStepReady checks if all the dependencies of the step are complete.
+func isStepReady(step datav1alpha1.DataFlowStep, stepStatuses []datav1alpha1.DataFlowStepStatus, stepIndex map[string]int) bool {
+	for _, dependency := range step.Dependencies {
+		if stepStatuses[stepIndex[dependency]].Phase != common.PhaseComplete {
+			return false
+		}
+	}
+	return true
+}
+
+func isStepFailed(phase common.Phase) bool {
+	return phase == common.PhaseFailed || phase == common.PhaseCancelled
+}
+
+// aggregateDataFlowPhase aggregates the phase of the DataFlow from the status of its steps. It must be called after
+// the data operations of the ready steps are created, so the DataFlow fails if some steps failed and no step is running.
+func aggregateDataFlowPhase(stepStatuses []datav1alpha1.DataFlowStepStatus) (phase common.Phase, message string) {
+	var failedSteps []string
+	allComplete, running := true, false
+	for _, stepStatus := range stepStatuses {
+		switch {
+		case stepStatus.Phase == common.PhaseComplete:
+			continue
+		case isStepFailed(stepStatus.Phase):
+			failedSteps = append(failedSteps, stepStatus.Name)
+		case stepStatus.Operation != nil:
+			running = true
+		}
+		allComplete = false
+	}
+
+	switch {
+	case allComplete:
+		return common.PhaseComplete, "all steps are complete"
+	case running:
+		return common.PhaseExecuting, ""
+	case len(failedSteps) != 0:
+		return common.PhaseFailed, "steps failed: " + strings.Join(failedSteps, ", ")
+	default:
+		return common.PhasePending, ""
+	}
+}
+
+func setDataFlowFinished(dataFlow *datav1alpha1.DataFlow, phase common.Phase, reason, message string) {
+	now := metav1.Now()
+	conditionType := common.Complete
+	if phase == common.PhaseFailed {
+		conditionType = common.Failed
+	}
+	dataFlow.Status.Phase = phase
+	dataFlow.Status.CompletionTime = &now
+	dataFlow.Status.Duration = utils.CalculateDuration(dataFlow.Status.StartTime.Time, now.Time)
+	dataFlow.Status.Conditions = []datav1alpha1.Condition{
+		{
+			Type:               conditionType,
+			Status:             corev1.ConditionTrue,
+			Reason:             reason,
+			Message:            message,
+			LastProbeTime:      now,
+			LastTransitionTime: now,
+		},
+	}
+}
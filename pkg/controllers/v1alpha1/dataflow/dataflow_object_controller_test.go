@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("DataFlowObjectReconciler", func() {
+	const (
+		flowName  = "flow"
+		namespace = "default"
+	)
+
+	var (
+		s        *runtime.Scheme
+		dataFlow *datav1alpha1.DataFlow
+		req      ctrl.Request
+	)
+
+	newReconciler := func(objs ...runtime.Object) *DataFlowObjectReconciler {
+		fakeClient := fake.NewFakeClientWithScheme(s, objs...)
+		return NewDataFlowObjectReconciler(fakeClient, logf.Log.WithName("dataflow-object-test"), record.NewFakeRecorder(32), 5*time.Second)
+	}
+
+	newDataLoad := func(step string, phase common.Phase) *datav1alpha1.DataLoad {
+		return &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: flowName + "-" + step, Namespace: namespace},
+			Status:     datav1alpha1.OperationStatus{Phase: phase},
+		}
+	}
+
+	getDataFlow := func(r *DataFlowObjectReconciler) *datav1alpha1.DataFlow {
+		got := &datav1alpha1.DataFlow{}
+		Expect(r.Get(context.TODO(), req.NamespacedName, got)).To(Succeed())
+		return got
+	}
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+
+		// load-a and load-b fan out from the root, and process fans in from both of them
+		dataFlow = &datav1alpha1.DataFlow{
+			ObjectMeta: metav1.ObjectMeta{Name: flowName, Namespace: namespace, UID: "flow-uid"},
+			Spec: datav1alpha1.DataFlowSpec{
+				Steps: []datav1alpha1.DataFlowStep{
+					{Name: "load-a", DataLoad: &datav1alpha1.DataLoadSpec{Dataset: datav1alpha1.TargetDataset{Name: "a"}}},
+					{Name: "load-b", DataLoad: &datav1alpha1.DataLoadSpec{Dataset: datav1alpha1.TargetDataset{Name: "b"}}},
+					{
+						Name:         "process",
+						Dependencies: []string{"load-a", "load-b"},
+						AffinityStrategy: &datav1alpha1.DataFlowAffinityStrategy{
+							DependOn: "load-b",
+							Policy:   datav1alpha1.RequireAffinityStrategy,
+						},
+						DataProcess: &datav1alpha1.DataProcessSpec{Dataset: datav1alpha1.TargetDatasetWithMountPath{TargetDataset: datav1alpha1.TargetDataset{Name: "a"}}},
+					},
+				},
+			},
+		}
+		req = ctrl.Request{NamespacedName: types.NamespacedName{Name: flowName, Namespace: namespace}}
+	})
+
+	It("should create the data operations of the steps without dependencies", func() {
+		r := newReconciler(dataFlow)
+
+		result, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(5 * time.Second))
+
+		for _, name := range []string{"flow-load-a", "flow-load-b"} {
+			dataLoad := &datav1alpha1.DataLoad{}
+			Expect(r.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, dataLoad)).To(Succeed())
+			Expect(dataLoad.Labels).To(HaveKeyWithValue(common.LabelDataFlowName, flowName))
+			Expect(dataLoad.OwnerReferences).To(HaveLen(1))
+			Expect(dataLoad.OwnerReferences[0].Kind).To(Equal("DataFlow"))
+			Expect(dataLoad.Spec.RunAfter).To(BeNil())
+		}
+		err = r.Get(context.TODO(), types.NamespacedName{Name: "flow-process", Namespace: namespace}, &datav1alpha1.DataProcess{})
+		Expect(err).To(HaveOccurred())
+
+		got := getDataFlow(r)
+		Expect(got.Status.Phase).To(Equal(common.PhaseExecuting))
+		Expect(got.Status.StartTime).NotTo(BeNil())
+		Expect(got.Status.Steps).To(HaveLen(3))
+		Expect(got.Status.Steps[0].Phase).To(Equal(common.PhasePending))
+		Expect(got.Status.Steps[0].Operation.Kind).To(Equal("DataLoad"))
+		Expect(got.Status.Steps[2].Phase).To(Equal(common.PhaseNone))
+		Expect(got.Status.Steps[2].Operation).To(BeNil())
+	})
+
+	It("should wait until all the dependencies are complete", func() {
+		r := newReconciler(dataFlow, newDataLoad("load-a", common.PhaseComplete), newDataLoad("load-b", common.PhaseExecuting))
+
+		_, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = r.Get(context.TODO(), types.NamespacedName{Name: "flow-process", Namespace: namespace}, &datav1alpha1.DataProcess{})
+		Expect(err).To(HaveOccurred())
+		got := getDataFlow(r)
+		Expect(got.Status.Phase).To(Equal(common.PhaseExecuting))
+		Expect(got.Status.Steps[0].Phase).To(Equal(common.PhaseComplete))
+		Expect(got.Status.Steps[1].Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should create the data operation with the affinity of the dependency once the dependencies are complete", func() {
+		r := newReconciler(dataFlow, newDataLoad("load-a", common.PhaseComplete), newDataLoad("load-b", common.PhaseComplete))
+
+		_, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		dataProcess := &datav1alpha1.DataProcess{}
+		Expect(r.Get(context.TODO(), types.NamespacedName{Name: "flow-process", Namespace: namespace}, dataProcess)).To(Succeed())
+		Expect(dataProcess.Spec.Dataset.Name).To(Equal("a"))
+		Expect(dataProcess.Spec.RunAfter).NotTo(BeNil())
+		Expect(dataProcess.Spec.RunAfter.Kind).To(Equal("DataLoad"))
+		Expect(dataProcess.Spec.RunAfter.Name).To(Equal("flow-load-b"))
+		Expect(dataProcess.Spec.RunAfter.AffinityStrategy.Policy).To(Equal(datav1alpha1.RequireAffinityStrategy))
+	})
+
+	It("should complete when all the steps are complete", func() {
+		dataProcess := &datav1alpha1.DataProcess{
+			ObjectMeta: metav1.ObjectMeta{Name: "flow-process", Namespace: namespace},
+			Status:     datav1alpha1.OperationStatus{Phase: common.PhaseComplete, Duration: "1m0s"},
+		}
+		r := newReconciler(dataFlow, newDataLoad("load-a", common.PhaseComplete), newDataLoad("load-b", common.PhaseComplete), dataProcess)
+
+		result, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		got := getDataFlow(r)
+		Expect(got.Status.Phase).To(Equal(common.PhaseComplete))
+		Expect(got.Status.CompletionTime).NotTo(BeNil())
+		Expect(got.Status.Steps[2].Duration).To(Equal("1m0s"))
+		Expect(got.Status.Conditions).To(HaveLen(1))
+		Expect(got.Status.Conditions[0].Type).To(Equal(common.Complete))
+	})
+
+	It("should fail without starting new steps when a step fails with StopOnFailure", func() {
+		r := newReconciler(dataFlow, newDataLoad("load-a", common.PhaseFailed))
+
+		_, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = r.Get(context.TODO(), types.NamespacedName{Name: "flow-load-b", Namespace: namespace}, &datav1alpha1.DataLoad{})
+		Expect(err).To(HaveOccurred())
+		got := getDataFlow(r)
+		Expect(got.Status.Phase).To(Equal(common.PhaseFailed))
+		Expect(got.Status.Conditions[0].Type).To(Equal(common.Failed))
+		Expect(got.Status.Conditions[0].Message).To(ContainSubstring("load-a"))
+	})
+
+	It("should keep starting the steps not depending on the failed step with ContinueOnFailure", func() {
+		dataFlow.Spec.FailurePolicy = datav1alpha1.ContinueOnFailure
+		r := newReconciler(dataFlow, newDataLoad("load-a", common.PhaseFailed))
+
+		_, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(r.Get(context.TODO(), types.NamespacedName{Name: "flow-load-b", Namespace: namespace}, &datav1alpha1.DataLoad{})).To(Succeed())
+		Expect(getDataFlow(r).Status.Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should not run the step again when its finished data operation is deleted", func() {
+		dataFlow.Status.Steps = []datav1alpha1.DataFlowStepStatus{
+			{Name: "load-a", Operation: &datav1alpha1.ObjectRef{Kind: "DataLoad", Name: "flow-load-a"}, Phase: common.PhaseComplete, Duration: "1m0s"},
+			{Name: "load-b", Operation: &datav1alpha1.ObjectRef{Kind: "DataLoad", Name: "flow-load-b"}, Phase: common.PhaseExecuting},
+		}
+		r := newReconciler(dataFlow, newDataLoad("load-b", common.PhaseExecuting))
+
+		_, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = r.Get(context.TODO(), types.NamespacedName{Name: "flow-load-a", Namespace: namespace}, &datav1alpha1.DataLoad{})
+		Expect(err).To(HaveOccurred())
+		got := getDataFlow(r)
+		Expect(got.Status.Phase).To(Equal(common.PhaseExecuting))
+		Expect(got.Status.Steps[0].Phase).To(Equal(common.PhaseComplete))
+		Expect(got.Status.Steps[0].Duration).To(Equal("1m0s"))
+	})
+
+	It("should fail the step whose data operation is deleted before finishing", func() {
+		dataFlow.Status.Steps = []datav1alpha1.DataFlowStepStatus{
+			{Name: "load-a", Operation: &datav1alpha1.ObjectRef{Kind: "DataLoad", Name: "flow-load-a"}, Phase: common.PhaseExecuting},
+			{Name: "load-b", Operation: &datav1alpha1.ObjectRef{Kind: "DataLoad", Name: "flow-load-b"}, Phase: common.PhaseComplete},
+		}
+		r := newReconciler(dataFlow, newDataLoad("load-b", common.PhaseComplete))
+
+		_, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = r.Get(context.TODO(), types.NamespacedName{Name: "flow-load-a", Namespace: namespace}, &datav1alpha1.DataLoad{})
+		Expect(err).To(HaveOccurred())
+		got := getDataFlow(r)
+		Expect(got.Status.Phase).To(Equal(common.PhaseFailed))
+		Expect(got.Status.Steps[0].Phase).To(Equal(common.PhaseFailed))
+		Expect(got.Status.Conditions[0].Message).To(ContainSubstring("load-a"))
+	})
+
+	It("should fail when the steps have circular dependencies", func() {
+		dataFlow.Spec.Steps[0].Dependencies = []string{"process"}
+		r := newReconciler(dataFlow)
+
+		_, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		got := getDataFlow(r)
+		Expect(got.Status.Phase).To(Equal(common.PhaseFailed))
+		Expect(got.Status.Conditions[0].Reason).To(Equal(common.DataFlowNotValid))
+	})
+
+	It("should not reconcile the finished dataflow", func() {
+		dataFlow.Status.Phase = common.PhaseComplete
+		r := newReconciler(dataFlow)
+
+		result, err := r.Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+		err = r.Get(context.TODO(), types.NamespacedName{Name: "flow-load-a", Namespace: namespace}, &datav1alpha1.DataLoad{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataflow "github.com/fluid-cloudnative/fluid/pkg/dataflow"
+)
+
+// getStepOperationRef returns the reference to the data operation created for the step.
+func getStepOperationRef(dataFlow *datav1alpha1.DataFlow, step datav1alpha1.DataFlowStep) (*datav1alpha1.ObjectRef, error) {
+	kind, err := cdataflow.GetStepOperationKind(step)
+	if err != nil {
+		return nil, err
+	}
+	return &datav1alpha1.ObjectRef{
+		APIVersion: datav1alpha1.GroupVersion.String(),
+		Kind:       string(kind),
+		Name:       cdataflow.GetStepOperationName(dataFlow.Name, step.Name),
+		Namespace:  dataFlow.Namespace,
+	}, nil
+}
+
+// newStepOperation builds the data operation of the step from its template, which is owned by the DataFlow.
+func newStepOperation(dataFlow *datav1alpha1.DataFlow, step datav1alpha1.DataFlowStep) (client.Object, error) {
+	objectMeta := metav1.ObjectMeta{
+		Name:      cdataflow.GetStepOperationName(dataFlow.Name, step.Name),
+		Namespace: dataFlow.Namespace,
+		Labels: map[string]string{
+			common.LabelDataFlowName: dataFlow.Name,
+		},
+		OwnerReferences: []metav1.OwnerReference{
+			*metav1.NewControllerRef(dataFlow, datav1alpha1.GroupVersion.WithKind("DataFlow")),
+		},
+	}
+
+	runAfter, err := genStepRunAfter(dataFlow, step)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case step.DataLoad != nil:
+		spec := step.DataLoad.DeepCopy()
+		if runAfter != nil {
+			spec.RunAfter = runAfter
+		}
+		return &datav1alpha1.DataLoad{ObjectMeta: objectMeta, Spec: *spec}, nil
+	case step.DataProcess != nil:
+		spec := step.DataProcess.DeepCopy()
+		if runAfter != nil {
+			spec.RunAfter = runAfter
+		}
+		return &datav1alpha1.DataProcess{ObjectMeta: objectMeta, Spec: *spec}, nil
+	case step.DataMigrate != nil:
+		spec := step.DataMigrate.DeepCopy()
+		if runAfter != nil {
+			spec.RunAfter = runAfter
+		}
+		return &datav1alpha1.DataMigrate{ObjectMeta: objectMeta, Spec: *spec}, nil
+	}
+	return nil, fmt.Errorf("step %q has no data operation template", step.Name)
+}
+
+// genStepRunAfter generates the RunAfter of the step's data operation from its affinity strategy. The dependency step
+// is already complete when the data operation is created, so RunAfter is only used to inject the affinity with
+// the data operation of the dependency step in the same way as the chained data operations.
+func genStepRunAfter(dataFlow *datav1alpha1.DataFlow, step datav1alpha1.DataFlowStep) (*datav1alpha1.OperationRef, error) {
+	if step.AffinityStrategy == nil || len(step.Dependencies) == 0 {
+		return nil, nil
+	}
+
+	dependOn := step.AffinityStrategy.DependOn
+	if len(dependOn) == 0 {
+		dependOn = step.Dependencies[0]
+	}
+	for _, dependency := range dataFlow.Spec.Steps {
+		if dependency.Name != dependOn {
+			continue
+		}
+		opRef, err := getStepOperationRef(dataFlow, dependency)
+		if err != nil {
+			return nil, err
+		}
+		return &datav1alpha1.OperationRef{
+			ObjectRef: *opRef,
+			AffinityStrategy: datav1alpha1.AffinityStrategy{
+				Policy:   step.AffinityStrategy.Policy,
+				Prefers:  step.AffinityStrategy.Prefers,
+				Requires: step.AffinityStrategy.Requires,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("step %q has affinity with step %q which doesn't exist", step.Name, dependOn)
+}
@@ -154,6 +154,37 @@ func reconcileDataProcess(ctx reconcileRequestContext) (needRequeue bool, err er
 	return reconcileOperationDataFlow(ctx, dataProcess, dataProcess.Spec.RunAfter, dataProcess.Status, updateStatusFn)
 }
 
+func reconcileDataEvict(ctx reconcileRequestContext) (needRequeue bool, err error) {
+	dataEvict, err := utils.GetDataEvict(ctx.Client, ctx.Name, ctx.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			ctx.Log.V(1).Info("DataEvict not found, skip reconciling")
+			return false, nil
+		}
+		return true, errors.Wrap(err, "failed to get dataevict")
+	}
+
+	updateStatusFn := func() error {
+		tmp, err := utils.GetDataEvict(ctx.Client, ctx.Name, ctx.Namespace)
+		if err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				return nil
+			}
+			return err
+		}
+
+		toUpdate := tmp.DeepCopy()
+		toUpdate.Status.WaitingFor.OperationComplete = ptr.To(false)
+		if !reflect.DeepEqual(toUpdate.Status, tmp.Status) {
+			return ctx.Client.Status().Update(context.TODO(), toUpdate)
+		}
+
+		return nil
+	}
+
+	return reconcileOperationDataFlow(ctx, dataEvict, dataEvict.Spec.RunAfter, dataEvict.Status, updateStatusFn)
+}
+
 func reconcileOperationDataFlow(ctx reconcileRequestContext,
 	object client.Object,
 	runAfter *datav1alpha1.OperationRef,
@@ -25,6 +25,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 
@@ -110,11 +111,35 @@ func (r *dataLoadOperation) Validate(ctx cruntime.ReconcileRequestContext) ([]da
 			},
 		}, err
 	}
+
+	// 2. Check the manifest and the file patterns are valid and supported by the runtime
+	err := cdataload.ValidateTargetFilters(dataLoad)
+	if err == nil {
+		err = cdataload.ValidateTargetFiltersSupported(dataLoad, ctx.RuntimeType)
+	}
+	if err != nil {
+		r.Recorder.Eventf(dataLoad,
+			v1.EventTypeWarning,
+			common.DataLoadInvalidTargetFilters,
+			"dataLoad(%s) has invalid target filters: %v",
+			dataLoad.Name, err)
+
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             v1.ConditionTrue,
+				Reason:             common.DataLoadInvalidTargetFilters,
+				Message:            err.Error(),
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(time.Now()),
+			},
+		}, err
+	}
 	return nil, nil
 }
 
 func (r *dataLoadOperation) UpdateStatusInfoForCompleted(infos map[string]string) error {
-	// DataLoad does not need to update OperationStatus's Infos field.
+	// The entries loaded, skipped or missing are set by the status handler when the job succeeds.
 	return nil
 }
 
@@ -162,3 +187,12 @@ func (r *dataLoadOperation) GetTTL() (ttl *int32, err error) {
 func (r *dataLoadOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
+
+func (r *dataLoadOperation) GetRetryPolicy() dataoperation.RetryPolicy {
+	return dataoperation.NewRetryPolicy(r.dataLoad.Spec.Policy, r.dataLoad.Spec.BackoffLimit, r.dataLoad.Spec.RetryBackoff, r.dataLoad.Spec.ActiveDeadlineSeconds)
+}
+
+func (r *dataLoadOperation) IsSuspended() bool {
+	// the cron DataLoad is suspended by suspending its cronjob in the status handler, the scheduled job is always tracked.
+	return r.dataLoad.Spec.Policy != datav1alpha1.Cron && ptr.Deref(r.dataLoad.Spec.Suspend, false)
+}
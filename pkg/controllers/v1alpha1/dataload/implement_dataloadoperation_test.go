@@ -168,6 +168,29 @@ var _ = Describe("dataLoadOperation", func() {
 		})
 	})
 
+	Describe("IsSuspended", func() {
+		It("returns false when Suspend is not set", func() {
+			op := newTestDataLoadOperation(mockDataLoad)
+			Expect(op.IsSuspended()).To(BeFalse())
+		})
+
+		It("returns true when Suspend is set for Once policy", func() {
+			suspend := true
+			mockDataLoad.Spec.Policy = datav1alpha1.Once
+			mockDataLoad.Spec.Suspend = &suspend
+			op := newTestDataLoadOperation(mockDataLoad)
+			Expect(op.IsSuspended()).To(BeTrue())
+		})
+
+		It("returns false when Suspend is set for Cron policy", func() {
+			suspend := true
+			mockDataLoad.Spec.Policy = datav1alpha1.Cron
+			mockDataLoad.Spec.Suspend = &suspend
+			op := newTestDataLoadOperation(mockDataLoad)
+			Expect(op.IsSuspended()).To(BeFalse())
+		})
+	})
+
 	Describe("GetStatusHandler", func() {
 		It("returns OnceStatusHandler for Once policy", func() {
 			mockDataLoad.Spec.Policy = datav1alpha1.Once
@@ -257,6 +280,35 @@ var _ = Describe("dataLoadOperation", func() {
 			Expect(conditions).To(HaveLen(1))
 			Expect(conditions[0].Type).To(Equal(common.Failed))
 		})
+
+		It("returns error when the manifest is invalid", func() {
+			mockDataLoad.Spec.Manifest = &datav1alpha1.DataLoadManifest{}
+			op := newTestDataLoadOperation(mockDataLoad)
+			ctx := cruntime.ReconcileRequestContext{Log: fake.NullLogger()}
+			conditions, err := op.Validate(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].Reason).To(Equal(common.DataLoadInvalidTargetFilters))
+		})
+
+		It("returns error when the file patterns are not supported by the runtime", func() {
+			mockDataLoad.Spec.Include = []string{"*.parquet"}
+			op := newTestDataLoadOperation(mockDataLoad)
+			ctx := cruntime.ReconcileRequestContext{Log: fake.NullLogger(), RuntimeType: common.JindoRuntime}
+			conditions, err := op.Validate(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].Reason).To(Equal(common.DataLoadInvalidTargetFilters))
+		})
+
+		It("returns nil when the file patterns are supported by the runtime", func() {
+			mockDataLoad.Spec.Include = []string{"*.parquet"}
+			op := newTestDataLoadOperation(mockDataLoad)
+			ctx := cruntime.ReconcileRequestContext{Log: fake.NullLogger(), RuntimeType: common.AlluxioRuntime}
+			conditions, err := op.Validate(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conditions).To(BeNil())
+		})
 	})
 })
 
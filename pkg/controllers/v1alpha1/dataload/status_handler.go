@@ -17,16 +17,21 @@
 package dataload
 
 import (
+	"context"
+
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation/progress"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
@@ -80,6 +85,13 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		return
 	}
 
+	// suspend or resume scheduling new jobs, the running job is not affected
+	suspend := ptr.Deref(c.dataLoad.Spec.Suspend, false)
+	if err = kubeclient.UpdateCronJobSuspend(c.Client, types.NamespacedName{Namespace: c.dataLoad.GetNamespace(), Name: cronjobName}, suspend); err != nil {
+		ctx.Log.Error(err, "can't update suspend of DataLoad cronjob", "namespace", ctx.Namespace, "cronjobName", cronjobName, "suspend", suspend)
+		return
+	}
+
 	// update LastScheduleTime and LastSuccessfulTime
 	result.LastScheduleTime = cronjobStatus.LastScheduleTime
 	result.LastSuccessfulTime = cronjobStatus.LastSuccessfulTime
@@ -90,6 +102,9 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		return
 	}
 
+	// record the recent runs by the jobs of the cronjob
+	utils.UpdateRecentRuns(result, jobs)
+
 	var currentJob *batchv1.Job
 	for _, job := range jobs {
 		if job.CreationTimestamp == *cronjobStatus.LastScheduleTime || job.CreationTimestamp.After(cronjobStatus.LastScheduleTime.Time) {
@@ -111,7 +126,10 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 			// dataset will be locked only when dataload pending
 			result.Phase = common.PhasePending
 			result.Duration = "-"
+			result.Progress = nil
+			return
 		}
+		progress.UpdateJobProgress(ctx, c.Client, currentJob, result)
 		return
 	}
 	// job either failed or complete, update dataload's phase status
@@ -129,6 +147,8 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		result.Phase = common.PhaseFailed
 	} else {
 		result.Phase = common.PhaseComplete
+		result.Progress = progress.Complete(result.Progress)
+		updateLoadResult(ctx, c.Client, currentJob, result)
 	}
 	result.Duration = utils.CalculateDuration(currentJob.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
 	return
@@ -165,6 +185,7 @@ func getJobOperationStatus(ctx cruntime.ReconcileRequestContext, c client.Client
 	finishedJobCondition := kubeclient.GetFinishedJobCondition(job)
 	if finishedJobCondition == nil {
 		ctx.Log.V(1).Info("DataLoad job still running", "namespace", namespace, "jobName", jobName)
+		progress.UpdateJobProgress(ctx, c, job, result)
 		return
 	}
 	isJobSucceed := finishedJobCondition.Type == batchv1.JobComplete
@@ -188,9 +209,34 @@ func getJobOperationStatus(ctx cruntime.ReconcileRequestContext, c client.Client
 	}
 	if isJobSucceed {
 		result.Phase = common.PhaseComplete
+		result.Progress = progress.Complete(result.Progress)
+		updateLoadResult(ctx, c, job, result)
 	} else {
 		result.Phase = common.PhaseFailed
 	}
 	result.Duration = utils.CalculateDuration(job.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
 	return
 }
+
+// updateLoadResult sets how many entries are loaded, skipped or missing, which are reported by the succeeded
+// loader pod, into the infos of the operation status.
+func updateLoadResult(ctx cruntime.ReconcileRequestContext, c client.Client, job *batchv1.Job, opStatus *datav1alpha1.OperationStatus) {
+	reqCtx := ctx.Context
+	if reqCtx == nil {
+		reqCtx = context.TODO()
+	}
+	loadResult, err := cdataload.GetLoadResultOfJob(reqCtx, c, job)
+	if err != nil {
+		ctx.Log.Error(err, "failed to get the load result of job, skip", "namespace", job.Namespace, "jobName", job.Name)
+		return
+	}
+	if len(loadResult) == 0 {
+		return
+	}
+	if opStatus.Infos == nil {
+		opStatus.Infos = map[string]string{}
+	}
+	for key, value := range loadResult {
+		opStatus.Infos[key] = value
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package dataload
 
 import (
+	"context"
 	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -30,9 +31,11 @@ import (
 
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 )
 
 const (
@@ -197,6 +200,121 @@ var _ = Describe("OnceStatusHandler", func() {
 		Expect(opStatus).NotTo(BeIdenticalTo(&mockDataload.Status))
 		Expect(*opStatus).To(Equal(*originalStatus))
 	})
+
+	It("GetOperationStatus updates the progress reported by the running loader pod", func() {
+		runningJob := batchv1.Job{
+			ObjectMeta: v1.ObjectMeta{Name: loaderJobName, Namespace: defaultNamespace},
+			Spec: batchv1.JobSpec{
+				Selector: &v1.LabelSelector{MatchLabels: map[string]string{"job-name": loaderJobName}},
+			},
+		}
+		loaderPod := corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      loaderJobName + "-abcde",
+				Namespace: defaultNamespace,
+				Labels:    map[string]string{"job-name": loaderJobName},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "dataloader"}}},
+			Status: corev1.PodStatus{
+				Phase:     corev1.PodRunning,
+				StartTime: &v1.Time{Time: time.Now().Add(-time.Minute)},
+			},
+		}
+		Expect(corev1.AddToScheme(testScheme)).To(Succeed())
+		client := fake.NewFakeClientWithScheme(testScheme, &mockDataload, &runningJob, &loaderPod)
+		handler := &OnceStatusHandler{Client: client, dataLoad: &mockDataload}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{Namespace: defaultNamespace, Name: ""},
+			Log:            fake.NullLogger(),
+		}
+		patches := gomonkey.ApplyFunc(kubeclient.ExecCommandInContainerWithTimeoutContext,
+			func(_ context.Context, podName string, containerName string, namespace string, cmd []string, timeout time.Duration) (string, string, error) {
+				return "processedFiles=1\ntotalFiles=4\nprocessedBytes=1024\ntotalBytes=4096", "", nil
+			})
+		defer patches.Reset()
+
+		opStatus, err := handler.GetOperationStatus(ctx, &mockDataload.Status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opStatus.Phase).To(Equal(mockDataload.Status.Phase))
+		Expect(opStatus.Progress).NotTo(BeNil())
+		Expect(opStatus.Progress.ProcessedFiles).To(Equal(int64(1)))
+		Expect(opStatus.Progress.Percentage).To(Equal("25.0%"))
+	})
+
+	It("GetOperationStatus marks the progress complete when job succeeds", func() {
+		mockDataload.Status.Progress = &v1alpha1.OperationProgress{ProcessedBytes: 1024, TotalBytes: 4096, Percentage: "25.0%", ETA: "1m"}
+		completedJob := batchv1.Job{
+			ObjectMeta: v1.ObjectMeta{Name: loaderJobName, Namespace: defaultNamespace},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{
+					Type:               batchv1.JobComplete,
+					Status:             corev1.ConditionTrue,
+					LastProbeTime:      v1.NewTime(time.Now()),
+					LastTransitionTime: v1.NewTime(time.Now()),
+				}},
+			},
+		}
+		client := fake.NewFakeClientWithScheme(testScheme, &mockDataload, &completedJob)
+		handler := &OnceStatusHandler{Client: client, dataLoad: &mockDataload}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{Namespace: defaultNamespace, Name: ""},
+			Log:            fake.NullLogger(),
+		}
+
+		opStatus, err := handler.GetOperationStatus(ctx, &mockDataload.Status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opStatus.Phase).To(Equal(common.PhaseComplete))
+		Expect(opStatus.Progress.ProcessedBytes).To(Equal(int64(4096)))
+		Expect(opStatus.Progress.Percentage).To(Equal("100.0%"))
+		Expect(opStatus.Progress.ETA).To(BeEmpty())
+	})
+
+	It("GetOperationStatus records the entries reported by the succeeded loader pod in infos", func() {
+		completedJob := batchv1.Job{
+			ObjectMeta: v1.ObjectMeta{Name: loaderJobName, Namespace: defaultNamespace},
+			Spec: batchv1.JobSpec{
+				Selector: &v1.LabelSelector{MatchLabels: map[string]string{"job-name": loaderJobName}},
+			},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{
+					Type:               batchv1.JobComplete,
+					Status:             corev1.ConditionTrue,
+					LastProbeTime:      v1.NewTime(time.Now()),
+					LastTransitionTime: v1.NewTime(time.Now()),
+				}},
+			},
+		}
+		loaderPod := corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      loaderJobName + "-abcde",
+				Namespace: defaultNamespace,
+				Labels:    map[string]string{"job-name": loaderJobName},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name: "dataloader",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						Message: "loadedEntries=198\nskippedEntries=1\nmissingEntries=1\n",
+					}},
+				}},
+			},
+		}
+		Expect(corev1.AddToScheme(testScheme)).To(Succeed())
+		client := fake.NewFakeClientWithScheme(testScheme, &mockDataload, &completedJob, &loaderPod)
+		handler := &OnceStatusHandler{Client: client, dataLoad: &mockDataload}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{Namespace: defaultNamespace, Name: ""},
+			Log:            fake.NullLogger(),
+		}
+
+		opStatus, err := handler.GetOperationStatus(ctx, &mockDataload.Status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opStatus.Phase).To(Equal(common.PhaseComplete))
+		Expect(opStatus.Infos).To(HaveKeyWithValue(cdataload.LoadedEntries, "198"))
+		Expect(opStatus.Infos).To(HaveKeyWithValue(cdataload.SkippedEntries, "1"))
+		Expect(opStatus.Infos).To(HaveKeyWithValue(cdataload.MissingEntries, "1"))
+	})
 })
 
 var _ = Describe("CronStatusHandler", func() {
@@ -276,6 +394,10 @@ var _ = Describe("CronStatusHandler", func() {
 		Expect(opStatus.LastScheduleTime).To(Equal(&lastScheduleTime))
 		Expect(opStatus.LastSuccessfulTime).To(Equal(&lastSuccessfulTime))
 		Expect(opStatus.Phase).To(Equal(common.PhaseComplete))
+		Expect(opStatus.RecentRuns).To(HaveLen(1))
+		Expect(opStatus.RecentRuns[0].JobName).To(Equal(job.Name))
+		Expect(opStatus.RecentRuns[0].Phase).To(Equal(common.PhaseComplete))
+		Expect(opStatus.RecentRuns[0].Duration).To(Equal("10s"))
 	})
 
 	It("running job yields PhasePending", func() {
@@ -299,6 +421,22 @@ var _ = Describe("CronStatusHandler", func() {
 		Expect(opStatus.Phase).To(Equal(common.PhasePending))
 	})
 
+	It("suspends the cronjob when the DataLoad is suspended", func() {
+		suspend := true
+		mockCronDataload.Spec.Suspend = &suspend
+		client := fake.NewFakeClientWithScheme(testScheme, &mockCronDataload, &mockCronJob)
+		handler := &CronStatusHandler{Client: client, dataLoad: &mockCronDataload}
+		ctx := cruntime.ReconcileRequestContext{Log: fake.NullLogger()}
+
+		_, err := handler.GetOperationStatus(ctx, &mockCronDataload.Status)
+		Expect(err).NotTo(HaveOccurred())
+
+		var cronJob batchv1.CronJob
+		Expect(client.Get(context.TODO(), types.NamespacedName{Namespace: defaultNamespace, Name: loaderJobName}, &cronJob)).To(Succeed())
+		Expect(cronJob.Spec.Suspend).NotTo(BeNil())
+		Expect(*cronJob.Spec.Suspend).To(BeTrue())
+	})
+
 	It("returns status with timestamps when no current job matches schedule time", func() {
 		// no jobs in the fake client that match lastScheduleTime
 		client := fake.NewFakeClientWithScheme(testScheme, &mockCronDataload, &mockCronJob)
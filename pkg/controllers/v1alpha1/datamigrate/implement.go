@@ -26,6 +26,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 
 	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
@@ -101,8 +102,8 @@ func (r *dataMigrateOperation) UpdateOperationApiStatus(opStatus *datav1alpha1.O
 func (r *dataMigrateOperation) Validate(ctx cruntime.ReconcileRequestContext) ([]datav1alpha1.Condition, error) {
 	targetDataSet := ctx.Dataset
 
-	// parallel data migration must specify the ssh secret name
-	if r.dataMigrate.Spec.Parallelism > 1 {
+	// parallel data migration must specify the ssh secret name if it runs in the workers connected by ssh
+	if r.dataMigrate.Spec.Parallelism > 1 && cdatamigrate.NeedParallelWorkers(ctx.RuntimeType) {
 		if r.dataMigrate.Spec.ParallelOptions == nil || len(r.dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHSecretName]) == 0 {
 			err := fmt.Errorf("DataMigrate(%s) with parallel tasks does not set the SSHSecretName", r.dataMigrate.GetName())
 			return []datav1alpha1.Condition{
@@ -183,3 +184,12 @@ func (r *dataMigrateOperation) GetTTL() (ttl *int32, err error) {
 func (r *dataMigrateOperation) GetParallelTaskNumber() int32 {
 	return r.dataMigrate.Spec.Parallelism
 }
+
+func (r *dataMigrateOperation) GetRetryPolicy() dataoperation.RetryPolicy {
+	return dataoperation.NewRetryPolicy(r.dataMigrate.Spec.Policy, r.dataMigrate.Spec.BackoffLimit, r.dataMigrate.Spec.RetryBackoff, r.dataMigrate.Spec.ActiveDeadlineSeconds)
+}
+
+func (r *dataMigrateOperation) IsSuspended() bool {
+	// the cron DataMigrate is suspended by suspending its cronjob in the status handler, the scheduled job is always tracked.
+	return r.dataMigrate.Spec.Policy != datav1alpha1.Cron && ptr.Deref(r.dataMigrate.Spec.Suspend, false)
+}
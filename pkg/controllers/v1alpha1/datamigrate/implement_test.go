@@ -60,6 +60,30 @@ var _ = Describe("dataMigrateOperation", func() {
 			Expect(got[0].Reason).To(Equal(common.TargetSSHSecretNameNotSet))
 		})
 
+		It("should not require SSH secret for parallel migrate on alluxio", func() {
+			op := &dataMigrateOperation{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism: 2,
+					},
+				},
+			}
+			op.dataMigrate.Namespace = "default"
+
+			dataset := &datav1alpha1.Dataset{}
+			dataset.Namespace = "default"
+
+			ctx := runtime.ReconcileRequestContext{
+				Dataset:     dataset,
+				RuntimeType: common.AlluxioRuntime,
+			}
+
+			got, err := op.Validate(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(BeNil())
+		})
+
 		It("should error when datamigrate namespace differs from dataset namespace", func() {
 			op := &dataMigrateOperation{
 				dataMigrate: &datav1alpha1.DataMigrate{
@@ -17,16 +17,21 @@
 package datamigrate
 
 import (
+	"context"
+
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation/progress"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
@@ -88,6 +93,13 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		return
 	}
 
+	// suspend or resume scheduling new jobs, the running job is not affected
+	suspend := ptr.Deref(object.Spec.Suspend, false)
+	if err = kubeclient.UpdateCronJobSuspend(c.Client, types.NamespacedName{Namespace: object.GetNamespace(), Name: cronjobName}, suspend); err != nil {
+		ctx.Log.Error(err, "can't update suspend of DataMigrate cronjob", "namespace", ctx.Namespace, "cronjobName", cronjobName, "suspend", suspend)
+		return
+	}
+
 	// update LastScheduleTime and LastSuccessfulTime
 	result.LastScheduleTime = cronjobStatus.LastScheduleTime
 	result.LastSuccessfulTime = cronjobStatus.LastSuccessfulTime
@@ -98,6 +110,9 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		return
 	}
 
+	// record the recent runs by the jobs of the cronjob
+	utils.UpdateRecentRuns(result, jobs)
+
 	// get the newest job
 	var currentJob *batchv1.Job
 	for _, job := range jobs {
@@ -138,7 +153,10 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		if opStatus.Phase == common.PhaseComplete || opStatus.Phase == common.PhaseFailed {
 			result.Phase = common.PhasePending
 			result.Duration = "-"
+			result.Progress = nil
+			return
 		}
+		progress.UpdateJobProgress(ctx, c.Client, currentJob, result)
 		return
 	}
 
@@ -157,6 +175,8 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		result.Phase = common.PhaseFailed
 	} else {
 		result.Phase = common.PhaseComplete
+		result.Progress = progress.Complete(result.Progress)
+		updateMigrateResult(ctx, c.Client, currentJob, result)
 	}
 	result.Duration = utils.CalculateDuration(currentJob.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
 	return
@@ -194,6 +214,7 @@ func getJobOperationStatus(ctx cruntime.ReconcileRequestContext, c client.Client
 	finishedJobCondition := kubeclient.GetFinishedJobCondition(job)
 	if finishedJobCondition == nil {
 		ctx.Log.V(1).Info("DataMigrate job still running", "namespace", namespace, "jobName", jobName)
+		progress.UpdateJobProgress(ctx, c, job, result)
 		return
 	}
 
@@ -217,9 +238,34 @@ func getJobOperationStatus(ctx cruntime.ReconcileRequestContext, c client.Client
 	}
 	if isJobSucceed {
 		result.Phase = common.PhaseComplete
+		result.Progress = progress.Complete(result.Progress)
+		updateMigrateResult(ctx, c, job, result)
 	} else {
 		result.Phase = common.PhaseFailed
 	}
 	result.Duration = utils.CalculateDuration(job.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
 	return
 }
+
+// updateMigrateResult sets how many files and bytes are copied, which are reported by the succeeded
+// migrate pod, into the infos of the operation status.
+func updateMigrateResult(ctx cruntime.ReconcileRequestContext, c client.Client, job *batchv1.Job, opStatus *datav1alpha1.OperationStatus) {
+	reqCtx := ctx.Context
+	if reqCtx == nil {
+		reqCtx = context.TODO()
+	}
+	migrateResult, err := cdatamigrate.GetMigrateResultOfJob(reqCtx, c, job)
+	if err != nil {
+		ctx.Log.Error(err, "failed to get the migrate result of job, skip", "namespace", job.Namespace, "jobName", job.Name)
+		return
+	}
+	if len(migrateResult) == 0 {
+		return
+	}
+	if opStatus.Infos == nil {
+		opStatus.Infos = map[string]string{}
+	}
+	for key, value := range migrateResult {
+		opStatus.Infos[key] = value
+	}
+}
@@ -277,3 +277,11 @@ func (r *dataProcessOperation) GetTTL() (ttl *int32, err error) {
 func (r *dataProcessOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
+
+func (r *dataProcessOperation) GetRetryPolicy() dataoperation.RetryPolicy {
+	return dataoperation.NewRetryPolicy(r.dataProcess.Spec.Policy, r.dataProcess.Spec.BackoffLimit, r.dataProcess.Spec.RetryBackoff, r.dataProcess.Spec.ActiveDeadlineSeconds)
+}
+
+func (r *dataProcessOperation) IsSuspended() bool {
+	return false
+}
@@ -134,6 +134,9 @@ func (handler *CronStatusHandler) GetOperationStatus(ctx runtime.ReconcileReques
 		return
 	}
 
+	// record the recent runs by the jobs of the cronjob
+	utils.UpdateRecentRuns(result, jobs)
+
 	var currentJob *batchv1.Job
 	for _, job := range jobs {
 		if job.CreationTimestamp == *cronjobStatus.LastScheduleTime || job.CreationTimestamp.After(cronjobStatus.LastScheduleTime.Time) {
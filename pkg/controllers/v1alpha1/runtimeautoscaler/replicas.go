@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimeautoscaler
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	defaultMinReplicas int32 = 1
+
+	defaultStabilizationWindowSeconds int32 = 300
+
+	// scaleTolerance is the tolerance of the ratio of the metric value to the target value,
+	// the workers are not scaled when the ratio is within it. It's the same as the default of HPA.
+	scaleTolerance = 0.1
+)
+
+// metricRecommendation is the replicas recommended by a metric
+type metricRecommendation struct {
+	// value is the observed value of the metric
+	value int32
+	// replicas is the recommended replicas
+	replicas int32
+	// vote is false if the metric doesn't recommend replicas, e.g. the cache hit ratio reaches the target
+	vote bool
+}
+
+// validateRuntimeAutoscaler checks the spec which cannot be validated by the openapi schema
+func validateRuntimeAutoscaler(autoscaler *datav1alpha1.RuntimeAutoscaler) error {
+	spec := autoscaler.Spec
+	if len(spec.ScaleTargetRef.Kind) == 0 || len(spec.ScaleTargetRef.Name) == 0 {
+		return fmt.Errorf("the kind and name of scaleTargetRef must be set")
+	}
+	if len(spec.ScaleTargetRef.APIVersion) > 0 {
+		if _, err := schema.ParseGroupVersion(spec.ScaleTargetRef.APIVersion); err != nil {
+			return fmt.Errorf("the apiVersion of scaleTargetRef is not valid: %v", err)
+		}
+	}
+	if minReplicas := getMinReplicas(autoscaler); spec.MaxReplicas < minReplicas {
+		return fmt.Errorf("maxReplicas %d is less than minReplicas %d", spec.MaxReplicas, minReplicas)
+	}
+	if len(spec.Metrics) == 0 {
+		return fmt.Errorf("at least one metric must be set")
+	}
+	for _, metric := range spec.Metrics {
+		if metric.TargetValue <= 0 {
+			return fmt.Errorf("the targetValue of metric %s must be positive", metric.Type)
+		}
+		if metric.Type != datav1alpha1.DatasetConsumersMetric && metric.TargetValue > 100 {
+			return fmt.Errorf("the targetValue of metric %s is a percentage, but got %d", metric.Type, metric.TargetValue)
+		}
+	}
+	return nil
+}
+
+func getMinReplicas(autoscaler *datav1alpha1.RuntimeAutoscaler) int32 {
+	if autoscaler.Spec.MinReplicas != nil {
+		return *autoscaler.Spec.MinReplicas
+	}
+	return defaultMinReplicas
+}
+
+func getStabilizationWindow(autoscaler *datav1alpha1.RuntimeAutoscaler) time.Duration {
+	seconds := defaultStabilizationWindowSeconds
+	if autoscaler.Spec.StabilizationWindowSeconds != nil {
+		seconds = *autoscaler.Spec.StabilizationWindowSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// recommendByCacheUsage recommends the replicas to keep the percentage of the used cache capacity around the target,
+// the cache capacity is assumed to be proportional to the worker replicas.
+func recommendByCacheUsage(cacheStates map[common.CacheStateName]string, currentReplicas, target int32) (recommendation metricRecommendation, ok bool) {
+	capacity, err := utils.FromHumanSize(cacheStates[common.CacheCapacity])
+	if err != nil || capacity <= 0 {
+		return recommendation, false
+	}
+	cached, err := utils.FromHumanSize(cacheStates[common.Cached])
+	if err != nil || currentReplicas <= 0 {
+		return recommendation, false
+	}
+
+	usage := float64(cached) * 100 / float64(capacity)
+	recommendation.value = int32(math.Round(usage))
+	recommendation.replicas = scaleByRatio(currentReplicas, usage/float64(target))
+	recommendation.vote = true
+	return recommendation, true
+}
+
+// recommendByCacheHitRatio recommends more replicas when the cache hit ratio is lower than the target, it doesn't
+// recommend scaling in as a hit ratio above the target doesn't tell whether fewer workers are enough.
+func recommendByCacheHitRatio(cacheStates map[common.CacheStateName]string, currentReplicas, target int32) (recommendation metricRecommendation, ok bool) {
+	hitRatio, err := parsePercentage(cacheStates[common.CacheHitRatio])
+	if err != nil || currentReplicas <= 0 {
+		return recommendation, false
+	}
+
+	recommendation.value = int32(math.Round(hitRatio))
+	// zero hit ratio usually means no data has been read through the cache yet
+	if hitRatio <= 0 || hitRatio >= float64(target) {
+		return recommendation, true
+	}
+	recommendation.replicas = scaleByRatio(currentReplicas, float64(target)/hitRatio)
+	recommendation.vote = recommendation.replicas > currentReplicas
+	return recommendation, true
+}
+
+// recommendByDatasetConsumers recommends the replicas to serve the target number of consumer pods per worker
+func recommendByDatasetConsumers(consumers, target int32) metricRecommendation {
+	return metricRecommendation{
+		value:    consumers,
+		replicas: int32(math.Ceil(float64(consumers) / float64(target))),
+		vote:     true,
+	}
+}
+
+// scaleByRatio scales the replicas by the ratio of the metric value to the target value,
+// the replicas are kept if the ratio is within the tolerance.
+func scaleByRatio(currentReplicas int32, ratio float64) int32 {
+	if math.Abs(ratio-1.0) <= scaleTolerance {
+		return currentReplicas
+	}
+	return int32(math.Ceil(ratio * float64(currentReplicas)))
+}
+
+// parsePercentage parses the percentage in the cache states, e.g. "95.0%"
+func parsePercentage(value string) (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "%")), 64)
+}
+
+func clampReplicas(replicas, minReplicas, maxReplicas int32) int32 {
+	if replicas < minReplicas {
+		return minReplicas
+	}
+	if replicas > maxReplicas {
+		return maxReplicas
+	}
+	return replicas
+}
+
+// stabilizeRecommendation records the recommendation and drops the ones out of the stabilization window. The workers are
+// scaled out to the recommendation immediately, but scaled in to the largest recommendation in the window so that
+// a temporary drop of the metrics doesn't evict the cache.
+func stabilizeRecommendation(recommendations []datav1alpha1.RuntimeAutoscalerRecommendation, recommendation, currentReplicas int32,
+	now metav1.Time, window time.Duration) (desiredReplicas int32, kept []datav1alpha1.RuntimeAutoscalerRecommendation) {
+	for _, r := range recommendations {
+		if now.Sub(r.Timestamp.Time) < window {
+			kept = append(kept, r)
+		}
+	}
+	kept = append(kept, datav1alpha1.RuntimeAutoscalerRecommendation{Replicas: recommendation, Timestamp: now})
+
+	desiredReplicas = recommendation
+	if desiredReplicas < currentReplicas {
+		for _, r := range kept {
+			if r.Replicas > desiredReplicas {
+				desiredReplicas = r.Replicas
+			}
+		}
+		if desiredReplicas > currentReplicas {
+			desiredReplicas = currentReplicas
+		}
+	}
+	return desiredReplicas, kept
+}
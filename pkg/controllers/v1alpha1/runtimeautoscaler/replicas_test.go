@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimeautoscaler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+var _ = Describe("RuntimeAutoscaler replicas", func() {
+	Describe("validateRuntimeAutoscaler", func() {
+		var autoscaler *datav1alpha1.RuntimeAutoscaler
+
+		BeforeEach(func() {
+			autoscaler = &datav1alpha1.RuntimeAutoscaler{
+				Spec: datav1alpha1.RuntimeAutoscalerSpec{
+					ScaleTargetRef: datav1alpha1.RuntimeScaleTargetReference{Kind: "AlluxioRuntime", Name: "hbase"},
+					MinReplicas:    ptr.To[int32](1),
+					MaxReplicas:    3,
+					Metrics:        []datav1alpha1.RuntimeAutoscalerMetric{{Type: datav1alpha1.CacheUsageMetric, TargetValue: 80}},
+				},
+			}
+		})
+
+		It("should accept a valid autoscaler", func() {
+			Expect(validateRuntimeAutoscaler(autoscaler)).To(Succeed())
+		})
+
+		It("should reject an autoscaler without target name", func() {
+			autoscaler.Spec.ScaleTargetRef.Name = ""
+			Expect(validateRuntimeAutoscaler(autoscaler)).NotTo(Succeed())
+		})
+
+		It("should reject an invalid apiVersion", func() {
+			autoscaler.Spec.ScaleTargetRef.APIVersion = "data.fluid.io/v1alpha1/extra"
+			Expect(validateRuntimeAutoscaler(autoscaler)).NotTo(Succeed())
+		})
+
+		It("should reject maxReplicas less than minReplicas", func() {
+			autoscaler.Spec.MinReplicas = ptr.To[int32](4)
+			Expect(validateRuntimeAutoscaler(autoscaler)).NotTo(Succeed())
+		})
+
+		It("should reject a percentage target larger than 100", func() {
+			autoscaler.Spec.Metrics[0].TargetValue = 120
+			Expect(validateRuntimeAutoscaler(autoscaler)).NotTo(Succeed())
+		})
+
+		It("should accept a consumers target larger than 100", func() {
+			autoscaler.Spec.Metrics[0] = datav1alpha1.RuntimeAutoscalerMetric{Type: datav1alpha1.DatasetConsumersMetric, TargetValue: 120}
+			Expect(validateRuntimeAutoscaler(autoscaler)).To(Succeed())
+		})
+	})
+
+	DescribeTable("recommendByCacheUsage",
+		func(cached, capacity string, current int32, ok bool, wantValue, wantReplicas int32) {
+			cacheStates := common.CacheStateList{common.Cached: cached, common.CacheCapacity: capacity}
+			recommendation, gotOK := recommendByCacheUsage(cacheStates, current, 80)
+			Expect(gotOK).To(Equal(ok))
+			if ok {
+				Expect(recommendation.vote).To(BeTrue())
+				Expect(recommendation.value).To(Equal(wantValue))
+				Expect(recommendation.replicas).To(Equal(wantReplicas))
+			}
+		},
+		Entry("scale out when the cache is almost full", "19.00GiB", "20.00GiB", int32(2), true, int32(95), int32(3)),
+		Entry("scale in when the cache is mostly free", "4.00GiB", "20.00GiB", int32(4), true, int32(20), int32(1)),
+		Entry("keep the replicas within the tolerance", "15.00GiB", "20.00GiB", int32(2), true, int32(75), int32(2)),
+		Entry("unavailable without cache capacity", "1.00GiB", "0.00B", int32(2), false, int32(0), int32(0)),
+		Entry("unavailable with unknown cached", "N/A", "20.00GiB", int32(2), false, int32(0), int32(0)),
+	)
+
+	DescribeTable("recommendByCacheHitRatio",
+		func(hitRatio string, ok, vote bool, wantValue, wantReplicas int32) {
+			cacheStates := common.CacheStateList{common.CacheHitRatio: hitRatio}
+			recommendation, gotOK := recommendByCacheHitRatio(cacheStates, 2, 90)
+			Expect(gotOK).To(Equal(ok))
+			Expect(recommendation.vote).To(Equal(vote))
+			if ok {
+				Expect(recommendation.value).To(Equal(wantValue))
+			}
+			if vote {
+				Expect(recommendation.replicas).To(Equal(wantReplicas))
+			}
+		},
+		Entry("scale out when the hit ratio is low", "45.0%", true, true, int32(45), int32(4)),
+		Entry("no vote when the hit ratio reaches the target", "95.0%", true, false, int32(95), int32(0)),
+		Entry("no vote when the hit ratio is within the tolerance", "85.0%", true, false, int32(85), int32(0)),
+		Entry("no vote when no data is read", "0.0%", true, false, int32(0), int32(0)),
+		Entry("unavailable hit ratio", "N/A", false, false, int32(0), int32(0)),
+	)
+
+	It("recommendByDatasetConsumers should serve the target consumers per worker", func() {
+		Expect(recommendByDatasetConsumers(7, 3).replicas).To(Equal(int32(3)))
+		Expect(recommendByDatasetConsumers(0, 3).replicas).To(Equal(int32(0)))
+	})
+
+	Describe("stabilizeRecommendation", func() {
+		now := metav1.Now()
+		window := 5 * time.Minute
+		recommendations := []datav1alpha1.RuntimeAutoscalerRecommendation{
+			{Replicas: 5, Timestamp: metav1.NewTime(now.Add(-10 * time.Minute))},
+			{Replicas: 3, Timestamp: metav1.NewTime(now.Add(-2 * time.Minute))},
+		}
+
+		It("should scale out immediately", func() {
+			desired, kept := stabilizeRecommendation(recommendations, 4, 2, now, window)
+			Expect(desired).To(Equal(int32(4)))
+			Expect(kept).To(HaveLen(2))
+			Expect(kept[1].Replicas).To(Equal(int32(4)))
+		})
+
+		It("should scale in to the largest recommendation in the window", func() {
+			desired, kept := stabilizeRecommendation(recommendations, 1, 4, now, window)
+			Expect(desired).To(Equal(int32(3)))
+			Expect(kept).To(HaveLen(2))
+		})
+
+		It("should not scale out when scaling in is stabilized", func() {
+			desired, _ := stabilizeRecommendation(recommendations, 1, 2, now, window)
+			Expect(desired).To(Equal(int32(2)))
+		})
+
+		It("should scale in directly without window", func() {
+			desired, kept := stabilizeRecommendation(recommendations, 1, 4, now, 0)
+			Expect(desired).To(Equal(int32(1)))
+			Expect(kept).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,342 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimeautoscaler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const controllerName string = "RuntimeAutoscalerReconciler"
+
+const (
+	reasonInvalidSpec      = "InvalidSpec"
+	reasonFailedGetScale   = "FailedGetScale"
+	reasonFailedGetMetrics = "FailedGetMetrics"
+	reasonFailedRescale    = "FailedRescale"
+	reasonNoMetricFound    = "NoMetricFound"
+	reasonValidMetricFound = "ValidMetricFound"
+)
+
+// RuntimeAutoscalerReconciler reconciles a RuntimeAutoscaler object, it scales the workers of the target runtime
+// through the scale subresource by the cache states of the runtime and the consumers of its dataset.
+type RuntimeAutoscalerReconciler struct {
+	client.Client
+	Recorder     record.EventRecorder
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+}
+
+// NewRuntimeAutoscalerReconciler returns a RuntimeAutoscalerReconciler
+func NewRuntimeAutoscalerReconciler(client client.Client,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	resyncPeriod time.Duration) *RuntimeAutoscalerReconciler {
+	return &RuntimeAutoscalerReconciler{
+		Client:       client,
+		Recorder:     recorder,
+		Log:          log,
+		ResyncPeriod: resyncPeriod,
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=runtimeautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=data.fluid.io,resources=runtimeautoscalers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=alluxioruntimes/scale;jindoruntimes/scale;juicefsruntimes/scale;thinruntimes/scale;efcruntimes/scale;vineyardruntimes/scale;cacheruntimes/scale,verbs=get;update;patch
+// Reconcile computes the desired worker replicas of the target runtime and rescales it
+func (r *RuntimeAutoscalerReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("runtimeautoscaler", req.NamespacedName)
+	log.V(1).Info("reconcile starts")
+	defer log.V(1).Info("reconcile ends")
+
+	autoscaler, err := utils.GetRuntimeAutoscaler(r.Client, req.Name, req.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			log.V(1).Info("runtimeautoscaler not found, skip reconciling")
+			return utils.NoRequeue()
+		}
+		return utils.RequeueIfError(errors.Wrap(err, "failed to get runtimeautoscaler"))
+	}
+
+	if !autoscaler.GetDeletionTimestamp().IsZero() {
+		return utils.NoRequeue()
+	}
+
+	autoscalerToUpdate := autoscaler.DeepCopy()
+	result := r.reconcileAutoscaler(context, log, autoscalerToUpdate)
+
+	if !reflect.DeepEqual(autoscaler.Status, autoscalerToUpdate.Status) {
+		if err = r.Client.Status().Update(context, autoscalerToUpdate); err != nil {
+			return utils.RequeueIfError(errors.Wrap(err, "failed to update status of runtimeautoscaler"))
+		}
+	}
+
+	return result, nil
+}
+
+// reconcileAutoscaler rescales the target runtime and records the result in the status of the autoscaler
+func (r *RuntimeAutoscalerReconciler) reconcileAutoscaler(ctx context.Context, log logr.Logger, autoscaler *datav1alpha1.RuntimeAutoscaler) ctrl.Result {
+	if err := validateRuntimeAutoscaler(autoscaler); err != nil {
+		log.Error(err, "runtimeautoscaler is not valid")
+		r.Recorder.Event(autoscaler, corev1.EventTypeWarning, common.RuntimeAutoscalerNotValid, err.Error())
+		setScalingActiveCondition(autoscaler, corev1.ConditionFalse, reasonInvalidSpec, err.Error())
+		// no need to requeue, the autoscaler is reconciled again when its spec is updated
+		return ctrl.Result{}
+	}
+
+	target := newScaleTarget(autoscaler)
+	scale := &autoscalingv1.Scale{}
+	if err := r.Client.SubResource("scale").Get(ctx, target, scale); err != nil {
+		message := fmt.Sprintf("failed to get the scale of %s %s: %v", target.GetKind(), target.GetName(), err)
+		log.Error(err, "failed to get the scale of the target runtime")
+		r.Recorder.Event(autoscaler, corev1.EventTypeWarning, common.RuntimeAutoscalerFailedGetScale, message)
+		setScalingActiveCondition(autoscaler, corev1.ConditionFalse, reasonFailedGetScale, message)
+		return ctrl.Result{RequeueAfter: r.ResyncPeriod}
+	}
+	currentReplicas := scale.Spec.Replicas
+
+	recommendation, metricStatuses, err := r.recommendReplicas(ctx, autoscaler, target, currentReplicas)
+	if err != nil {
+		message := fmt.Sprintf("failed to get the metrics of %s %s: %v", target.GetKind(), target.GetName(), err)
+		log.Error(err, "failed to get the metrics of the target runtime")
+		r.Recorder.Event(autoscaler, corev1.EventTypeWarning, common.RuntimeAutoscalerFailedGetMetrics, message)
+		setScalingActiveCondition(autoscaler, corev1.ConditionFalse, reasonFailedGetMetrics, message)
+		return ctrl.Result{RequeueAfter: r.ResyncPeriod}
+	}
+
+	minReplicas, maxReplicas := getMinReplicas(autoscaler), autoscaler.Spec.MaxReplicas
+	now := metav1.Now()
+	desiredReplicas, recommendations := stabilizeRecommendation(autoscaler.Status.Recommendations,
+		clampReplicas(recommendation, minReplicas, maxReplicas), currentReplicas, now, getStabilizationWindow(autoscaler))
+	desiredReplicas = clampReplicas(desiredReplicas, minReplicas, maxReplicas)
+
+	autoscaler.Status.CurrentReplicas = currentReplicas
+	autoscaler.Status.DesiredReplicas = desiredReplicas
+	autoscaler.Status.CurrentMetrics = metricStatuses
+	autoscaler.Status.Recommendations = recommendations
+
+	if desiredReplicas != currentReplicas {
+		scale.Spec.Replicas = desiredReplicas
+		if err = r.Client.SubResource("scale").Update(ctx, target, client.WithSubResourceBody(scale)); err != nil {
+			message := fmt.Sprintf("failed to rescale %s %s from %d to %d: %v", target.GetKind(), target.GetName(), currentReplicas, desiredReplicas, err)
+			log.Error(err, "failed to rescale the target runtime")
+			r.Recorder.Event(autoscaler, corev1.EventTypeWarning, common.RuntimeAutoscalerFailedRescale, message)
+			setScalingActiveCondition(autoscaler, corev1.ConditionFalse, reasonFailedRescale, message)
+			return ctrl.Result{RequeueAfter: r.ResyncPeriod}
+		}
+		log.Info("rescaled the target runtime", "from", currentReplicas, "to", desiredReplicas)
+		r.Recorder.Eventf(autoscaler, corev1.EventTypeNormal, common.RuntimeAutoscalerSucceededRescale,
+			"Rescaled %s %s from %d to %d", target.GetKind(), target.GetName(), currentReplicas, desiredReplicas)
+		autoscaler.Status.LastScaleTime = &now
+	}
+
+	if len(metricStatuses) == 0 {
+		setScalingActiveCondition(autoscaler, corev1.ConditionFalse, reasonNoMetricFound,
+			"none of the metrics is available, keep the current replicas within the bounds")
+	} else {
+		setScalingActiveCondition(autoscaler, corev1.ConditionTrue, reasonValidMetricFound,
+			fmt.Sprintf("the replicas are computed from the metrics, the desired replicas are %d", desiredReplicas))
+	}
+
+	return ctrl.Result{RequeueAfter: r.ResyncPeriod}
+}
+
+// recommendReplicas returns the largest replicas recommended by the metrics and the observed values of the metrics.
+// The current replicas are recommended if none of the metrics recommends replicas.
+func (r *RuntimeAutoscalerReconciler) recommendReplicas(ctx context.Context, autoscaler *datav1alpha1.RuntimeAutoscaler,
+	target *unstructured.Unstructured, currentReplicas int32) (replicas int32, metricStatuses []datav1alpha1.RuntimeAutoscalerMetricStatus, err error) {
+	var (
+		cacheStates common.CacheStateList
+		voted       bool
+	)
+
+	for _, metric := range autoscaler.Spec.Metrics {
+		var (
+			recommendation metricRecommendation
+			ok             bool
+		)
+
+		switch metric.Type {
+		case datav1alpha1.CacheUsageMetric, datav1alpha1.CacheHitRatioMetric:
+			if cacheStates == nil {
+				cacheStates, err = r.getCacheStates(ctx, target)
+				if err != nil {
+					return
+				}
+			}
+			if metric.Type == datav1alpha1.CacheUsageMetric {
+				recommendation, ok = recommendByCacheUsage(cacheStates, currentReplicas, metric.TargetValue)
+			} else {
+				recommendation, ok = recommendByCacheHitRatio(cacheStates, currentReplicas, metric.TargetValue)
+			}
+		case datav1alpha1.DatasetConsumersMetric:
+			var consumers int32
+			consumers, err = r.getDatasetConsumers(ctx, target)
+			if err != nil {
+				return
+			}
+			recommendation, ok = recommendByDatasetConsumers(consumers, metric.TargetValue), true
+		default:
+			err = fmt.Errorf("unknown metric type %s", metric.Type)
+			return
+		}
+
+		if !ok {
+			continue
+		}
+		metricStatuses = append(metricStatuses, datav1alpha1.RuntimeAutoscalerMetricStatus{
+			Type:         metric.Type,
+			CurrentValue: recommendation.value,
+		})
+		if recommendation.vote && (!voted || recommendation.replicas > replicas) {
+			replicas = recommendation.replicas
+			voted = true
+		}
+	}
+
+	if !voted {
+		replicas = currentReplicas
+	}
+	return replicas, metricStatuses, nil
+}
+
+// getCacheStates returns the cache states of the runtime, the cache states of the dataset are used
+// if the runtime doesn't report them.
+func (r *RuntimeAutoscalerReconciler) getCacheStates(ctx context.Context, target *unstructured.Unstructured) (common.CacheStateList, error) {
+	runtime := &unstructured.Unstructured{}
+	runtime.SetGroupVersionKind(target.GroupVersionKind())
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: target.GetNamespace(), Name: target.GetName()}, runtime); err != nil {
+		return nil, errors.Wrap(err, "failed to get the runtime")
+	}
+
+	cacheStates := common.CacheStateList{}
+	states, _, err := unstructured.NestedStringMap(runtime.Object, "status", "cacheStates")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the cache states of the runtime")
+	}
+	for name, value := range states {
+		cacheStates[common.CacheStateName(name)] = value
+	}
+	if len(cacheStates) > 0 {
+		return cacheStates, nil
+	}
+
+	dataset, err := utils.GetDataset(r.Client, target.GetName(), target.GetNamespace())
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			return cacheStates, nil
+		}
+		return nil, errors.Wrap(err, "failed to get the dataset")
+	}
+	for name, value := range dataset.Status.CacheStates {
+		cacheStates[name] = value
+	}
+	return cacheStates, nil
+}
+
+// getDatasetConsumers returns the number of the running pods which mount the pvc of the dataset
+func (r *RuntimeAutoscalerReconciler) getDatasetConsumers(ctx context.Context, target *unstructured.Unstructured) (int32, error) {
+	pods, err := kubeclient.GetPvcMountPodsWithContext(ctx, r.Client, target.GetName(), target.GetNamespace())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list the pods mounting the dataset")
+	}
+
+	var consumers int32
+	for i := range pods {
+		if !kubeclient.IsFinishedPod(&pods[i]) {
+			consumers++
+		}
+	}
+	return consumers, nil
+}
+
+// newScaleTarget returns the object referred by the scaleTargetRef, which is used to access the scale subresource
+func newScaleTarget(autoscaler *datav1alpha1.RuntimeAutoscaler) *unstructured.Unstructured {
+	ref := autoscaler.Spec.ScaleTargetRef
+	apiVersion := ref.APIVersion
+	if len(apiVersion) == 0 {
+		apiVersion = datav1alpha1.GroupVersion.String()
+	}
+
+	// the apiVersion has been checked by validateRuntimeAutoscaler
+	gv, _ := schema.ParseGroupVersion(apiVersion)
+	target := &unstructured.Unstructured{}
+	target.SetGroupVersionKind(gv.WithKind(ref.Kind))
+	target.SetNamespace(autoscaler.Namespace)
+	target.SetName(ref.Name)
+	return target
+}
+
+// setScalingActiveCondition sets the ScalingActive condition of the autoscaler, the transition time is only
+// changed when the status of the condition changes.
+func setScalingActiveCondition(autoscaler *datav1alpha1.RuntimeAutoscaler, status corev1.ConditionStatus, reason, message string) {
+	now := metav1.Now()
+	condition := datav1alpha1.Condition{
+		Type:               common.ScalingActive,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	}
+
+	for i, c := range autoscaler.Status.Conditions {
+		if c.Type != common.ScalingActive {
+			continue
+		}
+		if c.Status == status {
+			condition.LastTransitionTime = c.LastTransitionTime
+		}
+		autoscaler.Status.Conditions[i] = condition
+		return
+	}
+	autoscaler.Status.Conditions = append(autoscaler.Status.Conditions, condition)
+}
+
+func (r *RuntimeAutoscalerReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		// the autoscaler requeues itself periodically, so the updates of its status are ignored
+		For(&datav1alpha1.RuntimeAutoscaler{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+func (r *RuntimeAutoscalerReconciler) ControllerName() string {
+	return controllerName
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimeautoscaler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+// newScaleClient returns a fake client which serves the scale subresource of AlluxioRuntime,
+// the fake client of controller-runtime doesn't support the scale subresource of custom resources.
+func newScaleClient(s *runtime.Scheme, failUpdate bool, objs ...client.Object) client.Client {
+	fakeBase := fakeclient.NewClientBuilder().WithScheme(s).WithObjects(objs...).
+		WithStatusSubresource(&datav1alpha1.RuntimeAutoscaler{}, &datav1alpha1.AlluxioRuntime{}).Build()
+	return interceptor.NewClient(fakeBase, interceptor.Funcs{
+		SubResourceGet: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceGetOption) error {
+			if subResourceName != "scale" {
+				return c.SubResource(subResourceName).Get(ctx, obj, subResource, opts...)
+			}
+			alluxio := &datav1alpha1.AlluxioRuntime{}
+			if err := c.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, alluxio); err != nil {
+				return err
+			}
+			scale := subResource.(*autoscalingv1.Scale)
+			scale.Spec.Replicas = alluxio.Spec.Replicas
+			scale.Status.Replicas = alluxio.Status.CurrentWorkerNumberScheduled
+			return nil
+		},
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			if subResourceName != "scale" {
+				return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+			}
+			if failUpdate {
+				return fmt.Errorf("server unavailable")
+			}
+			scale := (&client.SubResourceUpdateOptions{}).ApplyOptions(opts).SubResourceBody.(*autoscalingv1.Scale)
+			alluxio := &datav1alpha1.AlluxioRuntime{}
+			if err := c.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, alluxio); err != nil {
+				return err
+			}
+			alluxio.Spec.Replicas = scale.Spec.Replicas
+			return c.Update(ctx, alluxio)
+		},
+	})
+}
+
+var _ = Describe("RuntimeAutoscalerReconciler", func() {
+	const namespace = "fluid"
+
+	var (
+		s          *runtime.Scheme
+		alluxio    *datav1alpha1.AlluxioRuntime
+		dataset    *datav1alpha1.Dataset
+		autoscaler *datav1alpha1.RuntimeAutoscaler
+		recorder   *record.FakeRecorder
+		req        ctrl.Request
+	)
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+
+		alluxio = &datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: namespace},
+			Spec:       datav1alpha1.AlluxioRuntimeSpec{Replicas: 2},
+			Status: datav1alpha1.RuntimeStatus{
+				CurrentWorkerNumberScheduled: 2,
+				CacheStates: common.CacheStateList{
+					common.CacheCapacity: "20.00GiB",
+					common.Cached:        "19.00GiB",
+				},
+			},
+		}
+		dataset = &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: namespace},
+		}
+		autoscaler = &datav1alpha1.RuntimeAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: namespace, Generation: 1},
+			Spec: datav1alpha1.RuntimeAutoscalerSpec{
+				ScaleTargetRef: datav1alpha1.RuntimeScaleTargetReference{Kind: "AlluxioRuntime", Name: "hbase"},
+				MinReplicas:    ptr.To[int32](1),
+				MaxReplicas:    4,
+				Metrics:        []datav1alpha1.RuntimeAutoscalerMetric{{Type: datav1alpha1.CacheUsageMetric, TargetValue: 80}},
+			},
+		}
+		recorder = record.NewFakeRecorder(10)
+		req = ctrl.Request{NamespacedName: types.NamespacedName{Name: "hbase", Namespace: namespace}}
+	})
+
+	newReconciler := func(c client.Client) *RuntimeAutoscalerReconciler {
+		return NewRuntimeAutoscalerReconciler(c, fake.NullLogger(), recorder, 15*time.Second)
+	}
+
+	getAutoscaler := func(c client.Client) *datav1alpha1.RuntimeAutoscaler {
+		got := &datav1alpha1.RuntimeAutoscaler{}
+		Expect(c.Get(context.TODO(), req.NamespacedName, got)).To(Succeed())
+		return got
+	}
+
+	getReplicas := func(c client.Client) int32 {
+		got := &datav1alpha1.AlluxioRuntime{}
+		Expect(c.Get(context.TODO(), req.NamespacedName, got)).To(Succeed())
+		return got.Spec.Replicas
+	}
+
+	It("should scale out the runtime when the cache is almost full", func() {
+		c := newScaleClient(s, false, alluxio, dataset, autoscaler)
+		result, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+
+		Expect(getReplicas(c)).To(Equal(int32(3)))
+		got := getAutoscaler(c)
+		Expect(got.Status.CurrentReplicas).To(Equal(int32(2)))
+		Expect(got.Status.DesiredReplicas).To(Equal(int32(3)))
+		Expect(got.Status.LastScaleTime).NotTo(BeNil())
+		Expect(got.Status.CurrentMetrics).To(ConsistOf(datav1alpha1.RuntimeAutoscalerMetricStatus{
+			Type: datav1alpha1.CacheUsageMetric, CurrentValue: 95,
+		}))
+		Expect(got.Status.Recommendations).To(HaveLen(1))
+		Expect(got.Status.Conditions).To(HaveLen(1))
+		Expect(got.Status.Conditions[0].Type).To(Equal(common.ScalingActive))
+		Expect(got.Status.Conditions[0].Status).To(Equal(corev1.ConditionTrue))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.RuntimeAutoscalerSucceededRescale)))
+	})
+
+	It("should not scale out beyond maxReplicas", func() {
+		autoscaler.Spec.MaxReplicas = 2
+		c := newScaleClient(s, false, alluxio, dataset, autoscaler)
+		_, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReplicas(c)).To(Equal(int32(2)))
+		Expect(getAutoscaler(c).Status.LastScaleTime).To(BeNil())
+	})
+
+	It("should scale by the consumers of the dataset", func() {
+		autoscaler.Spec.Metrics = []datav1alpha1.RuntimeAutoscalerMetric{{Type: datav1alpha1.DatasetConsumersMetric, TargetValue: 2}}
+		objs := []client.Object{alluxio, dataset, autoscaler}
+		for i, phase := range []corev1.PodPhase{corev1.PodRunning, corev1.PodRunning, corev1.PodPending, corev1.PodRunning, corev1.PodRunning, corev1.PodSucceeded} {
+			objs = append(objs, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("consumer-%d", i), Namespace: namespace},
+				Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "hbase"},
+					},
+				}}},
+				Status: corev1.PodStatus{Phase: phase},
+			})
+		}
+		c := newScaleClient(s, false, objs...)
+		_, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReplicas(c)).To(Equal(int32(3)))
+		Expect(getAutoscaler(c).Status.CurrentMetrics).To(ConsistOf(datav1alpha1.RuntimeAutoscalerMetricStatus{
+			Type: datav1alpha1.DatasetConsumersMetric, CurrentValue: 5,
+		}))
+	})
+
+	It("should fall back to the cache states of the dataset", func() {
+		alluxio.Status.CacheStates = nil
+		dataset.Status.CacheStates = common.CacheStateList{
+			common.CacheCapacity: "20.00GiB",
+			common.Cached:        "2.00GiB",
+		}
+		autoscaler.Spec.StabilizationWindowSeconds = ptr.To[int32](0)
+		c := newScaleClient(s, false, alluxio, dataset, autoscaler)
+		_, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReplicas(c)).To(Equal(int32(1)))
+	})
+
+	It("should keep the replicas when scaling in is stabilized", func() {
+		alluxio.Status.CacheStates[common.Cached] = "2.00GiB"
+		autoscaler.Status.Recommendations = []datav1alpha1.RuntimeAutoscalerRecommendation{
+			{Replicas: 2, Timestamp: metav1.NewTime(time.Now().Add(-time.Minute))},
+		}
+		c := newScaleClient(s, false, alluxio, dataset, autoscaler)
+		_, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReplicas(c)).To(Equal(int32(2)))
+		got := getAutoscaler(c)
+		Expect(got.Status.DesiredReplicas).To(Equal(int32(2)))
+		Expect(got.Status.Recommendations).To(HaveLen(2))
+	})
+
+	It("should keep the replicas when no metric is available", func() {
+		alluxio.Status.CacheStates = nil
+		c := newScaleClient(s, false, alluxio, dataset, autoscaler)
+		_, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReplicas(c)).To(Equal(int32(2)))
+		got := getAutoscaler(c)
+		Expect(got.Status.Conditions[0].Status).To(Equal(corev1.ConditionFalse))
+		Expect(got.Status.Conditions[0].Reason).To(Equal(reasonNoMetricFound))
+	})
+
+	It("should not requeue an invalid autoscaler", func() {
+		autoscaler.Spec.MaxReplicas = 0
+		c := newScaleClient(s, false, alluxio, dataset, autoscaler)
+		result, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+		Expect(getAutoscaler(c).Status.Conditions[0].Reason).To(Equal(reasonInvalidSpec))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.RuntimeAutoscalerNotValid)))
+	})
+
+	It("should report the failure to get the scale of a missing runtime", func() {
+		c := newScaleClient(s, false, dataset, autoscaler)
+		result, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+		Expect(getAutoscaler(c).Status.Conditions[0].Reason).To(Equal(reasonFailedGetScale))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.RuntimeAutoscalerFailedGetScale)))
+	})
+
+	It("should report the failure to rescale the runtime", func() {
+		c := newScaleClient(s, true, alluxio, dataset, autoscaler)
+		_, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReplicas(c)).To(Equal(int32(2)))
+		got := getAutoscaler(c)
+		Expect(got.Status.Conditions[0].Reason).To(Equal(reasonFailedRescale))
+		Expect(got.Status.LastScaleTime).To(BeNil())
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.RuntimeAutoscalerFailedRescale)))
+	})
+
+	It("should ignore a deleted autoscaler", func() {
+		c := newScaleClient(s, false, alluxio, dataset)
+		result, err := newReconciler(c).Reconcile(context.TODO(), req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+})
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const defaultDrainTimeoutSeconds int32 = 300
+
+// WorkerDrainer is implemented by the engines which are able to drain the workers before scaling in
+type WorkerDrainer interface {
+	// DrainWorkers asks the cache engine to decommission the worker pods to be removed. It's called in every sync
+	// until all the workers are drained or the drain timeout is reached, and returns the number of drained workers.
+	DrainWorkers(workers []corev1.Pod) (drained int, err error)
+
+	// RestoreWorkers recommissions the drained workers. It's called after the drained workers are removed
+	// or the scale-in is cancelled, so that the workers on the same nodes are able to serve again.
+	RestoreWorkers(workers []corev1.Pod) error
+}
+
+// SyncReplicasGracefully drains the workers to be removed before shrinking the workers if graceful scale-in is
+// enabled, and the progress is recorded in the ScalingIn condition of the runtime. The workers are shrunk after all
+// of them are drained or the drain timeout is reached. The replicas are synced directly in other cases.
+func (e *Helper) SyncReplicasGracefully(ctx cruntime.ReconcileRequestContext,
+	runtime base.RuntimeInterface,
+	currentStatus datav1alpha1.RuntimeStatus,
+	workers *appsv1.StatefulSet,
+	policy datav1alpha1.ScaleInPolicy,
+	drainer WorkerDrainer) (err error) {
+
+	currentReplicas := ptr.Deref(workers.Spec.Replicas, 0)
+	desiredReplicas := runtime.Replicas()
+	_, cond := utils.GetRuntimeCondition(runtime.GetStatus().Conditions, datav1alpha1.RuntimeWorkersScalingIn)
+	scalingIn := cond != nil && cond.Status == corev1.ConditionTrue
+
+	if !policy.Graceful || desiredReplicas >= currentReplicas {
+		if scalingIn {
+			if err = e.cancelScaleIn(runtime, workers, drainer); err != nil {
+				return err
+			}
+			currentStatus = *runtime.GetStatus().DeepCopy()
+		}
+		return e.SyncReplicas(ctx, runtime, currentStatus, workers)
+	}
+
+	victims, err := e.getStatefulSetPods(workers, desiredReplicas, currentReplicas)
+	if err != nil {
+		return err
+	}
+	if err = e.syncDrainingTarget(workers, desiredReplicas, scalingIn, drainer); err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	if scalingIn {
+		startTime = cond.LastTransitionTime.Time
+	} else {
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.WorkersDraining,
+			"Draining %d workers before scaling in from %d replicas to %d replicas", len(victims), currentReplicas, desiredReplicas)
+	}
+
+	drained, err := drainer.DrainWorkers(victims)
+	if err != nil {
+		e.log.Error(err, "Failed to drain the workers, retry later")
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.RuntimeScaleInFailed, "Failed to drain the workers: %v", err)
+	}
+
+	timeout := time.Duration(ptr.Deref(policy.DrainTimeoutSeconds, defaultDrainTimeoutSeconds)) * time.Second
+	timedOut := time.Since(startTime) >= timeout
+	if drained < len(victims) && !timedOut {
+		message := fmt.Sprintf("Drained %d/%d workers before scaling in from %d replicas to %d replicas.",
+			drained, len(victims), currentReplicas, desiredReplicas)
+		return e.updateScaleInCondition(runtime, datav1alpha1.RuntimeWorkersDrainingReason, message, corev1.ConditionTrue)
+	}
+
+	reason := datav1alpha1.RuntimeWorkersDrainedReason
+	if drained < len(victims) {
+		reason = datav1alpha1.RuntimeWorkersDrainTimeoutReason
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.WorkersDrainTimeout,
+			"Only %d/%d workers are drained in %v, scale in anyway", drained, len(victims), timeout)
+	}
+	message := fmt.Sprintf("Drained %d/%d workers and scaled in from %d replicas to %d replicas.",
+		drained, len(victims), currentReplicas, desiredReplicas)
+	if err = e.updateScaleInCondition(runtime, reason, message, corev1.ConditionFalse); err != nil {
+		return err
+	}
+	if err = e.setDrainingTarget(workers, ""); err != nil {
+		return err
+	}
+
+	if err = e.SyncReplicas(ctx, runtime, *runtime.GetStatus().DeepCopy(), workers); err != nil {
+		return err
+	}
+
+	if err = drainer.RestoreWorkers(victims); err != nil {
+		e.log.Error(err, "Failed to restore the removed workers")
+	}
+	return nil
+}
+
+// cancelScaleIn restores the drained workers when the replicas are changed or graceful scale-in is disabled
+// before the workers are shrunk.
+func (e *Helper) cancelScaleIn(runtime base.RuntimeInterface, workers *appsv1.StatefulSet, drainer WorkerDrainer) error {
+	pods, err := e.getStatefulSetPods(workers, 0, ptr.Deref(workers.Spec.Replicas, 0))
+	if err != nil {
+		return err
+	}
+	if err = drainer.RestoreWorkers(pods); err != nil {
+		return err
+	}
+	if err = e.setDrainingTarget(workers, ""); err != nil {
+		return err
+	}
+
+	e.log.Info("Draining workers is cancelled", "replicas", runtime.Replicas())
+	message := fmt.Sprintf("Draining workers is cancelled, the desired replicas are %d.", runtime.Replicas())
+	return e.updateScaleInCondition(runtime, datav1alpha1.RuntimeWorkersScaleInCancelledReason, message, corev1.ConditionFalse)
+}
+
+// syncDrainingTarget records the replicas the workers are being scaled in to. If the replicas are changed while the
+// workers are being drained, the drained workers which are not to be removed any more are restored.
+func (e *Helper) syncDrainingTarget(workers *appsv1.StatefulSet,
+	desiredReplicas int32,
+	scalingIn bool,
+	drainer WorkerDrainer) error {
+
+	value, found := workers.Annotations[common.AnnotationWorkersDrainingTo]
+	if found && value == strconv.Itoa(int(desiredReplicas)) {
+		return nil
+	}
+
+	if drainingTo, parseErr := strconv.Atoi(value); scalingIn && found && parseErr == nil && int32(drainingTo) < desiredReplicas {
+		pods, err := e.getStatefulSetPods(workers, int32(drainingTo), desiredReplicas)
+		if err != nil {
+			return err
+		}
+		e.log.Info("Restore the drained workers which are not to be removed", "from", drainingTo, "to", desiredReplicas)
+		if err = drainer.RestoreWorkers(pods); err != nil {
+			return err
+		}
+	}
+
+	return e.setDrainingTarget(workers, strconv.Itoa(int(desiredReplicas)))
+}
+
+// setDrainingTarget sets the replicas the workers are being scaled in to, it's removed if the value is empty.
+// The workers are patched in place so that they can be updated later.
+func (e *Helper) setDrainingTarget(workers *appsv1.StatefulSet, value string) error {
+	if workers.Annotations[common.AnnotationWorkersDrainingTo] == value {
+		return nil
+	}
+	workersToUpdate := workers.DeepCopy()
+	if len(value) == 0 {
+		delete(workersToUpdate.Annotations, common.AnnotationWorkersDrainingTo)
+	} else {
+		if workersToUpdate.Annotations == nil {
+			workersToUpdate.Annotations = map[string]string{}
+		}
+		workersToUpdate.Annotations[common.AnnotationWorkersDrainingTo] = value
+	}
+	if err := e.client.Patch(context.TODO(), workersToUpdate, client.MergeFrom(workers)); err != nil {
+		return err
+	}
+	workersToUpdate.DeepCopyInto(workers)
+	return nil
+}
+
+// getStatefulSetPods returns the existing pods of the statefulset whose ordinals are in [from, to)
+func (e *Helper) getStatefulSetPods(sts *appsv1.StatefulSet, from, to int32) (pods []corev1.Pod, err error) {
+	for i := from; i < to; i++ {
+		pod, err := kubeclient.GetPodByName(e.client, fmt.Sprintf("%s-%d", sts.Name, i), sts.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if pod != nil {
+			pods = append(pods, *pod)
+		}
+	}
+	return pods, nil
+}
+
+// updateScaleInCondition updates the ScalingIn condition of the runtime, the transition time is kept while
+// the workers are being drained so that it can be used to check the drain timeout.
+func (e *Helper) updateScaleInCondition(runtime base.RuntimeInterface, reason, message string, status corev1.ConditionStatus) error {
+	statusToUpdate := runtime.GetStatus()
+	cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkersScalingIn, reason, message, status)
+	_, oldCond := utils.GetRuntimeCondition(statusToUpdate.Conditions, cond.Type)
+	if oldCond != nil && oldCond.Status == cond.Status {
+		if oldCond.Reason == cond.Reason && oldCond.Message == cond.Message {
+			return nil
+		}
+		cond.LastTransitionTime = oldCond.LastTransitionTime
+	}
+
+	statusToUpdate.Conditions = utils.UpdateRuntimeCondition(statusToUpdate.Conditions, cond)
+	return e.client.Status().Update(context.TODO(), runtime)
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+type fakeWorkerDrainer struct {
+	drained         int
+	err             error
+	drainedWorkers  []string
+	restoredWorkers []string
+}
+
+func (d *fakeWorkerDrainer) DrainWorkers(workers []corev1.Pod) (int, error) {
+	for _, worker := range workers {
+		d.drainedWorkers = append(d.drainedWorkers, worker.Name)
+	}
+	return d.drained, d.err
+}
+
+func (d *fakeWorkerDrainer) RestoreWorkers(workers []corev1.Pod) error {
+	for _, worker := range workers {
+		d.restoredWorkers = append(d.restoredWorkers, worker.Name)
+	}
+	return nil
+}
+
+var _ = Describe("Ctrl Scale In Tests", func() {
+	var (
+		helper       *Helper
+		resources    []runtime.Object
+		k8sClient    client.Client
+		fluidRuntime *datav1alpha1.JuiceFSRuntime
+		workerSts    *appsv1.StatefulSet
+		drainer      *fakeWorkerDrainer
+		policy       datav1alpha1.ScaleInPolicy
+		ctx          cruntime.ReconcileRequestContext
+	)
+
+	newWorkerPod := func(ordinal string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-worker-" + ordinal, Namespace: "fluid"},
+			Status:     corev1.PodStatus{HostIP: "192.168.0." + ordinal},
+		}
+	}
+
+	getRuntime := func() *datav1alpha1.JuiceFSRuntime {
+		updatedRuntime := &datav1alpha1.JuiceFSRuntime{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test"}, updatedRuntime)).To(Succeed())
+		return updatedRuntime
+	}
+
+	getWorkerReplicas := func() int32 {
+		updatedSts := &appsv1.StatefulSet{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test-worker"}, updatedSts)).To(Succeed())
+		return *updatedSts.Spec.Replicas
+	}
+
+	BeforeEach(func() {
+		fluidRuntime = &datav1alpha1.JuiceFSRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"},
+			Spec:       datav1alpha1.JuiceFSRuntimeSpec{Replicas: 1},
+			Status:     datav1alpha1.RuntimeStatus{DesiredWorkerNumberScheduled: 3},
+		}
+		workerSts = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-worker", Namespace: "fluid"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](3)},
+		}
+		drainer = &fakeWorkerDrainer{}
+		policy = datav1alpha1.ScaleInPolicy{Graceful: true}
+		ctx = cruntime.ReconcileRequestContext{
+			Log:      fake.NullLogger(),
+			Recorder: record.NewFakeRecorder(300),
+		}
+	})
+
+	JustBeforeEach(func() {
+		dataset := &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"}}
+		resources = append([]runtime.Object{fluidRuntime, dataset, workerSts}, newWorkerPod("0"), newWorkerPod("1"), newWorkerPod("2"))
+		k8sClient = fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, resources...)
+		runtimeInfo, _ := base.BuildRuntimeInfo(fluidRuntime.Name, fluidRuntime.Namespace, common.JuiceFSRuntime)
+		helper = BuildHelper(runtimeInfo, k8sClient, fake.NullLogger())
+	})
+
+	It("should scale in directly if graceful scale-in is disabled", func() {
+		policy.Graceful = false
+		Expect(helper.SyncReplicasGracefully(ctx, fluidRuntime, fluidRuntime.Status, workerSts, policy, drainer)).To(Succeed())
+		Expect(drainer.drainedWorkers).To(BeEmpty())
+		Expect(getWorkerReplicas()).To(Equal(int32(1)))
+	})
+
+	It("should wait for the workers to be drained", func() {
+		drainer.drained = 1
+		Expect(helper.SyncReplicasGracefully(ctx, fluidRuntime, fluidRuntime.Status, workerSts, policy, drainer)).To(Succeed())
+		Expect(drainer.drainedWorkers).To(ConsistOf("test-worker-1", "test-worker-2"))
+		Expect(getWorkerReplicas()).To(Equal(int32(3)))
+
+		_, cond := utils.GetRuntimeCondition(getRuntime().Status.Conditions, datav1alpha1.RuntimeWorkersScalingIn)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeWorkersDrainingReason))
+		Expect(cond.Message).To(ContainSubstring("Drained 1/2 workers"))
+	})
+
+	It("should keep waiting if draining fails", func() {
+		drainer.err = errors.New("failed to decommission")
+		Expect(helper.SyncReplicasGracefully(ctx, fluidRuntime, fluidRuntime.Status, workerSts, policy, drainer)).To(Succeed())
+		Expect(getWorkerReplicas()).To(Equal(int32(3)))
+	})
+
+	It("should scale in after all the workers are drained", func() {
+		drainer.drained = 2
+		Expect(helper.SyncReplicasGracefully(ctx, fluidRuntime, fluidRuntime.Status, workerSts, policy, drainer)).To(Succeed())
+		Expect(getWorkerReplicas()).To(Equal(int32(1)))
+		Expect(drainer.restoredWorkers).To(ConsistOf("test-worker-1", "test-worker-2"))
+
+		_, cond := utils.GetRuntimeCondition(getRuntime().Status.Conditions, datav1alpha1.RuntimeWorkersScalingIn)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeWorkersDrainedReason))
+
+		updatedSts := &appsv1.StatefulSet{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test-worker"}, updatedSts)).To(Succeed())
+		Expect(updatedSts.Annotations).NotTo(HaveKey(common.AnnotationWorkersDrainingTo))
+	})
+
+	Context("when the workers are being drained", func() {
+		BeforeEach(func() {
+			cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkersScalingIn, datav1alpha1.RuntimeWorkersDrainingReason,
+				"Drained 0/2 workers before scaling in from 3 replicas to 1 replicas.", corev1.ConditionTrue)
+			cond.LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Minute))
+			fluidRuntime.Status.Conditions = []datav1alpha1.RuntimeCondition{cond}
+		})
+
+		It("should keep the transition time when the progress is updated", func() {
+			drainer.drained = 1
+			Expect(helper.SyncReplicasGracefully(ctx, fluidRuntime, fluidRuntime.Status, workerSts, policy, drainer)).To(Succeed())
+
+			_, cond := utils.GetRuntimeCondition(getRuntime().Status.Conditions, datav1alpha1.RuntimeWorkersScalingIn)
+			Expect(cond.Message).To(ContainSubstring("Drained 1/2 workers"))
+			Expect(time.Since(cond.LastTransitionTime.Time)).To(BeNumerically(">=", 50*time.Second))
+		})
+
+		It("should scale in when draining times out", func() {
+			policy.DrainTimeoutSeconds = ptr.To[int32](30)
+			Expect(helper.SyncReplicasGracefully(ctx, fluidRuntime, fluidRuntime.Status, workerSts, policy, drainer)).To(Succeed())
+			Expect(getWorkerReplicas()).To(Equal(int32(1)))
+
+			_, cond := utils.GetRuntimeCondition(getRuntime().Status.Conditions, datav1alpha1.RuntimeWorkersScalingIn)
+			Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeWorkersDrainTimeoutReason))
+		})
+
+		When("the replicas are changed but still less than the current replicas", func() {
+			BeforeEach(func() {
+				fluidRuntime.Spec.Replicas = 2
+				workerSts.Annotations = map[string]string{common.AnnotationWorkersDrainingTo: "1"}
+			})
+
+			It("should restore the drained workers not to be removed any more", func() {
+				Expect(helper.SyncReplicasGracefully(ctx, fluidRuntime, fluidRuntime.Status, workerSts, policy, drainer)).To(Succeed())
+				Expect(drainer.restoredWorkers).To(ConsistOf("test-worker-1"))
+				Expect(drainer.drainedWorkers).To(ConsistOf("test-worker-2"))
+				Expect(getWorkerReplicas()).To(Equal(int32(3)))
+
+				updatedSts := &appsv1.StatefulSet{}
+				Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test-worker"}, updatedSts)).To(Succeed())
+				Expect(updatedSts.Annotations).To(HaveKeyWithValue(common.AnnotationWorkersDrainingTo, "2"))
+			})
+		})
+
+		When("the replicas are scaled back", func() {
+			BeforeEach(func() {
+				fluidRuntime.Spec.Replicas = 3
+			})
+
+			It("should cancel draining and restore the workers", func() {
+				Expect(helper.SyncReplicasGracefully(ctx, fluidRuntime, fluidRuntime.Status, workerSts, policy, drainer)).To(Succeed())
+				Expect(getWorkerReplicas()).To(Equal(int32(3)))
+				Expect(drainer.drainedWorkers).To(BeEmpty())
+				Expect(drainer.restoredWorkers).To(ConsistOf("test-worker-0", "test-worker-1", "test-worker-2"))
+
+				_, cond := utils.GetRuntimeCondition(getRuntime().Status.Conditions, datav1alpha1.RuntimeWorkersScalingIn)
+				Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+				Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeWorkersScaleInCancelledReason))
+			})
+		})
+	})
+})
@@ -0,0 +1,419 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const defaultWorkerBatchSize int32 = 1
+
+// UpgradeComponent describes a component of the runtime and the version it's upgraded to
+type UpgradeComponent struct {
+	// Name is the name of the statefulset or daemonset of the component
+	Name string
+
+	// Container is the name of the container running the runtime image
+	Container string
+
+	// Image and ImageTag are the version to upgrade to, the current image or tag is kept if it's empty
+	Image    string
+	ImageTag string
+}
+
+// UpgradeTarget describes the components of the runtime to upgrade
+type UpgradeTarget struct {
+	Master UpgradeComponent
+	Worker UpgradeComponent
+	Fuse   UpgradeComponent
+	Policy datav1alpha1.UpgradePolicy
+}
+
+// componentVersion is the observed version of a runtime component
+type componentVersion struct {
+	datav1alpha1.ComponentVersionStatus
+
+	// templateImage is the image in the pod template of the component
+	templateImage string
+
+	// outdatedPods are the pods not running the target version, which are sorted by ordinal
+	outdatedPods []corev1.Pod
+
+	// inProgress means some pods are being restarted or the upgraded pods are not ready yet
+	inProgress bool
+}
+
+func (v componentVersion) upgraded() bool {
+	return v.templateImage == v.TargetVersion && len(v.outdatedPods) == 0 && !v.inProgress
+}
+
+// SyncUpgrade upgrades the runtime components to the target versions in order. The master is upgraded first, then the
+// workers are upgraded in batches from the highest ordinal, and the fuse is marked as outdated at last so that the fuse
+// pod on each node is recreated once it's not used any more. Before restarting any pod, the upgrade is paused if the
+// runtime is not healthy. The progress is recorded in the Upgrading condition and the versions of the components
+// are recorded in the runtime status. Only AlluxioRuntime is upgraded this way so far.
+func (e *Helper) SyncUpgrade(ctx cruntime.ReconcileRequestContext,
+	runtime base.RuntimeInterface,
+	target UpgradeTarget,
+	checkHealthy func() error) (changed bool, err error) {
+
+	namespace := e.runtimeInfo.GetNamespace()
+	master, err := kubeclient.GetStatefulSet(e.client, target.Master.Name, namespace)
+	if err != nil {
+		return
+	}
+	workers, err := kubeclient.GetStatefulSet(e.client, target.Worker.Name, namespace)
+	if err != nil {
+		return
+	}
+	fuse, err := kubeclient.GetDaemonset(e.client, target.Fuse.Name, namespace)
+	if err != nil {
+		return
+	}
+
+	masterVersion, err := e.getStatefulSetVersion(master, target.Master)
+	if err != nil {
+		return
+	}
+	workerVersion, err := e.getStatefulSetVersion(workers, target.Worker)
+	if err != nil {
+		return
+	}
+	fuseVersion, err := e.getDaemonSetVersion(fuse, target.Fuse)
+	if err != nil {
+		return
+	}
+
+	statusToUpdate := runtime.GetStatus()
+	oldStatus := statusToUpdate.DeepCopy()
+	statusToUpdate.Upgrade = &datav1alpha1.RuntimeUpgradeStatus{
+		Master: masterVersion.ComponentVersionStatus,
+		Worker: workerVersion.ComponentVersionStatus,
+		Fuse:   fuseVersion.ComponentVersionStatus,
+	}
+
+	_, cond := utils.GetRuntimeCondition(statusToUpdate.Conditions, datav1alpha1.RuntimeUpgrading)
+	upgrading := cond != nil && cond.Status == corev1.ConditionTrue
+	// the pods restarted for other reasons are not taken as upgrading
+	if !upgrading {
+		masterVersion.inProgress = false
+		workerVersion.inProgress = false
+	}
+
+	switch {
+	case !masterVersion.upgraded():
+		changed, err = e.upgradeStatefulSet(ctx, runtime, master, masterVersion, 1, checkHealthy,
+			"master", datav1alpha1.RuntimeUpgradingMasterReason)
+	case !workerVersion.upgraded():
+		batchSize := ptr.Deref(target.Policy.WorkerBatchSize, defaultWorkerBatchSize)
+		changed, err = e.upgradeStatefulSet(ctx, runtime, workers, workerVersion, batchSize, checkHealthy,
+			"workers", datav1alpha1.RuntimeUpgradingWorkersReason)
+	default:
+		if fuseVersion.templateImage != fuseVersion.TargetVersion {
+			if err = e.markFuseOutdated(ctx, runtime, fuse, fuseVersion); err != nil {
+				return
+			}
+			changed = true
+		} else if !upgrading {
+			break
+		}
+		message := fmt.Sprintf("The master and workers are upgraded to %s, the fuse is upgraded to %s on each node once it's not used.",
+			workerVersion.TargetVersion, fuseVersion.TargetVersion)
+		setUpgradeCondition(statusToUpdate, datav1alpha1.RuntimeUpgradedReason, message, corev1.ConditionFalse)
+		ctx.Recorder.Event(runtime, corev1.EventTypeNormal, common.RuntimeUpgraded, message)
+	}
+	if err != nil {
+		return
+	}
+
+	if !reflect.DeepEqual(oldStatus, statusToUpdate) {
+		err = e.client.Status().Update(context.TODO(), runtime)
+	}
+	return
+}
+
+// upgradeStatefulSet updates the image of the statefulset and restarts at most batchSize outdated pods in a sync.
+// The next batch is not restarted until the upgraded pods are ready and the runtime is healthy.
+func (e *Helper) upgradeStatefulSet(ctx cruntime.ReconcileRequestContext,
+	runtime base.RuntimeInterface,
+	sts *appsv1.StatefulSet,
+	version componentVersion,
+	batchSize int32,
+	checkHealthy func() error,
+	component string,
+	reason string) (changed bool, err error) {
+
+	statusToUpdate := runtime.GetStatus()
+	progress := fmt.Sprintf("%d/%d pods of the %s are upgraded to %s.",
+		version.UpdatedReplicas, version.Replicas, component, version.TargetVersion)
+	if version.inProgress {
+		setUpgradeCondition(statusToUpdate, reason, "Waiting for the upgraded pods to be ready, "+progress, corev1.ConditionTrue)
+		return
+	}
+
+	if err = checkHealthy(); err != nil {
+		e.log.Info("The runtime is not healthy, pause upgrading", "component", component, "reason", err.Error())
+		_, cond := utils.GetRuntimeCondition(statusToUpdate.Conditions, datav1alpha1.RuntimeUpgrading)
+		if cond == nil || cond.Reason != datav1alpha1.RuntimeUpgradePausedReason {
+			ctx.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.RuntimeUpgradePaused,
+				"Upgrading the %s is paused because the runtime is not healthy: %v", component, err)
+		}
+		setUpgradeCondition(statusToUpdate, datav1alpha1.RuntimeUpgradePausedReason,
+			fmt.Sprintf("The runtime is not healthy: %v, %s", err, progress), corev1.ConditionTrue)
+		return false, nil
+	}
+
+	if version.templateImage != version.TargetVersion {
+		stsToUpdate := sts.DeepCopy()
+		setContainerImage(&stsToUpdate.Spec.Template.Spec, version.templateImage, version.TargetVersion)
+		// the pods are restarted by the orchestrator in order
+		stsToUpdate.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+		if err = e.client.Update(context.TODO(), stsToUpdate); err != nil {
+			return
+		}
+		e.log.Info("Upgrading the statefulset", "name", sts.Name, "from", version.templateImage, "to", version.TargetVersion)
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.RuntimeUpgrading,
+			"Upgrading the %s from %s to %s", component, version.templateImage, version.TargetVersion)
+		changed = true
+	}
+
+	// restart the pods with the highest ordinals first like the partitioned rolling update of statefulset
+	var restarted int32
+	for i := len(version.outdatedPods) - 1; i >= 0 && restarted < batchSize; i-- {
+		pod := version.outdatedPods[i]
+		if err = e.client.Delete(context.TODO(), &pod); err != nil {
+			if utils.IgnoreNotFound(err) != nil {
+				return
+			}
+			err = nil
+		}
+		e.log.Info("Restarting the pod to upgrade", "pod", pod.Name, "image", version.TargetVersion)
+		restarted++
+	}
+
+	setUpgradeCondition(statusToUpdate, reason, fmt.Sprintf("Restarting %d pods, %s", restarted, progress), corev1.ConditionTrue)
+	return
+}
+
+// markFuseOutdated updates the image of the fuse and increases the fuse generation in the pod template and the pvc,
+// so that the CSI plugin recreates the outdated fuse pod on each node once it's not used.
+func (e *Helper) markFuseOutdated(ctx cruntime.ReconcileRequestContext,
+	runtime base.RuntimeInterface,
+	fuse *appsv1.DaemonSet,
+	version componentVersion) (err error) {
+
+	pvc, err := kubeclient.GetPersistentVolumeClaim(e.client, e.runtimeInfo.GetName(), e.runtimeInfo.GetNamespace())
+	if utils.IgnoreNotFound(err) != nil {
+		return
+	}
+	if err != nil {
+		pvc = nil
+		err = nil
+	}
+
+	generation := parseFuseGeneration(fuse.Spec.Template.Labels)
+	if pvc != nil {
+		generation = max(generation, parseFuseGeneration(pvc.Labels))
+	}
+	newGeneration := strconv.Itoa(generation + 1)
+
+	fuseToUpdate := fuse.DeepCopy()
+	setContainerImage(&fuseToUpdate.Spec.Template.Spec, version.templateImage, version.TargetVersion)
+	if fuseToUpdate.Spec.Template.Labels == nil {
+		fuseToUpdate.Spec.Template.Labels = map[string]string{}
+	}
+	fuseToUpdate.Spec.Template.Labels[common.LabelRuntimeFuseGeneration] = newGeneration
+	if err = e.client.Update(context.TODO(), fuseToUpdate); err != nil {
+		return
+	}
+
+	if pvc != nil {
+		labelsToModify := common.LabelsToModify{}
+		if _, exist := pvc.Labels[common.LabelRuntimeFuseGeneration]; exist {
+			labelsToModify.Update(common.LabelRuntimeFuseGeneration, newGeneration)
+		} else {
+			labelsToModify.Add(common.LabelRuntimeFuseGeneration, newGeneration)
+		}
+		if _, err = utils.PatchLabels(e.client, pvc, labelsToModify); err != nil {
+			return
+		}
+	}
+
+	e.log.Info("Marked the fuse as outdated", "from", version.templateImage, "to", version.TargetVersion, "generation", newGeneration)
+	ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.RuntimeUpgrading,
+		"Upgrading the fuse from %s to %s, the fuse pods are recreated once they are not used", version.templateImage, version.TargetVersion)
+	return
+}
+
+// getStatefulSetVersion gets the version of the pods of the statefulset
+func (e *Helper) getStatefulSetVersion(sts *appsv1.StatefulSet, component UpgradeComponent) (version componentVersion, err error) {
+	replicas := ptr.Deref(sts.Spec.Replicas, 0)
+	pods, err := e.getStatefulSetPods(sts, 0, replicas)
+	if err != nil {
+		return
+	}
+
+	version = newComponentVersion(sts.Spec.Template.Spec, component, replicas)
+	// some pods are deleted and not created yet
+	version.inProgress = int32(len(pods)) < replicas
+	version.collectPods(pods, component.Container)
+	return
+}
+
+// getDaemonSetVersion gets the version of the pods of the daemonset
+func (e *Helper) getDaemonSetVersion(ds *appsv1.DaemonSet, component UpgradeComponent) (version componentVersion, err error) {
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return
+	}
+	podList := &corev1.PodList{}
+	err = e.client.List(context.TODO(), podList, &client.ListOptions{Namespace: ds.Namespace, LabelSelector: selector})
+	if err != nil {
+		return
+	}
+
+	version = newComponentVersion(ds.Spec.Template.Spec, component, int32(len(podList.Items)))
+	version.collectPods(podList.Items, component.Container)
+	return
+}
+
+func newComponentVersion(spec corev1.PodSpec, component UpgradeComponent, replicas int32) componentVersion {
+	templateImage := getContainerImage(spec, component.Container)
+	return componentVersion{
+		ComponentVersionStatus: datav1alpha1.ComponentVersionStatus{
+			TargetVersion: resolveImage(templateImage, component.Image, component.ImageTag),
+			Replicas:      replicas,
+		},
+		templateImage: templateImage,
+	}
+}
+
+// collectPods counts the upgraded pods and collects the outdated ones. The current version is the image of the
+// outdated pods if there are any, otherwise it's the image in the pod template.
+func (v *componentVersion) collectPods(pods []corev1.Pod, container string) {
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			v.inProgress = true
+			continue
+		}
+
+		image := getContainerImage(pod.Spec, container)
+		if image != v.TargetVersion {
+			v.outdatedPods = append(v.outdatedPods, pod)
+			v.CurrentVersion = image
+			continue
+		}
+
+		v.UpdatedReplicas++
+		if !podutil.IsPodReady(&pod) {
+			v.inProgress = true
+		}
+	}
+
+	if len(v.CurrentVersion) == 0 {
+		v.CurrentVersion = v.templateImage
+	}
+}
+
+// getContainerImage returns the image of the container with the given name
+func getContainerImage(spec corev1.PodSpec, name string) string {
+	for _, container := range spec.Containers {
+		if container.Name == name {
+			return container.Image
+		}
+	}
+	return ""
+}
+
+// setContainerImage replaces the old image of all the containers and init containers with the new one
+func setContainerImage(spec *corev1.PodSpec, oldImage, newImage string) {
+	for i := range spec.InitContainers {
+		if spec.InitContainers[i].Image == oldImage {
+			spec.InitContainers[i].Image = newImage
+		}
+	}
+	for i := range spec.Containers {
+		if spec.Containers[i].Image == oldImage {
+			spec.Containers[i].Image = newImage
+		}
+	}
+}
+
+// resolveImage returns the image to upgrade to, the repository or tag of the current image is kept if it's
+// not specified.
+func resolveImage(currentImage, image, tag string) string {
+	if len(image) == 0 && len(tag) == 0 {
+		return currentImage
+	}
+
+	currentRepo, currentTag := currentImage, ""
+	// the tag is after the last colon unless the colon belongs to the registry host
+	if idx := strings.LastIndex(currentImage, ":"); idx > strings.LastIndex(currentImage, "/") {
+		currentRepo, currentTag = currentImage[:idx], currentImage[idx+1:]
+	}
+	if len(image) == 0 {
+		image = currentRepo
+	}
+	if len(tag) == 0 {
+		tag = currentTag
+	}
+	if len(tag) == 0 {
+		return image
+	}
+	return image + ":" + tag
+}
+
+// parseFuseGeneration parses the fuse generation in the labels, 0 is returned if it's not found or invalid
+func parseFuseGeneration(labels map[string]string) int {
+	generation, err := strconv.Atoi(labels[common.LabelRuntimeFuseGeneration])
+	if err != nil {
+		return 0
+	}
+	return generation
+}
+
+// setUpgradeCondition sets the Upgrading condition of the runtime, the transition time is kept while the status
+// of the condition is not changed.
+func setUpgradeCondition(status *datav1alpha1.RuntimeStatus, reason, message string, conditionStatus corev1.ConditionStatus) {
+	cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeUpgrading, reason, message, conditionStatus)
+	_, oldCond := utils.GetRuntimeCondition(status.Conditions, cond.Type)
+	if oldCond != nil && oldCond.Status == cond.Status {
+		if oldCond.Reason == cond.Reason && oldCond.Message == cond.Message {
+			return
+		}
+		cond.LastTransitionTime = oldCond.LastTransitionTime
+	}
+	status.Conditions = utils.UpdateRuntimeCondition(status.Conditions, cond)
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("Ctrl Upgrade Tests", func() {
+	const (
+		oldImage     = "registry:5000/alluxio:2.9.0"
+		newImage     = "registry:5000/alluxio:2.9.1"
+		oldFuseImage = "registry:5000/alluxio-fuse:2.9.0"
+		newFuseImage = "registry:5000/alluxio-fuse:2.9.1"
+	)
+
+	var (
+		helper       *Helper
+		resources    []runtime.Object
+		k8sClient    client.Client
+		fluidRuntime *datav1alpha1.AlluxioRuntime
+		masterSts    *appsv1.StatefulSet
+		workerSts    *appsv1.StatefulSet
+		fuseDs       *appsv1.DaemonSet
+		pvc          *corev1.PersistentVolumeClaim
+		pods         []runtime.Object
+		target       UpgradeTarget
+		healthErr    error
+		ctx          cruntime.ReconcileRequestContext
+	)
+
+	newPodSpec := func(container, image string) corev1.PodSpec {
+		return corev1.PodSpec{Containers: []corev1.Container{{Name: container, Image: image}}}
+	}
+
+	newPod := func(name, container, image string, labels map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fluid", Labels: labels},
+			Spec:       newPodSpec(container, image),
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	newStatefulSetPods := func(sts *appsv1.StatefulSet, container, image string) (pods []runtime.Object) {
+		for i := int32(0); i < *sts.Spec.Replicas; i++ {
+			pods = append(pods, newPod(fmt.Sprintf("%s-%d", sts.Name, i), container, image, nil))
+		}
+		return
+	}
+
+	getRuntime := func() *datav1alpha1.AlluxioRuntime {
+		updatedRuntime := &datav1alpha1.AlluxioRuntime{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test"}, updatedRuntime)).To(Succeed())
+		return updatedRuntime
+	}
+
+	getUpgradeCondition := func() *datav1alpha1.RuntimeCondition {
+		_, cond := utils.GetRuntimeCondition(getRuntime().Status.Conditions, datav1alpha1.RuntimeUpgrading)
+		return cond
+	}
+
+	getStatefulSet := func(name string) *appsv1.StatefulSet {
+		sts := &appsv1.StatefulSet{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: name}, sts)).To(Succeed())
+		return sts
+	}
+
+	podExists := func(name string) bool {
+		err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: name}, &corev1.Pod{})
+		if apierrs.IsNotFound(err) {
+			return false
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return true
+	}
+
+	syncUpgrade := func() bool {
+		changed, err := helper.SyncUpgrade(ctx, fluidRuntime, target, func() error { return healthErr })
+		Expect(err).NotTo(HaveOccurred())
+		return changed
+	}
+
+	BeforeEach(func() {
+		fluidRuntime = &datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"},
+		}
+		masterSts = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-master", Namespace: "fluid"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](1),
+				Template: corev1.PodTemplateSpec{Spec: newPodSpec("alluxio-master", oldImage)},
+			},
+		}
+		workerSts = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-worker", Namespace: "fluid"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](3),
+				Template: corev1.PodTemplateSpec{Spec: newPodSpec("alluxio-worker", oldImage)},
+			},
+		}
+		fuseDs = &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-fuse", Namespace: "fluid"},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "alluxio-fuse"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"role": "alluxio-fuse"}},
+					Spec:       newPodSpec("alluxio-fuse", oldFuseImage),
+				},
+			},
+		}
+		pvc = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "fluid",
+				Labels:    map[string]string{common.LabelRuntimeFuseGeneration: "1"},
+			},
+		}
+		pods = append(newStatefulSetPods(masterSts, "alluxio-master", oldImage), newStatefulSetPods(workerSts, "alluxio-worker", oldImage)...)
+		pods = append(pods, newPod("test-fuse-abcde", "alluxio-fuse", oldFuseImage, map[string]string{"role": "alluxio-fuse"}))
+
+		target = UpgradeTarget{
+			Master: UpgradeComponent{Name: "test-master", Container: "alluxio-master"},
+			Worker: UpgradeComponent{Name: "test-worker", Container: "alluxio-worker"},
+			Fuse:   UpgradeComponent{Name: "test-fuse", Container: "alluxio-fuse"},
+		}
+		healthErr = nil
+		ctx = cruntime.ReconcileRequestContext{
+			Log:      fake.NullLogger(),
+			Recorder: record.NewFakeRecorder(300),
+		}
+	})
+
+	JustBeforeEach(func() {
+		resources = append([]runtime.Object{fluidRuntime, masterSts, workerSts, fuseDs, pvc}, pods...)
+		k8sClient = fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, resources...)
+		runtimeInfo, _ := base.BuildRuntimeInfo(fluidRuntime.Name, fluidRuntime.Namespace, common.AlluxioRuntime)
+		helper = BuildHelper(runtimeInfo, k8sClient, fake.NullLogger())
+	})
+
+	It("should only record the versions if the version is not changed", func() {
+		Expect(syncUpgrade()).To(BeFalse())
+		Expect(getUpgradeCondition()).To(BeNil())
+
+		upgradeStatus := getRuntime().Status.Upgrade
+		Expect(upgradeStatus).NotTo(BeNil())
+		Expect(upgradeStatus.Master).To(Equal(datav1alpha1.ComponentVersionStatus{
+			CurrentVersion: oldImage, TargetVersion: oldImage, UpdatedReplicas: 1, Replicas: 1,
+		}))
+		Expect(upgradeStatus.Worker.UpdatedReplicas).To(Equal(int32(3)))
+		Expect(upgradeStatus.Fuse.CurrentVersion).To(Equal(oldFuseImage))
+	})
+
+	When("the version is changed", func() {
+		BeforeEach(func() {
+			target.Master.ImageTag = "2.9.1"
+			target.Worker.ImageTag = "2.9.1"
+			target.Fuse.ImageTag = "2.9.1"
+		})
+
+		It("should upgrade the master first", func() {
+			Expect(syncUpgrade()).To(BeTrue())
+			master := getStatefulSet("test-master")
+			Expect(master.Spec.Template.Spec.Containers[0].Image).To(Equal(newImage))
+			Expect(master.Spec.UpdateStrategy.Type).To(Equal(appsv1.OnDeleteStatefulSetStrategyType))
+			Expect(podExists("test-master-0")).To(BeFalse())
+			Expect(getStatefulSet("test-worker").Spec.Template.Spec.Containers[0].Image).To(Equal(oldImage))
+
+			cond := getUpgradeCondition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeUpgradingMasterReason))
+			Expect(getRuntime().Status.Upgrade.Master.TargetVersion).To(Equal(newImage))
+		})
+
+		It("should pause upgrading if the runtime is not healthy", func() {
+			healthErr = errors.New("the master is not ready")
+			Expect(syncUpgrade()).To(BeFalse())
+			Expect(getStatefulSet("test-master").Spec.Template.Spec.Containers[0].Image).To(Equal(oldImage))
+			Expect(podExists("test-master-0")).To(BeTrue())
+
+			cond := getUpgradeCondition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeUpgradePausedReason))
+			Expect(cond.Message).To(ContainSubstring("the master is not ready"))
+		})
+
+		Context("and the master is being upgraded", func() {
+			BeforeEach(func() {
+				masterSts.Spec.Template.Spec.Containers[0].Image = newImage
+				pods[0] = newPod("test-master-0", "alluxio-master", newImage, nil)
+				pods[0].(*corev1.Pod).Status.Conditions = nil
+				fluidRuntime.Status.Conditions = []datav1alpha1.RuntimeCondition{
+					utils.NewRuntimeCondition(datav1alpha1.RuntimeUpgrading, datav1alpha1.RuntimeUpgradingMasterReason, "", corev1.ConditionTrue),
+				}
+			})
+
+			It("should wait for the master to be ready", func() {
+				Expect(syncUpgrade()).To(BeFalse())
+				Expect(getStatefulSet("test-worker").Spec.Template.Spec.Containers[0].Image).To(Equal(oldImage))
+
+				cond := getUpgradeCondition()
+				Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeUpgradingMasterReason))
+				Expect(cond.Message).To(ContainSubstring("Waiting for the upgraded pods to be ready"))
+			})
+		})
+
+		Context("and the master is upgraded", func() {
+			BeforeEach(func() {
+				masterSts.Spec.Template.Spec.Containers[0].Image = newImage
+				pods[0] = newPod("test-master-0", "alluxio-master", newImage, nil)
+				target.Policy.WorkerBatchSize = ptr.To[int32](2)
+			})
+
+			It("should upgrade the workers in batches from the highest ordinal", func() {
+				Expect(syncUpgrade()).To(BeTrue())
+				Expect(getStatefulSet("test-worker").Spec.Template.Spec.Containers[0].Image).To(Equal(newImage))
+				Expect(podExists("test-worker-0")).To(BeTrue())
+				Expect(podExists("test-worker-1")).To(BeFalse())
+				Expect(podExists("test-worker-2")).To(BeFalse())
+
+				cond := getUpgradeCondition()
+				Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeUpgradingWorkersReason))
+				Expect(getRuntime().Status.Upgrade.Master.CurrentVersion).To(Equal(newImage))
+				Expect(getRuntime().Status.Upgrade.Worker.CurrentVersion).To(Equal(oldImage))
+			})
+		})
+
+		Context("and the master and workers are upgraded", func() {
+			BeforeEach(func() {
+				masterSts.Spec.Template.Spec.Containers[0].Image = newImage
+				workerSts.Spec.Template.Spec.Containers[0].Image = newImage
+				pods = append(newStatefulSetPods(masterSts, "alluxio-master", newImage), newStatefulSetPods(workerSts, "alluxio-worker", newImage)...)
+				pods = append(pods, newPod("test-fuse-abcde", "alluxio-fuse", oldFuseImage, map[string]string{"role": "alluxio-fuse"}))
+				fluidRuntime.Status.Conditions = []datav1alpha1.RuntimeCondition{
+					utils.NewRuntimeCondition(datav1alpha1.RuntimeUpgrading, datav1alpha1.RuntimeUpgradingWorkersReason, "", corev1.ConditionTrue),
+				}
+			})
+
+			It("should mark the fuse as outdated and complete upgrading", func() {
+				Expect(syncUpgrade()).To(BeTrue())
+
+				fuse := &appsv1.DaemonSet{}
+				Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test-fuse"}, fuse)).To(Succeed())
+				Expect(fuse.Spec.Template.Spec.Containers[0].Image).To(Equal(newFuseImage))
+				Expect(fuse.Spec.Template.Labels).To(HaveKeyWithValue(common.LabelRuntimeFuseGeneration, "2"))
+				Expect(podExists("test-fuse-abcde")).To(BeTrue())
+
+				updatedPvc := &corev1.PersistentVolumeClaim{}
+				Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test"}, updatedPvc)).To(Succeed())
+				Expect(updatedPvc.Labels).To(HaveKeyWithValue(common.LabelRuntimeFuseGeneration, "2"))
+
+				cond := getUpgradeCondition()
+				Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+				Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeUpgradedReason))
+				Expect(getRuntime().Status.Upgrade.Fuse.CurrentVersion).To(Equal(oldFuseImage))
+				Expect(getRuntime().Status.Upgrade.Fuse.TargetVersion).To(Equal(newFuseImage))
+			})
+		})
+	})
+
+	DescribeTable("resolveImage",
+		func(currentImage, image, tag, expected string) {
+			Expect(resolveImage(currentImage, image, tag)).To(Equal(expected))
+		},
+		Entry("keeps the current image", "registry:5000/alluxio:2.9.0", "", "", "registry:5000/alluxio:2.9.0"),
+		Entry("changes the tag", "registry:5000/alluxio:2.9.0", "", "2.9.1", "registry:5000/alluxio:2.9.1"),
+		Entry("changes the repository", "registry:5000/alluxio:2.9.0", "alluxio/alluxio", "", "alluxio/alluxio:2.9.0"),
+		Entry("handles the image without tag", "registry:5000/alluxio", "", "2.9.1", "registry:5000/alluxio:2.9.1"),
+	)
+})
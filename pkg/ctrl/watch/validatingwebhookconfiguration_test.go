@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validatingWebhookConfigurationEventHandler", func() {
+	var (
+		webhookName = "test"
+		handler     *validatingWebhookConfigurationEventHandler
+	)
+
+	BeforeEach(func() {
+		handler = &validatingWebhookConfigurationEventHandler{}
+	})
+
+	newConfiguration := func(name string) *admissionregistrationv1.ValidatingWebhookConfiguration {
+		return &admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	Describe("onCreateFunc", func() {
+		It("should reconcile only the ValidatingWebhookConfiguration with the correct name", func() {
+			f := handler.onCreateFunc(webhookName)
+			Expect(f(event.CreateEvent{Object: newConfiguration(webhookName)})).To(BeTrue())
+			Expect(f(event.CreateEvent{Object: newConfiguration("fakeTest")})).To(BeFalse())
+			Expect(f(event.CreateEvent{Object: &appsv1.DaemonSet{}})).To(BeFalse())
+		})
+	})
+
+	Describe("onUpdateFunc", func() {
+		It("should reconcile only the ValidatingWebhookConfiguration with the correct name", func() {
+			f := handler.onUpdateFunc(webhookName)
+			Expect(f(event.UpdateEvent{ObjectOld: newConfiguration(webhookName), ObjectNew: newConfiguration(webhookName)})).To(BeTrue())
+			Expect(f(event.UpdateEvent{ObjectOld: newConfiguration("fakeTest"), ObjectNew: newConfiguration("fakeTest")})).To(BeFalse())
+			Expect(f(event.UpdateEvent{ObjectOld: &appsv1.DaemonSet{}, ObjectNew: newConfiguration(webhookName)})).To(BeFalse())
+			Expect(f(event.UpdateEvent{ObjectOld: newConfiguration(webhookName), ObjectNew: &appsv1.DaemonSet{}})).To(BeFalse())
+		})
+	})
+
+	Describe("onDeleteFunc", func() {
+		It("should not reconcile on deletion", func() {
+			f := handler.onDeleteFunc(webhookName)
+			Expect(f(event.DeleteEvent{Object: newConfiguration(webhookName)})).To(BeFalse())
+		})
+	})
+})
@@ -46,3 +46,55 @@ type DataBackup struct {
 	ImagePullSecrets []corev1.LocalObjectReference `yaml:"imagePullSecrets,omitempty"`
 	Affinity         *corev1.Affinity              `yaml:"affinity,omitempty"`
 }
+
+// CacheDataBackupValue defines the value yaml file used in the CacheRuntime DataBackup helm chart
+type CacheDataBackupValue struct {
+	Name           string                 `json:"name"`
+	OwnerDatasetId string                 `json:"ownerDatasetId"`
+	Owner          *common.OwnerReference `json:"owner,omitempty"`
+	DataBackupInfo CacheDataBackupInfo    `json:"dataBackup"`
+}
+
+// CacheDataBackupInfo defines values used in the CacheRuntime DataBackup helm chart,
+// the backup command is declared by the CacheRuntimeClass rather than built in the chart.
+type CacheDataBackupInfo struct {
+	// Namespace is the namespace of the dataset and DataBackup
+	Namespace string `json:"namespace,omitempty"`
+
+	// Dataset is the dataset that this DataBackup targets
+	Dataset string `json:"dataset,omitempty"`
+
+	// NodeName is the node to run the backup pod, empty means scheduled by kubernetes
+	NodeName string `json:"nodeName,omitempty"`
+
+	// Image is the image that the backup pod uses
+	Image string `json:"image,omitempty"`
+
+	// PVCName is the pvc to save backup files, if empty, backup files will be saved in the host path
+	PVCName string `json:"pvcName,omitempty"`
+
+	// Path is the path in pvc or host to save backup files
+	Path string `json:"path,omitempty"`
+
+	// Command for the backup container
+	Command []string `json:"command,omitempty"`
+
+	// Args for the backup container
+	Args []string `json:"args,omitempty"`
+
+	// Envs for the backup container
+	Envs []corev1.EnvVar `json:"envs,omitempty"`
+
+	// RunAsUser and RunAsGroup set the security context of the backup container if specified
+	RunAsUser  *int64 `json:"runAsUser,omitempty"`
+	RunAsGroup *int64 `json:"runAsGroup,omitempty"`
+
+	// image pull secrets
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataevict
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// GetTargetPaths returns the paths whose cache will be evicted, the whole dataset is returned if no target is set.
+func GetTargetPaths(dataEvict *datav1alpha1.DataEvict) (paths []string) {
+	for _, target := range dataEvict.Spec.Target {
+		paths = append(paths, target.Path)
+	}
+	if len(paths) == 0 {
+		paths = append(paths, "/")
+	}
+	return
+}
+
+// IsWholeDatasetEvicted checks if the DataEvict evicts the cache of the whole dataset.
+// It's used by the engines which can only evict all the cache at once.
+func IsWholeDatasetEvicted(dataEvict *datav1alpha1.DataEvict) bool {
+	for _, path := range GetTargetPaths(dataEvict) {
+		if filepath.Clean(path) == "/" {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNextScheduleTime returns the next time to evict the cache for the DataEvict with Cron policy.
+// The schedule is counted from the last schedule time, or the creation time if it has never been scheduled.
+func GetNextScheduleTime(dataEvict *datav1alpha1.DataEvict) (time.Time, error) {
+	schedule, err := cron.ParseStandard(dataEvict.Spec.Schedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse schedule %q: %v", dataEvict.Spec.Schedule, err)
+	}
+
+	lastScheduleTime := dataEvict.CreationTimestamp.Time
+	if dataEvict.Status.LastScheduleTime != nil {
+		lastScheduleTime = dataEvict.Status.LastScheduleTime.Time
+	}
+	return schedule.Next(lastScheduleTime), nil
+}
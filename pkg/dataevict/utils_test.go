@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataevict
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func newDataEvict(paths ...string) *datav1alpha1.DataEvict {
+	dataEvict := &datav1alpha1.DataEvict{}
+	for _, path := range paths {
+		dataEvict.Spec.Target = append(dataEvict.Spec.Target, datav1alpha1.EvictTargetPath{Path: path})
+	}
+	return dataEvict
+}
+
+func TestGetTargetPaths(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataEvict *datav1alpha1.DataEvict
+		want      []string
+	}{
+		{name: "no target", dataEvict: newDataEvict(), want: []string{"/"}},
+		{name: "multiple targets", dataEvict: newDataEvict("/a", "/b"), want: []string{"/a", "/b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetTargetPaths(tt.dataEvict); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetTargetPaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsWholeDatasetEvicted(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataEvict *datav1alpha1.DataEvict
+		want      bool
+	}{
+		{name: "no target", dataEvict: newDataEvict(), want: true},
+		{name: "root path", dataEvict: newDataEvict("/a", "//"), want: true},
+		{name: "sub paths", dataEvict: newDataEvict("/a", "/b"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsWholeDatasetEvicted(tt.dataEvict); got != tt.want {
+				t.Errorf("IsWholeDatasetEvicted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetNextScheduleTime(t *testing.T) {
+	creationTime := time.Date(2026, 1, 1, 0, 30, 0, 0, time.UTC)
+	lastScheduleTime := time.Date(2026, 1, 1, 5, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		schedule         string
+		lastScheduleTime *metav1.Time
+		want             time.Time
+		wantErr          bool
+	}{
+		{
+			name:     "never scheduled",
+			schedule: "0 * * * *",
+			want:     time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "scheduled before",
+			schedule:         "0 * * * *",
+			lastScheduleTime: &metav1.Time{Time: lastScheduleTime},
+			want:             time.Date(2026, 1, 1, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "invalid schedule",
+			schedule: "invalid",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataEvict := newDataEvict()
+			dataEvict.CreationTimestamp = metav1.Time{Time: creationTime}
+			dataEvict.Spec.Schedule = tt.schedule
+			dataEvict.Status.LastScheduleTime = tt.lastScheduleTime
+
+			got, err := GetNextScheduleTime(dataEvict)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetNextScheduleTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("GetNextScheduleTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"fmt"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// ValidateDataFlowSteps checks that the steps of the DataFlow form a directed acyclic graph, and each step
+// has exactly one data operation template which runs once.
+func ValidateDataFlowSteps(steps []datav1alpha1.DataFlowStep) error {
+	stepIndex := make(map[string]int, len(steps))
+	for i, step := range steps {
+		if _, found := stepIndex[step.Name]; found {
+			return fmt.Errorf("duplicate step name %q", step.Name)
+		}
+		stepIndex[step.Name] = i
+
+		if _, err := GetStepOperationKind(step); err != nil {
+			return err
+		}
+		if err := validateStepTemplate(step); err != nil {
+			return err
+		}
+	}
+
+	for _, step := range steps {
+		for _, dependency := range step.Dependencies {
+			if _, found := stepIndex[dependency]; !found {
+				return fmt.Errorf("step %q depends on step %q which doesn't exist", step.Name, dependency)
+			}
+		}
+		if step.AffinityStrategy != nil && len(step.AffinityStrategy.DependOn) != 0 && !utils.ContainsString(step.Dependencies, step.AffinityStrategy.DependOn) {
+			return fmt.Errorf("step %q has affinity with step %q which is not one of its dependencies", step.Name, step.AffinityStrategy.DependOn)
+		}
+	}
+
+	// detect cycles by depth-first search, the steps in the current search path are marked as visiting
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make([]int, len(steps))
+	var path []string
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch states[i] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("steps have circular dependencies: %s -> %s", strings.Join(path, " -> "), steps[i].Name)
+		}
+		states[i] = visiting
+		path = append(path, steps[i].Name)
+		for _, dependency := range steps[i].Dependencies {
+			if err := visit(stepIndex[dependency]); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		states[i] = visited
+		return nil
+	}
+	for i := range steps {
+		if err := visit(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateStepTemplate checks that the data operation template of the step runs once, and leaves its order and
+// cleanup to the DataFlow. The DataFlow waits for the data operation to finish, and it loses the status of the step
+// if the data operation is cleaned up by TTL.
+func validateStepTemplate(step datav1alpha1.DataFlowStep) error {
+	var (
+		policy   datav1alpha1.Policy
+		runAfter *datav1alpha1.OperationRef
+		ttl      *int32
+	)
+	switch {
+	case step.DataLoad != nil:
+		policy, runAfter, ttl = step.DataLoad.Policy, step.DataLoad.RunAfter, step.DataLoad.TTLSecondsAfterFinished
+	case step.DataProcess != nil:
+		policy, runAfter, ttl = step.DataProcess.Policy, step.DataProcess.RunAfter, step.DataProcess.TTLSecondsAfterFinished
+	case step.DataMigrate != nil:
+		policy, runAfter, ttl = step.DataMigrate.Policy, step.DataMigrate.RunAfter, step.DataMigrate.TTLSecondsAfterFinished
+	}
+
+	if len(policy) != 0 && policy != datav1alpha1.Once {
+		return fmt.Errorf("step %q must use the %s policy, but got %s", step.Name, datav1alpha1.Once, policy)
+	}
+	if runAfter != nil {
+		return fmt.Errorf("step %q can't set runAfter, use dependencies instead", step.Name)
+	}
+	if ttl != nil {
+		return fmt.Errorf("step %q can't set ttlSecondsAfterFinished, the data operations are deleted together with the DataFlow", step.Name)
+	}
+	return nil
+}
+
+// GetStepOperationKind returns the kind of the data operation created for the step.
+func GetStepOperationKind(step datav1alpha1.DataFlowStep) (dataoperation.OperationType, error) {
+	var kinds []dataoperation.OperationType
+	if step.DataLoad != nil {
+		kinds = append(kinds, dataoperation.DataLoadType)
+	}
+	if step.DataProcess != nil {
+		kinds = append(kinds, dataoperation.DataProcessType)
+	}
+	if step.DataMigrate != nil {
+		kinds = append(kinds, dataoperation.DataMigrateType)
+	}
+	if len(kinds) != 1 {
+		return "", fmt.Errorf("step %q must have exactly one template of DataLoad, DataProcess and DataMigrate, but got %d", step.Name, len(kinds))
+	}
+	return kinds[0], nil
+}
+
+// GetStepOperationName returns the name of the data operation created for the step.
+func GetStepOperationName(dataFlowName, stepName string) string {
+	return fmt.Sprintf("%s-%s", dataFlowName, stepName)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+)
+
+func TestValidateDataFlowSteps(t *testing.T) {
+	loadStep := func(name string, dependencies ...string) datav1alpha1.DataFlowStep {
+		return datav1alpha1.DataFlowStep{Name: name, Dependencies: dependencies, DataLoad: &datav1alpha1.DataLoadSpec{}}
+	}
+
+	tests := []struct {
+		name    string
+		steps   []datav1alpha1.DataFlowStep
+		wantErr string
+	}{
+		{
+			name: "fan-out and fan-in",
+			steps: []datav1alpha1.DataFlowStep{
+				loadStep("load"),
+				{Name: "process-a", Dependencies: []string{"load"}, DataProcess: &datav1alpha1.DataProcessSpec{}},
+				{Name: "process-b", Dependencies: []string{"load"}, DataProcess: &datav1alpha1.DataProcessSpec{}},
+				{Name: "migrate", Dependencies: []string{"process-a", "process-b"}, DataMigrate: &datav1alpha1.DataMigrateSpec{},
+					AffinityStrategy: &datav1alpha1.DataFlowAffinityStrategy{DependOn: "process-b"}},
+			},
+		},
+		{
+			name:    "duplicate step name",
+			steps:   []datav1alpha1.DataFlowStep{loadStep("load"), loadStep("load")},
+			wantErr: "duplicate step name",
+		},
+		{
+			name:    "no template",
+			steps:   []datav1alpha1.DataFlowStep{{Name: "load"}},
+			wantErr: "exactly one template",
+		},
+		{
+			name: "more than one template",
+			steps: []datav1alpha1.DataFlowStep{
+				{Name: "load", DataLoad: &datav1alpha1.DataLoadSpec{}, DataMigrate: &datav1alpha1.DataMigrateSpec{}},
+			},
+			wantErr: "exactly one template",
+		},
+		{
+			name:    "dependency not found",
+			steps:   []datav1alpha1.DataFlowStep{loadStep("load", "missing")},
+			wantErr: "which doesn't exist",
+		},
+		{
+			name: "affinity with a step which is not a dependency",
+			steps: []datav1alpha1.DataFlowStep{
+				loadStep("a"),
+				loadStep("b"),
+				{Name: "c", Dependencies: []string{"a"}, DataLoad: &datav1alpha1.DataLoadSpec{},
+					AffinityStrategy: &datav1alpha1.DataFlowAffinityStrategy{DependOn: "b"}},
+			},
+			wantErr: "not one of its dependencies",
+		},
+		{
+			name:    "circular dependencies",
+			steps:   []datav1alpha1.DataFlowStep{loadStep("a", "c"), loadStep("b", "a"), loadStep("c", "b")},
+			wantErr: "circular dependencies: a -> c -> b -> a",
+		},
+		{
+			name: "once policy",
+			steps: []datav1alpha1.DataFlowStep{
+				{Name: "load", DataLoad: &datav1alpha1.DataLoadSpec{Policy: datav1alpha1.Once}},
+			},
+		},
+		{
+			name: "cron policy",
+			steps: []datav1alpha1.DataFlowStep{
+				{Name: "load", DataLoad: &datav1alpha1.DataLoadSpec{Policy: datav1alpha1.Cron, Schedule: "* * * * *"}},
+			},
+			wantErr: "must use the Once policy",
+		},
+		{
+			name: "on event policy",
+			steps: []datav1alpha1.DataFlowStep{
+				{Name: "migrate", DataMigrate: &datav1alpha1.DataMigrateSpec{Policy: datav1alpha1.OnEvent}},
+			},
+			wantErr: "must use the Once policy",
+		},
+		{
+			name: "run after",
+			steps: []datav1alpha1.DataFlowStep{
+				{Name: "process", DataProcess: &datav1alpha1.DataProcessSpec{RunAfter: &datav1alpha1.OperationRef{}}},
+			},
+			wantErr: "can't set runAfter",
+		},
+		{
+			name: "ttl seconds after finished",
+			steps: []datav1alpha1.DataFlowStep{
+				{Name: "load", DataLoad: &datav1alpha1.DataLoadSpec{TTLSecondsAfterFinished: ptr.To[int32](10)}},
+			},
+			wantErr: "can't set ttlSecondsAfterFinished",
+		},
+		{
+			name:    "self dependency",
+			steps:   []datav1alpha1.DataFlowStep{loadStep("a", "a")},
+			wantErr: "circular dependencies",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDataFlowSteps(tt.steps)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("ValidateDataFlowSteps() got unexpected error %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateDataFlowSteps() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetStepOperationKind(t *testing.T) {
+	kind, err := GetStepOperationKind(datav1alpha1.DataFlowStep{Name: "process", DataProcess: &datav1alpha1.DataProcessSpec{}})
+	if err != nil || kind != dataoperation.DataProcessType {
+		t.Errorf("GetStepOperationKind() = %v, %v, want %v", kind, err, dataoperation.DataProcessType)
+	}
+
+	if name := GetStepOperationName("flow", "process"); name != "flow-process" {
+		t.Errorf("GetStepOperationName() = %v, want flow-process", name)
+	}
+}
@@ -24,4 +24,11 @@ const (
 	DataloadDefaultImage = "registry.cn-hangzhou.aliyuncs.com/fluid/fluid-dataloader"
 	DataloadSuffixLength = 5
 	EnvDataloaderImg     = "DATALOADER_IMG"
+
+	// LoadedEntries, SkippedEntries and MissingEntries are the keys in OperationStatus.Infos recording how many
+	// entries of the manifest or the target paths are loaded, skipped by the include and exclude patterns or missing
+	// in the dataset when the DataLoad has a manifest or file patterns.
+	LoadedEntries  = "LoadedEntries"
+	SkippedEntries = "SkippedEntries"
+	MissingEntries = "MissingEntries"
 )
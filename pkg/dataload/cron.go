@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// GenCronJobPolicy generates the policies of the CronJob for the DataLoad with Cron policy.
+func GenCronJobPolicy(dataLoad *datav1alpha1.DataLoad) common.CronJobPolicy {
+	if dataLoad.Spec.Policy != datav1alpha1.Cron {
+		return common.CronJobPolicy{}
+	}
+	return common.CronJobPolicy{
+		ConcurrencyPolicy:          string(dataLoad.Spec.ConcurrencyPolicy),
+		StartingDeadlineSeconds:    dataLoad.Spec.StartingDeadlineSeconds,
+		SuccessfulJobsHistoryLimit: dataLoad.Spec.SuccessfulJobsHistoryLimit,
+		FailedJobsHistoryLimit:     dataLoad.Spec.FailedJobsHistoryLimit,
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestGenCronJobPolicy(t *testing.T) {
+	spec := datav1alpha1.DataLoadSpec{
+		Schedule:                   "*/5 * * * *",
+		ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+		StartingDeadlineSeconds:    ptr.To[int64](300),
+		SuccessfulJobsHistoryLimit: ptr.To[int32](0),
+		FailedJobsHistoryLimit:     ptr.To[int32](2),
+	}
+
+	cronSpec := spec.DeepCopy()
+	cronSpec.Policy = datav1alpha1.Cron
+	policy := GenCronJobPolicy(&datav1alpha1.DataLoad{Spec: *cronSpec})
+	if policy.ConcurrencyPolicy != "Forbid" || *policy.StartingDeadlineSeconds != 300 ||
+		*policy.SuccessfulJobsHistoryLimit != 0 || *policy.FailedJobsHistoryLimit != 2 {
+		t.Errorf("GenCronJobPolicy() = %+v, want the policies in spec", policy)
+	}
+
+	onceSpec := spec.DeepCopy()
+	onceSpec.Policy = datav1alpha1.Once
+	policy = GenCronJobPolicy(&datav1alpha1.DataLoad{Spec: *onceSpec})
+	if policy.ConcurrencyPolicy != "" || policy.StartingDeadlineSeconds != nil ||
+		policy.SuccessfulJobsHistoryLimit != nil || policy.FailedJobsHistoryLimit != nil {
+		t.Errorf("GenCronJobPolicy() = %+v, want empty policies for Once policy", policy)
+	}
+}
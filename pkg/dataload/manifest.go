@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	fluiderrors "github.com/fluid-cloudnative/fluid/pkg/errors"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const (
+	// ManifestVolumeName is the name of the volume holding the manifest in the ConfigMap
+	ManifestVolumeName = "fluid-dataload-manifest"
+	// ManifestMountDir is the directory where the manifest in the ConfigMap is mounted in the loader pod
+	ManifestMountDir = "/etc/fluid/dataload-manifest"
+	// ManifestFileName is the file name of the manifest in the ConfigMap mounted in the loader pod
+	ManifestFileName = "manifest"
+
+	EnvManifestFile     = "FLUID_DATALOAD_MANIFEST_FILE"
+	EnvManifestPath     = "FLUID_DATALOAD_MANIFEST_PATH"
+	EnvManifestReplicas = "FLUID_DATALOAD_MANIFEST_REPLICAS"
+	EnvIncludePatterns  = "FLUID_DATALOAD_INCLUDE"
+	EnvExcludePatterns  = "FLUID_DATALOAD_EXCLUDE"
+)
+
+// runtimesWithoutTargetFilters are the runtimes whose loaders can't load the files in a manifest or matching the
+// file patterns
+var runtimesWithoutTargetFilters = sets.New[string](common.JindoRuntime, common.EFCRuntime, common.VineyardRuntime)
+
+// loadResultKeys maps the keys reported by the loader in the termination message to the keys in OperationStatus.Infos
+var loadResultKeys = map[string]string{
+	"loadedEntries":  LoadedEntries,
+	"skippedEntries": SkippedEntries,
+	"missingEntries": MissingEntries,
+}
+
+// HasTargetFilters returns true if the DataLoad loads files listed in a manifest or matching the file patterns
+func HasTargetFilters(dataLoad *datav1alpha1.DataLoad) bool {
+	return dataLoad.Spec.Manifest != nil || len(dataLoad.Spec.Include) > 0 || len(dataLoad.Spec.Exclude) > 0
+}
+
+// ValidateTargetFilters checks if the manifest and the file patterns of the DataLoad are valid
+func ValidateTargetFilters(dataLoad *datav1alpha1.DataLoad) error {
+	if manifest := dataLoad.Spec.Manifest; manifest != nil {
+		hasConfigMap := manifest.ConfigMapKeyRef != nil
+		hasPath := len(manifest.Path) > 0
+		if hasConfigMap == hasPath {
+			return fmt.Errorf("exactly one of configMapKeyRef and path must be set in the manifest")
+		}
+		if hasConfigMap && (len(manifest.ConfigMapKeyRef.Name) == 0 || len(manifest.ConfigMapKeyRef.Key) == 0) {
+			return fmt.Errorf("both name and key of configMapKeyRef must be set in the manifest")
+		}
+		if manifest.Replicas < 0 {
+			return fmt.Errorf("replicas of the manifest must not be negative")
+		}
+	}
+
+	for _, patterns := range [][]string{dataLoad.Spec.Include, dataLoad.Spec.Exclude} {
+		for _, pattern := range patterns {
+			if len(strings.TrimSpace(pattern)) == 0 {
+				return fmt.Errorf("file pattern must not be empty")
+			}
+			if _, err := compileTargetPattern(pattern); err != nil {
+				return fmt.Errorf("invalid file pattern %q: %v", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateTargetFiltersSupported checks if the loader of the runtime supports the manifest and the file patterns
+func ValidateTargetFiltersSupported(dataLoad *datav1alpha1.DataLoad, runtimeType string) error {
+	if HasTargetFilters(dataLoad) && runtimesWithoutTargetFilters.Has(runtimeType) {
+		return NewTargetFiltersNotSupported(runtimeType)
+	}
+	return nil
+}
+
+// NewTargetFiltersNotSupported returns the error for the runtime whose loader can't load the files in a manifest or
+// matching the file patterns
+func NewTargetFiltersNotSupported(runtimeType string) error {
+	return fluiderrors.NewNotSupported(schema.GroupResource{
+		Group:    datav1alpha1.GroupVersion.Group,
+		Resource: "the manifest, include and exclude of DataLoad",
+	}, runtimeType)
+}
+
+// compileTargetPattern translates the file pattern into an anchored regular expression. The pattern is matched the
+// same as the bash conditional expression [[ path == pattern ]] used by the loaders, where "*" matches any string
+// including "/", "?" matches any single character and "[...]" matches a character in the set, which is negated
+// by a leading "!" or "^". A character escaped by a backslash is matched literally.
+func compileTargetPattern(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '*':
+			expr.WriteString("(?s:.*)")
+		case '?':
+			expr.WriteString("(?s:.)")
+		case '\\':
+			if i+1 == len(runes) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			i++
+			expr.WriteString(regexp.QuoteMeta(string(runes[i])))
+		case '[':
+			end := i + 1
+			if end < len(runes) && (runes[end] == '!' || runes[end] == '^') {
+				end++
+			}
+			// a "]" right after the opening bracket is a member of the set
+			if end < len(runes) && runes[end] == ']' {
+				end++
+			}
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated character set")
+			}
+			expr.WriteString(translateCharacterSet(runes[i+1 : end]))
+			i = end
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.Compile(expr.String())
+}
+
+// translateCharacterSet translates the members of the bracket expression into a character class of regexp
+func translateCharacterSet(set []rune) string {
+	var class strings.Builder
+	class.WriteString("[")
+	if len(set) > 0 && (set[0] == '!' || set[0] == '^') {
+		class.WriteString("^")
+		set = set[1:]
+	}
+	for _, c := range set {
+		switch c {
+		case '-':
+			class.WriteRune(c)
+		case '\\', '[', ']', '^':
+			class.WriteString("\\")
+			class.WriteRune(c)
+		default:
+			class.WriteRune(c)
+		}
+	}
+	class.WriteString("]")
+	return class.String()
+}
+
+// SetTargetFilters sets the manifest and the file patterns of the DataLoad into the values of the loader chart
+func SetTargetFilters(info *DataLoadInfo, dataLoad *datav1alpha1.DataLoad) {
+	if manifest := dataLoad.Spec.Manifest; manifest != nil {
+		info.Manifest = &Manifest{
+			Path:     manifest.Path,
+			Replicas: manifest.Replicas,
+		}
+		if manifest.ConfigMapKeyRef != nil {
+			info.Manifest.ConfigMapName = manifest.ConfigMapKeyRef.Name
+			info.Manifest.ConfigMapKey = manifest.ConfigMapKeyRef.Key
+		}
+		if info.Manifest.Replicas == 0 {
+			info.Manifest.Replicas = 1
+		}
+	}
+	info.Include = dataLoad.Spec.Include
+	info.Exclude = dataLoad.Spec.Exclude
+}
+
+// InjectTargetFilterEnvs passes the manifest and the file patterns to the loader defined by the runtime profile or
+// the runtime class with environment variables, the manifest in the ConfigMap is mounted as a file. The patterns are
+// separated by newlines.
+func InjectTargetFilterEnvs(info *DataLoadInfo) {
+	if manifest := info.Manifest; manifest != nil {
+		if len(manifest.ConfigMapName) > 0 {
+			info.Envs = append(info.Envs, Env{Name: EnvManifestFile, Value: filepath.Join(ManifestMountDir, ManifestFileName)})
+			info.Volumes = append(info.Volumes, corev1.Volume{
+				Name: ManifestVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: manifest.ConfigMapName},
+						Items:                []corev1.KeyToPath{{Key: manifest.ConfigMapKey, Path: ManifestFileName}},
+					},
+				},
+			})
+			info.VolumeMounts = append(info.VolumeMounts, corev1.VolumeMount{
+				Name:      ManifestVolumeName,
+				MountPath: ManifestMountDir,
+				ReadOnly:  true,
+			})
+		} else {
+			info.Envs = append(info.Envs, Env{Name: EnvManifestPath, Value: manifest.Path})
+		}
+		info.Envs = append(info.Envs, Env{Name: EnvManifestReplicas, Value: strconv.Itoa(int(manifest.Replicas))})
+	}
+	if len(info.Include) > 0 {
+		info.Envs = append(info.Envs, Env{Name: EnvIncludePatterns, Value: strings.Join(info.Include, "\n")})
+	}
+	if len(info.Exclude) > 0 {
+		info.Envs = append(info.Envs, Env{Name: EnvExcludePatterns, Value: strings.Join(info.Exclude, "\n")})
+	}
+}
+
+// ParseLoadResult parses the result reported by the loader in the termination message, which consists of lines in
+// the format of key=value, e.g.
+//
+//	loadedEntries=100
+//	skippedEntries=2
+//	missingEntries=1
+//
+// Unknown keys and malformed lines are ignored.
+func ParseLoadResult(message string) map[string]string {
+	result := map[string]string{}
+	for _, line := range strings.Split(message, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found {
+			continue
+		}
+		if infoKey, ok := loadResultKeys[strings.TrimSpace(key)]; ok {
+			result[infoKey] = strings.TrimSpace(value)
+		}
+	}
+	return result
+}
+
+// GetLoadResultOfJob gets the result reported by the succeeded pod of the DataLoad job, it returns an empty map
+// if there is no succeeded pod or nothing is reported.
+func GetLoadResultOfJob(ctx context.Context, c client.Client, job *batchv1.Job) (map[string]string, error) {
+	pod, err := kubeclient.GetSucceedPodForJobWithContext(ctx, c, job)
+	if err != nil {
+		return nil, err
+	}
+	if pod == nil {
+		return map[string]string{}, nil
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && len(status.State.Terminated.Message) > 0 {
+			return ParseLoadResult(status.State.Terminated.Message), nil
+		}
+	}
+	return map[string]string{}, nil
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	fluiderrors "github.com/fluid-cloudnative/fluid/pkg/errors"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestValidateTargetFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    datav1alpha1.DataLoadSpec
+		wantErr bool
+	}{
+		{name: "no filters", spec: datav1alpha1.DataLoadSpec{}},
+		{
+			name: "manifest in configmap",
+			spec: datav1alpha1.DataLoadSpec{Manifest: &datav1alpha1.DataLoadManifest{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "files"}, Key: "list"},
+			}},
+		},
+		{
+			name: "manifest in dataset with patterns",
+			spec: datav1alpha1.DataLoadSpec{
+				Manifest: &datav1alpha1.DataLoadManifest{Path: "/manifest.txt"},
+				Include:  []string{"*.parquet"},
+				Exclude:  []string{"/tmp/*"},
+			},
+		},
+		{name: "empty manifest", spec: datav1alpha1.DataLoadSpec{Manifest: &datav1alpha1.DataLoadManifest{}}, wantErr: true},
+		{
+			name: "both configmap and path",
+			spec: datav1alpha1.DataLoadSpec{Manifest: &datav1alpha1.DataLoadManifest{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "files"}, Key: "list"},
+				Path:            "/manifest.txt",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "configmap without key",
+			spec: datav1alpha1.DataLoadSpec{Manifest: &datav1alpha1.DataLoadManifest{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "files"}},
+			}},
+			wantErr: true,
+		},
+		{name: "empty pattern", spec: datav1alpha1.DataLoadSpec{Include: []string{" "}}, wantErr: true},
+		{name: "malformed pattern", spec: datav1alpha1.DataLoadSpec{Exclude: []string{"[a-"}}, wantErr: true},
+		{name: "trailing backslash", spec: datav1alpha1.DataLoadSpec{Include: []string{"/data\\"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTargetFilters(&datav1alpha1.DataLoad{Spec: tt.spec})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTargetFilters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTargetFiltersSupported(t *testing.T) {
+	withPatterns := &datav1alpha1.DataLoad{Spec: datav1alpha1.DataLoadSpec{Include: []string{"*.parquet"}}}
+	if err := ValidateTargetFiltersSupported(withPatterns, common.AlluxioRuntime); err != nil {
+		t.Errorf("ValidateTargetFiltersSupported() error = %v, want nil", err)
+	}
+	if err := ValidateTargetFiltersSupported(&datav1alpha1.DataLoad{}, common.JindoRuntime); err != nil {
+		t.Errorf("ValidateTargetFiltersSupported() error = %v, want nil", err)
+	}
+	for _, runtimeType := range []string{common.JindoRuntime, common.EFCRuntime, common.VineyardRuntime} {
+		if err := ValidateTargetFiltersSupported(withPatterns, runtimeType); !fluiderrors.IsNotSupported(err) {
+			t.Errorf("ValidateTargetFiltersSupported() with %s error = %v, want NotSupported", runtimeType, err)
+		}
+	}
+}
+
+func TestCompileTargetPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "/data/*.csv", path: "/data/a.csv", want: true},
+		{pattern: "/data/*.csv", path: "/data/sub/b.csv", want: true},
+		{pattern: "/data/*.csv", path: "/data/a.csv.bak", want: false},
+		{pattern: "*", path: "/any/path", want: true},
+		{pattern: "/data/?.csv", path: "/data/a.csv", want: true},
+		{pattern: "/data/?.csv", path: "/data/ab.csv", want: false},
+		{pattern: "/data/[ab].csv", path: "/data/b.csv", want: true},
+		{pattern: "/data/[!ab].csv", path: "/data/b.csv", want: false},
+		{pattern: "/data/[^ab].csv", path: "/data/c.csv", want: true},
+		{pattern: "/data/[a-c].csv", path: "/data/c.csv", want: true},
+		{pattern: "/data/[]a].csv", path: "/data/].csv", want: true},
+		{pattern: "/data/\\*.csv", path: "/data/*.csv", want: true},
+		{pattern: "/data/\\*.csv", path: "/data/a.csv", want: false},
+		{pattern: "/data/a+b(1).csv", path: "/data/a+b(1).csv", want: true},
+	}
+	for _, tt := range tests {
+		re, err := compileTargetPattern(tt.pattern)
+		if err != nil {
+			t.Fatalf("compileTargetPattern(%q) error = %v", tt.pattern, err)
+		}
+		if got := re.MatchString(tt.path); got != tt.want {
+			t.Errorf("compileTargetPattern(%q) matches %q = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSetTargetFiltersAndInjectEnvs(t *testing.T) {
+	dataLoad := &datav1alpha1.DataLoad{Spec: datav1alpha1.DataLoadSpec{
+		Manifest: &datav1alpha1.DataLoadManifest{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "files"}, Key: "list"},
+		},
+		Include: []string{"*.parquet", "*.json"},
+		Exclude: []string{"/tmp/*"},
+	}}
+
+	info := &DataLoadInfo{}
+	SetTargetFilters(info, dataLoad)
+	wantManifest := &Manifest{ConfigMapName: "files", ConfigMapKey: "list", Replicas: 1}
+	if !reflect.DeepEqual(info.Manifest, wantManifest) {
+		t.Errorf("SetTargetFilters() manifest = %v, want %v", info.Manifest, wantManifest)
+	}
+
+	InjectTargetFilterEnvs(info)
+	wantEnvs := []Env{
+		{Name: EnvManifestFile, Value: "/etc/fluid/dataload-manifest/manifest"},
+		{Name: EnvManifestReplicas, Value: "1"},
+		{Name: EnvIncludePatterns, Value: "*.parquet\n*.json"},
+		{Name: EnvExcludePatterns, Value: "/tmp/*"},
+	}
+	if !reflect.DeepEqual(info.Envs, wantEnvs) {
+		t.Errorf("InjectTargetFilterEnvs() envs = %v, want %v", info.Envs, wantEnvs)
+	}
+	if len(info.Volumes) != 1 || info.Volumes[0].ConfigMap == nil || info.Volumes[0].ConfigMap.Name != "files" ||
+		info.Volumes[0].ConfigMap.Items[0].Key != "list" {
+		t.Errorf("InjectTargetFilterEnvs() volumes = %v, want the configmap files", info.Volumes)
+	}
+	if len(info.VolumeMounts) != 1 || info.VolumeMounts[0].MountPath != ManifestMountDir {
+		t.Errorf("InjectTargetFilterEnvs() volume mounts = %v, want mounted at %s", info.VolumeMounts, ManifestMountDir)
+	}
+
+	info = &DataLoadInfo{}
+	SetTargetFilters(info, &datav1alpha1.DataLoad{Spec: datav1alpha1.DataLoadSpec{
+		Manifest: &datav1alpha1.DataLoadManifest{Path: "/manifest.txt", Replicas: 2},
+	}})
+	InjectTargetFilterEnvs(info)
+	wantEnvs = []Env{
+		{Name: EnvManifestPath, Value: "/manifest.txt"},
+		{Name: EnvManifestReplicas, Value: "2"},
+	}
+	if !reflect.DeepEqual(info.Envs, wantEnvs) || len(info.Volumes) != 0 {
+		t.Errorf("InjectTargetFilterEnvs() envs = %v, volumes = %v, want envs %v without volumes", info.Envs, info.Volumes, wantEnvs)
+	}
+}
+
+func TestParseLoadResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    map[string]string
+	}{
+		{name: "empty", message: "", want: map[string]string{}},
+		{
+			name:    "all keys",
+			message: "loadedEntries=10\nskippedEntries=2\nmissingEntries=1\n",
+			want:    map[string]string{LoadedEntries: "10", SkippedEntries: "2", MissingEntries: "1"},
+		},
+		{
+			name:    "unknown keys and malformed lines",
+			message: "loadedEntries = 3\nfoo=bar\nnot a result",
+			want:    map[string]string{LoadedEntries: "3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseLoadResult(tt.message); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLoadResult() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLoadResultOfJob(t *testing.T) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "default"},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": "test-job"}},
+		},
+	}
+	newPod := func(name string, phase corev1.PodPhase, message string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"job-name": "test-job"}},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}}},
+				},
+			},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+
+	tests := []struct {
+		name string
+		objs []runtime.Object
+		want map[string]string
+	}{
+		{name: "no succeeded pod", objs: []runtime.Object{newPod("failed", corev1.PodFailed, "loadedEntries=1")}, want: map[string]string{}},
+		{name: "nothing reported", objs: []runtime.Object{newPod("succeeded", corev1.PodSucceeded, "")}, want: map[string]string{}},
+		{
+			name: "reported by succeeded pod",
+			objs: []runtime.Object{newPod("succeeded", corev1.PodSucceeded, "loadedEntries=5\nskippedEntries=0\nmissingEntries=2")},
+			want: map[string]string{LoadedEntries: "5", SkippedEntries: "0", MissingEntries: "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(scheme, tt.objs...)
+			got, err := GetLoadResultOfJob(context.TODO(), c, job)
+			if err != nil {
+				t.Fatalf("GetLoadResultOfJob() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetLoadResultOfJob() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -37,6 +37,9 @@ type DataLoadInfo struct {
 	// Schedule The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.
 	Schedule string `json:"schedule,omitempty"`
 
+	// CronJobPolicy specifies the policies of the CronJob, only set when policy is cron
+	common.CronJobPolicy
+
 	// BackoffLimit specifies the upper limit times when the DataLoad job fails
 	BackoffLimit int32 `json:"backoffLimit,omitempty"`
 
@@ -49,6 +52,15 @@ type DataLoadInfo struct {
 	// TargetPaths specifies which paths should the DataLoad load
 	TargetPaths []TargetPath `json:"targetPaths,omitempty"`
 
+	// Manifest specifies the manifest listing files that the DataLoad should load
+	Manifest *Manifest `json:"manifest,omitempty"`
+
+	// Include specifies glob patterns of the files that the DataLoad should load
+	Include []string `json:"include,omitempty"`
+
+	// Exclude specifies glob patterns of the files that the DataLoad should not load
+	Exclude []string `json:"exclude,omitempty"`
+
 	// Image specifies the image that the DataLoad job uses
 	Image string `json:"image,omitempty"`
 
@@ -108,3 +120,17 @@ type TargetPath struct {
 	// FluidNative specifies if the path is a native mountPoint(e.g. hostpath or pvc)
 	FluidNative bool `json:"fluidNative,omitempty"`
 }
+
+type Manifest struct {
+	// ConfigMapName specifies the ConfigMap which holds the manifest
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// ConfigMapKey specifies the key of the manifest in the ConfigMap
+	ConfigMapKey string `json:"configMapKey,omitempty"`
+
+	// Path specifies the path of the manifest inside the dataset
+	Path string `json:"path,omitempty"`
+
+	// Replicas specifies how many replicas should be loaded for the files in the manifest
+	Replicas int32 `json:"replicas,omitempty"`
+}
@@ -28,4 +28,9 @@ const (
 
 	DefaultSSHReadyTimeoutSeconds = 180
 	DefaultSSHPort                = 22
+
+	// CopiedFiles and CopiedBytes are the keys in OperationStatus.Infos recording how many files and bytes
+	// are copied by the DataMigrate job, only set when the job reports them.
+	CopiedFiles = "CopiedFiles"
+	CopiedBytes = "CopiedBytes"
 )
@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// GenCronJobPolicy generates the policies of the CronJob for the DataMigrate with Cron policy.
+func GenCronJobPolicy(dataMigrate *datav1alpha1.DataMigrate) common.CronJobPolicy {
+	if dataMigrate.Spec.Policy != datav1alpha1.Cron {
+		return common.CronJobPolicy{}
+	}
+	return common.CronJobPolicy{
+		ConcurrencyPolicy:          string(dataMigrate.Spec.ConcurrencyPolicy),
+		StartingDeadlineSeconds:    dataMigrate.Spec.StartingDeadlineSeconds,
+		SuccessfulJobsHistoryLimit: dataMigrate.Spec.SuccessfulJobsHistoryLimit,
+		FailedJobsHistoryLimit:     dataMigrate.Spec.FailedJobsHistoryLimit,
+	}
+}
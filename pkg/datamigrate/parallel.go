@@ -0,0 +1,100 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// NeedParallelWorkers returns true if the parallel DataMigrate of the runtime type runs its tasks in the workers
+// connected by ssh. Alluxio distributes the copy tasks to its own job workers, so it doesn't need the workers.
+func NeedParallelWorkers(runtimeType string) bool {
+	return runtimeType != common.AlluxioRuntime
+}
+
+// SetParallelMigrateOptions parses the ssh options of the parallel workers from the DataMigrate's ParallelOptions.
+func SetParallelMigrateOptions(dataMigrateInfo *DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) error {
+	var err error
+	dataMigrateInfo.ParallelOptions = ParallelOptions{
+		SSHPort:                DefaultSSHPort,
+		SSHReadyTimeoutSeconds: DefaultSSHReadyTimeoutSeconds,
+		SSHSecretName:          dataMigrate.Spec.ParallelOptions[SSHSecretName],
+	}
+
+	sshPort, exist := dataMigrate.Spec.ParallelOptions[SSHPort]
+	if exist {
+		dataMigrateInfo.ParallelOptions.SSHPort, err = strconv.Atoi(sshPort)
+		if err != nil {
+			return errors.Wrap(err, "sshPort in the parallelOptions is not a int")
+		}
+	}
+
+	sshReadyTimeoutSeconds, exist := dataMigrate.Spec.ParallelOptions[SSHReadyTimeoutSeconds]
+	if exist {
+		dataMigrateInfo.ParallelOptions.SSHReadyTimeoutSeconds, err = strconv.Atoi(sshReadyTimeoutSeconds)
+		if err != nil {
+			return errors.Wrap(err, "sshReadyTimeoutSeconds in the parallelOptions is not a int")
+		}
+	}
+	return nil
+}
+
+// AddWorkerPodPreferredAntiAffinity makes the launcher prefer to run on different host with the parallel workers.
+func AddWorkerPodPreferredAntiAffinity(dataMigrateInfo *DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) {
+	releaseName := utils.GetDataMigrateReleaseName(dataMigrate.Name)
+
+	podAffinityTerm := corev1.WeightedPodAffinityTerm{
+		Weight: 100,
+		PodAffinityTerm: corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", dataMigrate.Namespace, releaseName),
+				},
+			},
+			TopologyKey: common.K8sNodeNameLabelKey,
+		},
+	}
+
+	// Affinity is nil
+	if dataMigrateInfo.Affinity == nil {
+		dataMigrateInfo.Affinity = &corev1.Affinity{
+			PodAntiAffinity: &corev1.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+					podAffinityTerm,
+				},
+			},
+		}
+		return
+	}
+	// Affinity not nil, PodAntiAffinity is nil
+	if dataMigrateInfo.Affinity.PodAntiAffinity == nil {
+		dataMigrateInfo.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
+	}
+
+	dataMigrateInfo.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution =
+		append(dataMigrateInfo.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, podAffinityTerm)
+}
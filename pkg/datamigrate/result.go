@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	"context"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// migrateResultKeys maps the keys reported by the migrate job in the termination message to the keys in OperationStatus.Infos
+var migrateResultKeys = map[string]string{
+	"copiedFiles": CopiedFiles,
+	"copiedBytes": CopiedBytes,
+}
+
+// ParseMigrateResult parses the result reported by the migrate job in the termination message, which consists of
+// lines in the format of key=value, e.g.
+//
+//	copiedFiles=100
+//	copiedBytes=1048576
+//
+// Unknown keys and malformed lines are ignored.
+func ParseMigrateResult(message string) map[string]string {
+	result := map[string]string{}
+	for _, line := range strings.Split(message, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found {
+			continue
+		}
+		if infoKey, ok := migrateResultKeys[strings.TrimSpace(key)]; ok {
+			result[infoKey] = strings.TrimSpace(value)
+		}
+	}
+	return result
+}
+
+// GetMigrateResultOfJob gets the result reported by the succeeded pod of the DataMigrate job, it returns an empty map
+// if there is no succeeded pod or nothing is reported.
+func GetMigrateResultOfJob(ctx context.Context, c client.Client, job *batchv1.Job) (map[string]string, error) {
+	pod, err := kubeclient.GetSucceedPodForJobWithContext(ctx, c, job)
+	if err != nil {
+		return nil, err
+	}
+	if pod == nil {
+		return map[string]string{}, nil
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && len(status.State.Terminated.Message) > 0 {
+			return ParseMigrateResult(status.State.Terminated.Message), nil
+		}
+	}
+	return map[string]string{}, nil
+}
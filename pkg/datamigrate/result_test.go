@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestParseMigrateResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    map[string]string
+	}{
+		{name: "empty", message: "", want: map[string]string{}},
+		{
+			name:    "all keys",
+			message: "copiedFiles=10\ncopiedBytes=1024\n",
+			want:    map[string]string{CopiedFiles: "10", CopiedBytes: "1024"},
+		},
+		{
+			name:    "unknown keys and malformed lines",
+			message: "copiedFiles = 3\nfoo=bar\nnot a result",
+			want:    map[string]string{CopiedFiles: "3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseMigrateResult(tt.message); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMigrateResult() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMigrateResultOfJob(t *testing.T) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "default"},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": "test-job"}},
+		},
+	}
+	newPod := func(name string, phase corev1.PodPhase, message string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"job-name": "test-job"}},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}}},
+				},
+			},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+
+	tests := []struct {
+		name string
+		objs []runtime.Object
+		want map[string]string
+	}{
+		{name: "no succeeded pod", objs: []runtime.Object{newPod("failed", corev1.PodFailed, "copiedFiles=1")}, want: map[string]string{}},
+		{name: "nothing reported", objs: []runtime.Object{newPod("succeeded", corev1.PodSucceeded, "")}, want: map[string]string{}},
+		{
+			name: "reported by succeeded pod",
+			objs: []runtime.Object{newPod("succeeded", corev1.PodSucceeded, "copiedFiles=5\ncopiedBytes=2048")},
+			want: map[string]string{CopiedFiles: "5", CopiedBytes: "2048"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(scheme, tt.objs...)
+			got, err := GetMigrateResultOfJob(context.TODO(), c, job)
+			if err != nil {
+				t.Fatalf("GetMigrateResultOfJob() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetMigrateResultOfJob() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -37,6 +37,9 @@ type DataMigrateInfo struct {
 	// Schedule The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.
 	Schedule string `json:"schedule,omitempty"`
 
+	// CronJobPolicy specifies the policies of the CronJob, only set when policy is cron
+	common.CronJobPolicy
+
 	// BackoffLimit specifies the upper limit times when the DataMigrate job fails
 	BackoffLimit int32 `json:"backoffLimit,omitempty"`
 
@@ -93,6 +96,21 @@ type DataMigrateInfo struct {
 
 	// ParallelOptions used when Parallelism is greater than 1.
 	ParallelOptions ParallelOptions `json:"parallelOptions,omitempty"`
+
+	// Envs for DataMigrate job, for cache engine.
+	Envs []corev1.EnvVar `json:"envs,omitempty"`
+
+	// Command for DataMigrate job container, for cache engine.
+	Command []string `json:"command,omitempty"`
+
+	// Args for DataMigrate job container, for cache engine.
+	Args []string `json:"args,omitempty"`
+
+	// VolumeMounts for DataMigrate job container, for cache engine.
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// Volumes for DataMigrate job pod, for cache engine.
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
 }
 
 type ParallelOptions struct {
@@ -75,6 +75,12 @@ type OperationInterface interface {
 
 	// GetParallelTaskNumber get the parallel tasks for data operations.
 	GetParallelTaskNumber() int32
+
+	// GetRetryPolicy gets the backoff limit, retry backoff and active deadline of the data operation.
+	GetRetryPolicy() RetryPolicy
+
+	// IsSuspended checks if the data operation is suspended from starting executing.
+	IsSuspended() bool
 }
 
 type StatusHandler interface {
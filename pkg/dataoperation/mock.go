@@ -78,6 +78,16 @@ func (r *mockDataloadOperationReconciler) GetParallelTaskNumber() int32 {
 	return 1
 }
 
+// GetRetryPolicy implements OperationInterface.
+func (r *mockDataloadOperationReconciler) GetRetryPolicy() RetryPolicy {
+	return RetryPolicy{}
+}
+
+// IsSuspended implements OperationInterface.
+func (r *mockDataloadOperationReconciler) IsSuspended() bool {
+	return false
+}
+
 // GetTargetDataset implements OperationInterface.
 func (m mockDataloadOperationReconciler) GetTargetDataset() (*datav1alpha1.Dataset, error) {
 	panic("unimplemented")
@@ -148,6 +148,34 @@ func (mr *MockOperationInterfaceMockRecorder) GetParallelTaskNumber() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParallelTaskNumber", reflect.TypeOf((*MockOperationInterface)(nil).GetParallelTaskNumber))
 }
 
+// GetRetryPolicy mocks base method.
+func (m *MockOperationInterface) GetRetryPolicy() dataoperation.RetryPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRetryPolicy")
+	ret0, _ := ret[0].(dataoperation.RetryPolicy)
+	return ret0
+}
+
+// GetRetryPolicy indicates an expected call of GetRetryPolicy.
+func (mr *MockOperationInterfaceMockRecorder) GetRetryPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetryPolicy", reflect.TypeOf((*MockOperationInterface)(nil).GetRetryPolicy))
+}
+
+// IsSuspended mocks base method.
+func (m *MockOperationInterface) IsSuspended() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSuspended")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsSuspended indicates an expected call of IsSuspended.
+func (mr *MockOperationInterfaceMockRecorder) IsSuspended() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSuspended", reflect.TypeOf((*MockOperationInterface)(nil).IsSuspended))
+}
+
 // GetPossibleTargetDatasetNamespacedNames mocks base method.
 func (m *MockOperationInterface) GetPossibleTargetDatasetNamespacedNames() []types.NamespacedName {
 	m.ctrl.T.Helper()
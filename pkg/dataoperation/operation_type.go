@@ -23,4 +23,11 @@ const (
 	DataBackupType  OperationType = "DataBackup"
 	DataMigrateType OperationType = "DataMigrate"
 	DataProcessType OperationType = "DataProcess"
+	DataEvictType   OperationType = "DataEvict"
 )
+
+// IsExecutedByEngine returns true if the data operation is executed by the runtime engine directly
+// in the runtime pods instead of installing a helm chart.
+func IsExecutedByEngine(operationType OperationType) bool {
+	return operationType == DataEvictType
+}
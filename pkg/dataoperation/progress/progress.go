@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const (
+	// FilePath is the well-known file that the data operation pod reports its progress to.
+	// The file consists of lines in the format of key=value, e.g.
+	//   processedFiles=10
+	//   totalFiles=100
+	//   processedBytes=1048576
+	//   totalBytes=10485760
+	// Keys are optional, and unknown keys are ignored.
+	FilePath = "/tmp/fluid-operation-progress"
+
+	keyProcessedFiles = "processedFiles"
+	keyTotalFiles     = "totalFiles"
+	keyProcessedBytes = "processedBytes"
+	keyTotalBytes     = "totalBytes"
+
+	readTimeout = 10 * time.Second
+)
+
+var execCommandInContainer = kubeclient.ExecCommandInContainerWithTimeoutContext
+
+// RawProgress is the raw progress reported by the data operation pod.
+type RawProgress struct {
+	ProcessedFiles int64
+	TotalFiles     int64
+	ProcessedBytes int64
+	TotalBytes     int64
+}
+
+// ParseRawProgress parses the content of the progress file.
+func ParseRawProgress(content string) (*RawProgress, error) {
+	progress := &RawProgress{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid progress line %q, expected key=value", line)
+		}
+
+		var field *int64
+		switch strings.TrimSpace(key) {
+		case keyProcessedFiles:
+			field = &progress.ProcessedFiles
+		case keyTotalFiles:
+			field = &progress.TotalFiles
+		case keyProcessedBytes:
+			field = &progress.ProcessedBytes
+		case keyTotalBytes:
+			field = &progress.TotalBytes
+		default:
+			continue
+		}
+
+		number, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value of %s in progress", key)
+		}
+		*field = number
+	}
+	return progress, nil
+}
+
+// ToOperationProgress converts the raw progress to OperationProgress, the throughput and ETA are calculated
+// with the bytes processed since startTime.
+func (r *RawProgress) ToOperationProgress(startTime, now time.Time) *datav1alpha1.OperationProgress {
+	result := &datav1alpha1.OperationProgress{
+		ProcessedFiles: r.ProcessedFiles,
+		TotalFiles:     r.TotalFiles,
+		ProcessedBytes: r.ProcessedBytes,
+		TotalBytes:     r.TotalBytes,
+		LastUpdateTime: &metav1.Time{Time: now},
+	}
+
+	processed, total := r.ProcessedBytes, r.TotalBytes
+	if total <= 0 {
+		processed, total = r.ProcessedFiles, r.TotalFiles
+	}
+	if total > 0 {
+		result.Percentage = fmt.Sprintf("%.1f%%", float64(min(processed, total))/float64(total)*100.0)
+	}
+
+	elapsed := now.Sub(startTime).Seconds()
+	if elapsed <= 0 || r.ProcessedBytes <= 0 {
+		return result
+	}
+	bytesPerSecond := float64(r.ProcessedBytes) / elapsed
+	result.Throughput = utils.BytesSize(bytesPerSecond) + "/s"
+	if r.TotalBytes > 0 {
+		remaining := float64(max(r.TotalBytes-r.ProcessedBytes, 0)) / bytesPerSecond
+		result.ETA = (time.Duration(remaining * float64(time.Second))).Round(time.Second).String()
+	}
+	return result
+}
+
+// Complete marks the progress as finished when the operation succeeds.
+func Complete(progress *datav1alpha1.OperationProgress) *datav1alpha1.OperationProgress {
+	if progress == nil {
+		return nil
+	}
+	result := progress.DeepCopy()
+	if result.TotalFiles > 0 {
+		result.ProcessedFiles = result.TotalFiles
+	}
+	if result.TotalBytes > 0 {
+		result.ProcessedBytes = result.TotalBytes
+	}
+	result.Percentage = "100.0%"
+	result.ETA = ""
+	return result
+}
+
+// GetJobProgress reads the progress file in the running pod of the job. It returns nil with no error
+// if the pod is not running or the progress has not been reported yet.
+func GetJobProgress(ctx context.Context, c client.Client, job *batchv1.Job) (*datav1alpha1.OperationProgress, error) {
+	pod, err := kubeclient.GetRunningPodForJobWithContext(ctx, c, job)
+	if err != nil {
+		return nil, err
+	}
+	if pod == nil || len(pod.Spec.Containers) == 0 {
+		return nil, nil
+	}
+
+	containerName := pod.Spec.Containers[0].Name
+	stdout, stderr, err := execCommandInContainer(ctx, pod.Name, containerName, pod.Namespace,
+		[]string{"sh", "-c", fmt.Sprintf("test ! -f %s || cat %s", FilePath, FilePath)}, readTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read progress in pod %s/%s, stderr: %s", pod.Namespace, pod.Name, stderr)
+	}
+	if len(strings.TrimSpace(stdout)) == 0 {
+		return nil, nil
+	}
+
+	raw, err := ParseRawProgress(stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := job.CreationTimestamp.Time
+	if pod.Status.StartTime != nil {
+		startTime = pod.Status.StartTime.Time
+	}
+	return raw.ToOperationProgress(startTime, time.Now()), nil
+}
+
+// UpdateJobProgress sets the progress reported by the running pod of the job into the operation status.
+// Failing to read the progress doesn't block the operation, so the error is only logged and the last progress is kept.
+func UpdateJobProgress(ctx cruntime.ReconcileRequestContext, c client.Client, job *batchv1.Job, opStatus *datav1alpha1.OperationStatus) {
+	reqCtx := ctx.Context
+	if reqCtx == nil {
+		reqCtx = context.TODO()
+	}
+	progress, err := GetJobProgress(reqCtx, c, job)
+	if err != nil {
+		ctx.Log.V(1).Info("failed to get the progress of job, skip", "namespace", job.Namespace, "jobName", job.Name, "err", err.Error())
+		return
+	}
+	if progress != nil {
+		opStatus.Progress = progress
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("ParseRawProgress", func() {
+	It("should parse all the known keys", func() {
+		raw, err := ParseRawProgress("processedFiles=10\ntotalFiles=100\n processedBytes = 1024 \ntotalBytes=4096\nunknown=abc\n\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*raw).To(Equal(RawProgress{ProcessedFiles: 10, TotalFiles: 100, ProcessedBytes: 1024, TotalBytes: 4096}))
+	})
+
+	It("should return error if the line is not key=value", func() {
+		_, err := ParseRawProgress("processedFiles")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return error if the value is not a number", func() {
+		_, err := ParseRawProgress("totalBytes=1GiB")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("RawProgress.ToOperationProgress", func() {
+	startTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := startTime.Add(10 * time.Second)
+
+	It("should calculate the percentage, throughput and eta by bytes", func() {
+		raw := &RawProgress{ProcessedFiles: 1, TotalFiles: 4, ProcessedBytes: 10 * 1024 * 1024, TotalBytes: 40 * 1024 * 1024}
+		progress := raw.ToOperationProgress(startTime, now)
+		Expect(progress.Percentage).To(Equal("25.0%"))
+		Expect(progress.Throughput).To(Equal("1.00MiB/s"))
+		Expect(progress.ETA).To(Equal("30s"))
+		Expect(progress.LastUpdateTime.Time).To(Equal(now))
+	})
+
+	It("should calculate the percentage by files if the total bytes is unknown", func() {
+		raw := &RawProgress{ProcessedFiles: 1, TotalFiles: 8}
+		progress := raw.ToOperationProgress(startTime, now)
+		Expect(progress.Percentage).To(Equal("12.5%"))
+		Expect(progress.Throughput).To(BeEmpty())
+		Expect(progress.ETA).To(BeEmpty())
+	})
+
+	It("should not exceed 100 percent", func() {
+		raw := &RawProgress{ProcessedBytes: 2048, TotalBytes: 1024}
+		progress := raw.ToOperationProgress(startTime, now)
+		Expect(progress.Percentage).To(Equal("100.0%"))
+		Expect(progress.ETA).To(Equal("0s"))
+	})
+})
+
+var _ = Describe("Complete", func() {
+	It("should return nil if there is no progress", func() {
+		Expect(Complete(nil)).To(BeNil())
+	})
+
+	It("should mark all the files and bytes processed", func() {
+		progress := Complete(&datav1alpha1.OperationProgress{
+			ProcessedFiles: 1, TotalFiles: 4, ProcessedBytes: 1024, TotalBytes: 4096, Percentage: "25.0%", ETA: "30s",
+		})
+		Expect(progress.ProcessedFiles).To(Equal(int64(4)))
+		Expect(progress.ProcessedBytes).To(Equal(int64(4096)))
+		Expect(progress.Percentage).To(Equal("100.0%"))
+		Expect(progress.ETA).To(BeEmpty())
+	})
+})
+
+var _ = Describe("UpdateJobProgress", func() {
+	var (
+		job        *batchv1.Job
+		pod        *corev1.Pod
+		opStatus   *datav1alpha1.OperationStatus
+		ctx        cruntime.ReconcileRequestContext
+		testScheme *runtime.Scheme
+		original   = execCommandInContainer
+	)
+
+	BeforeEach(func() {
+		job = &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-loader-job", Namespace: "default"},
+			Spec: batchv1.JobSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": "test-loader-job"}},
+			},
+		}
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-loader-job-abcde",
+				Namespace: "default",
+				Labels:    map[string]string{"job-name": "test-loader-job"},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "dataloader"}}},
+			Status: corev1.PodStatus{
+				Phase:     corev1.PodRunning,
+				StartTime: &metav1.Time{Time: time.Now().Add(-10 * time.Second)},
+			},
+		}
+		opStatus = &datav1alpha1.OperationStatus{Progress: &datav1alpha1.OperationProgress{ProcessedBytes: 1}}
+		ctx = cruntime.ReconcileRequestContext{Log: fake.NullLogger()}
+
+		testScheme = runtime.NewScheme()
+		Expect(batchv1.AddToScheme(testScheme)).To(Succeed())
+		Expect(corev1.AddToScheme(testScheme)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		execCommandInContainer = original
+	})
+
+	It("should update the progress reported by the running pod", func() {
+		c := fake.NewFakeClientWithScheme(testScheme, job, pod)
+		execCommandInContainer = func(_ context.Context, podName, containerName, namespace string, cmd []string, _ time.Duration) (string, string, error) {
+			Expect(podName).To(Equal(pod.Name))
+			Expect(containerName).To(Equal("dataloader"))
+			return "processedBytes=1024\ntotalBytes=4096", "", nil
+		}
+
+		UpdateJobProgress(ctx, c, job, opStatus)
+		Expect(opStatus.Progress.ProcessedBytes).To(Equal(int64(1024)))
+		Expect(opStatus.Progress.Percentage).To(Equal("25.0%"))
+		Expect(opStatus.Progress.Throughput).NotTo(BeEmpty())
+	})
+
+	It("should keep the last progress if no progress is reported", func() {
+		c := fake.NewFakeClientWithScheme(testScheme, job, pod)
+		execCommandInContainer = func(_ context.Context, _, _, _ string, _ []string, _ time.Duration) (string, string, error) {
+			return "", "", nil
+		}
+
+		UpdateJobProgress(ctx, c, job, opStatus)
+		Expect(opStatus.Progress.ProcessedBytes).To(Equal(int64(1)))
+	})
+
+	It("should keep the last progress if it fails to read the progress", func() {
+		c := fake.NewFakeClientWithScheme(testScheme, job, pod)
+		execCommandInContainer = func(_ context.Context, _, _, _ string, _ []string, _ time.Duration) (string, string, error) {
+			return "", "exec failed", errors.New("exec error")
+		}
+
+		UpdateJobProgress(ctx, c, job, opStatus)
+		Expect(opStatus.Progress.ProcessedBytes).To(Equal(int64(1)))
+	})
+
+	It("should not read the progress if the pod is not running", func() {
+		pod.Status.Phase = corev1.PodPending
+		c := fake.NewFakeClientWithScheme(testScheme, job, pod)
+		execCommandInContainer = func(_ context.Context, _, _, _ string, _ []string, _ time.Duration) (string, string, error) {
+			Fail("should not exec in the pod which is not running")
+			return "", "", nil
+		}
+
+		UpdateJobProgress(ctx, c, job, opStatus)
+		Expect(opStatus.Progress.ProcessedBytes).To(Equal(int64(1)))
+	})
+})
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataoperation
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+const (
+	// DefaultRetryBackoff is the delay before the first retry if RetryBackoff is not set
+	DefaultRetryBackoff = 10 * time.Second
+	// MaxRetryBackoff is the upper limit of the delay before a retry
+	MaxRetryBackoff = 10 * time.Minute
+)
+
+// RetryPolicy defines how a failed data operation is retried and how long it can be executing
+type RetryPolicy struct {
+	// BackoffLimit is the number of retries before the data operation is marked as failed
+	BackoffLimit int32
+	// RetryBackoff is the delay before the first retry, which is doubled for each retry
+	RetryBackoff time.Duration
+	// ActiveDeadline is the duration the data operation can be executing, retries included. No limit if it's 0.
+	ActiveDeadline time.Duration
+}
+
+// NewRetryPolicy builds the RetryPolicy from the spec of the data operation. The cron data operations are retried
+// and terminated by the next scheduled run, so an empty RetryPolicy is returned for them.
+func NewRetryPolicy(policy datav1alpha1.Policy, backoffLimit *int32, retryBackoff *metav1.Duration, activeDeadlineSeconds *int64) RetryPolicy {
+	if policy == datav1alpha1.Cron {
+		return RetryPolicy{}
+	}
+
+	retryPolicy := RetryPolicy{RetryBackoff: DefaultRetryBackoff}
+	if backoffLimit != nil && *backoffLimit > 0 {
+		retryPolicy.BackoffLimit = *backoffLimit
+	}
+	if retryBackoff != nil && retryBackoff.Duration > 0 {
+		retryPolicy.RetryBackoff = retryBackoff.Duration
+	}
+	if activeDeadlineSeconds != nil && *activeDeadlineSeconds > 0 {
+		retryPolicy.ActiveDeadline = time.Duration(*activeDeadlineSeconds) * time.Second
+	}
+	return retryPolicy
+}
+
+// GetRetryDelay returns the delay before the next retry when the data operation has been executed for attempts times.
+func (p RetryPolicy) GetRetryDelay(attempts int32) time.Duration {
+	delay := p.RetryBackoff
+	for i := int32(1); i < attempts && delay < MaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxRetryBackoff)
+}
+
+// CanRetry checks if the failed data operation can be retried after it has been executed for attempts times.
+func (p RetryPolicy) CanRetry(attempts int32) bool {
+	return attempts > 0 && attempts <= p.BackoffLimit
+}
+
+// GetRemainingTime returns the remaining time before the active deadline of the data operation started at startTime,
+// and false if there is no deadline.
+func (p RetryPolicy) GetRemainingTime(startTime *metav1.Time, now time.Time) (time.Duration, bool) {
+	if p.ActiveDeadline <= 0 || startTime == nil {
+		return 0, false
+	}
+	return startTime.Add(p.ActiveDeadline).Sub(now), true
+}
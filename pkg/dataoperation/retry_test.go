@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataoperation
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+var _ = Describe("RetryPolicy", func() {
+	Describe("NewRetryPolicy", func() {
+		It("should use the default retry backoff and no retry if not set", func() {
+			policy := NewRetryPolicy(datav1alpha1.Once, nil, nil, nil)
+
+			Expect(policy).To(Equal(RetryPolicy{RetryBackoff: DefaultRetryBackoff}))
+		})
+
+		It("should build the policy from the spec", func() {
+			policy := NewRetryPolicy(datav1alpha1.Once, ptr.To[int32](3), &metav1.Duration{Duration: time.Minute}, ptr.To[int64](600))
+
+			Expect(policy.BackoffLimit).To(Equal(int32(3)))
+			Expect(policy.RetryBackoff).To(Equal(time.Minute))
+			Expect(policy.ActiveDeadline).To(Equal(10 * time.Minute))
+		})
+
+		It("should return an empty policy for cron data operations", func() {
+			policy := NewRetryPolicy(datav1alpha1.Cron, ptr.To[int32](3), nil, ptr.To[int64](600))
+
+			Expect(policy).To(Equal(RetryPolicy{}))
+		})
+	})
+
+	Describe("GetRetryDelay", func() {
+		It("should double the delay for each retry and cap it", func() {
+			policy := RetryPolicy{BackoffLimit: 10, RetryBackoff: time.Minute}
+
+			Expect(policy.GetRetryDelay(1)).To(Equal(time.Minute))
+			Expect(policy.GetRetryDelay(2)).To(Equal(2 * time.Minute))
+			Expect(policy.GetRetryDelay(3)).To(Equal(4 * time.Minute))
+			Expect(policy.GetRetryDelay(5)).To(Equal(MaxRetryBackoff))
+		})
+	})
+
+	Describe("CanRetry", func() {
+		It("should retry until the backoff limit is reached", func() {
+			policy := RetryPolicy{BackoffLimit: 2}
+
+			Expect(policy.CanRetry(0)).To(BeFalse())
+			Expect(policy.CanRetry(1)).To(BeTrue())
+			Expect(policy.CanRetry(2)).To(BeTrue())
+			Expect(policy.CanRetry(3)).To(BeFalse())
+		})
+	})
+
+	Describe("GetRemainingTime", func() {
+		It("should return the time before the active deadline", func() {
+			now := time.Now()
+			policy := RetryPolicy{ActiveDeadline: time.Minute}
+
+			remaining, hasDeadline := policy.GetRemainingTime(&metav1.Time{Time: now.Add(-40 * time.Second)}, now)
+			Expect(hasDeadline).To(BeTrue())
+			Expect(remaining).To(Equal(20 * time.Second))
+
+			_, hasDeadline = policy.GetRemainingTime(nil, now)
+			Expect(hasDeadline).To(BeFalse())
+
+			_, hasDeadline = RetryPolicy{}.GetRemainingTime(&metav1.Time{Time: now}, now)
+			Expect(hasDeadline).To(BeFalse())
+		})
+	})
+})
@@ -59,4 +59,10 @@ const (
 
 	MountConfigStorage   = "ALLUXIO_MOUNT_CONFIG_STORAGE"
 	ConfigmapStorageName = "configmap"
+
+	// DefaultDataMigrateTimeout is the default timeout of the distributed copy in the DataMigrate job
+	DefaultDataMigrateTimeout = "30m"
+
+	// dataMigrateMountRoot is the directory in Alluxio namespace where the external storage of DataMigrate is mounted
+	dataMigrateMountRoot = "/.fluid-datamigrate"
 )
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+// alluxioPropertyKeyRegexp matches the keys of Alluxio properties, the encrypt options of the external storage
+// are passed as mount options so their names must be property keys.
+var alluxioPropertyKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// generateDataMigrateValueFile builds a DataMigrateValue by extracted specifications from the given DataMigrate, and
+// marshals the DataMigrateValue to a temporary yaml file where stores values that'll be used by fluid datamigrate helm chart
+func (e *AlluxioEngine) generateDataMigrateValueFile(r cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataMigrate, ok := object.(*datav1alpha1.DataMigrate)
+	if !ok {
+		return "", fmt.Errorf("object %v is not a DataMigrate", object)
+	}
+
+	targetDataset, err := utils.GetTargetDatasetOfMigrate(r.Client, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+	e.Log.Info("target dataset", "dataset", targetDataset)
+
+	imageName, imageTag := dataMigrate.Spec.Image, dataMigrate.Spec.ImageTag
+	if len(imageName) == 0 || len(imageTag) == 0 {
+		imageName, imageTag = e.getDataMigrateImage(r.Client, targetDataset, imageName, imageTag)
+	}
+	image := fmt.Sprintf("%s:%s", imageName, imageTag)
+
+	dataMigrateValue, err := e.genDataMigrateValue(image, targetDataset, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataMigrateValue)
+	if err != nil {
+		return
+	}
+	e.Log.Info("dataMigrate value", "value", string(data))
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-migrate-values.yaml", dataMigrate.Namespace, dataMigrate.Name))
+	if err != nil {
+		return
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return
+	}
+	return valueFile.Name(), nil
+}
+
+// getDataMigrateImage uses the image of the Alluxio workers by default, as the DataMigrate job runs the Alluxio shell.
+func (e *AlluxioEngine) getDataMigrateImage(c client.Client, targetDataset *datav1alpha1.Dataset, imageName, imageTag string) (string, string) {
+	workerImageName, workerImageTag := docker.GetWorkerImage(c, targetDataset.Name, common.AlluxioRuntime, targetDataset.Namespace)
+	defaultImageInfo := strings.Split(common.DefaultAlluxioRuntimeImage, ":")
+
+	if len(imageName) == 0 {
+		imageName = workerImageName
+	}
+	if len(imageName) == 0 {
+		imageName = docker.GetImageRepoFromEnv(common.AlluxioRuntimeImageEnv)
+	}
+	if len(imageName) == 0 {
+		imageName = defaultImageInfo[0]
+	}
+
+	if len(imageTag) == 0 {
+		imageTag = workerImageTag
+	}
+	if len(imageTag) == 0 {
+		imageTag = docker.GetImageTagFromEnv(common.AlluxioRuntimeImageEnv)
+	}
+	if len(imageTag) == 0 && len(defaultImageInfo) > 1 {
+		imageTag = defaultImageInfo[1]
+	}
+	return imageName, imageTag
+}
+
+// genDataMigrateValue builds a DataMigrateValue for the Fluid datamigrate Helm chart. The data is copied by
+// `alluxio fs distributedCp` in Alluxio namespace, so the external storage is mounted to a temporary path
+// in Alluxio namespace during the migration.
+func (e *AlluxioEngine) genDataMigrateValue(image string, targetDataset *datav1alpha1.Dataset, dataMigrate *datav1alpha1.DataMigrate) (*cdatamigrate.DataMigrateValue, error) {
+	imagePullSecrets := docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey)
+
+	dataMigrateInfo := cdatamigrate.DataMigrateInfo{
+		BackoffLimit:     3,
+		TargetDataset:    targetDataset.Name,
+		EncryptOptions:   []datav1alpha1.EncryptOption{},
+		Image:            image,
+		Options:          map[string]string{},
+		Labels:           dataMigrate.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataMigrate.Annotations, dataMigrate.Spec.PodMetadata.Annotations),
+		ImagePullSecrets: imagePullSecrets,
+		Policy:           string(dataMigrate.Spec.Policy),
+		Schedule:         dataMigrate.Spec.Schedule,
+		CronJobPolicy:    cdatamigrate.GenCronJobPolicy(dataMigrate),
+		Resources:        dataMigrate.Spec.Resources,
+		Parallelism:      dataMigrate.Spec.Parallelism,
+	}
+
+	if dataMigrate.Spec.Affinity != nil {
+		dataMigrateInfo.Affinity = dataMigrate.Spec.Affinity
+	}
+
+	// inject the node affinity by previous operation pod.
+	var err error
+	dataMigrateInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(e.Client, dataMigrate.Spec.RunAfter, dataMigrate.Namespace, dataMigrateInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	if dataMigrate.Spec.NodeSelector != nil {
+		dataMigrateInfo.NodeSelector = dataMigrate.Spec.NodeSelector
+	}
+
+	if len(dataMigrate.Spec.Tolerations) > 0 {
+		dataMigrateInfo.Tolerations = dataMigrate.Spec.Tolerations
+	}
+
+	if len(dataMigrate.Spec.SchedulerName) > 0 {
+		dataMigrateInfo.SchedulerName = dataMigrate.Spec.SchedulerName
+	}
+
+	// set the options of distributedCp, the parallelism limits the copy jobs running in the Alluxio job workers
+	options := map[string]string{}
+	for k, v := range dataMigrate.Spec.Options {
+		options[k] = v
+	}
+	timeout := options["timeout"]
+	delete(options, "timeout")
+	if timeout == "" {
+		timeout = DefaultDataMigrateTimeout
+	}
+	if _, found := options["active-jobs"]; !found && dataMigrate.Spec.Parallelism > 1 {
+		options["active-jobs"] = strconv.Itoa(int(dataMigrate.Spec.Parallelism))
+	}
+	dataMigrateInfo.Options["option"] = genDistributedCpOptions(options)
+	dataMigrateInfo.Options["timeout"] = timeout
+
+	// set from & to
+	mountPath := path.Join(dataMigrateMountRoot, utils.GetDataMigrateReleaseName(dataMigrate.Name))
+	migrateFrom, err := e.genDataMigratePath(dataMigrate.Spec.From, targetDataset, mountPath, &dataMigrateInfo)
+	if err != nil {
+		return nil, err
+	}
+	migrateTo, err := e.genDataMigratePath(dataMigrate.Spec.To, targetDataset, mountPath, &dataMigrateInfo)
+	if err != nil {
+		return nil, err
+	}
+	dataMigrateInfo.MigrateFrom = migrateFrom
+	dataMigrateInfo.MigrateTo = migrateTo
+
+	dataMigrateValue := &cdatamigrate.DataMigrateValue{
+		Name:            dataMigrate.Name,
+		DataMigrateInfo: dataMigrateInfo,
+		Owner:           transformer.GenerateOwnerReferenceFromObject(dataMigrate),
+		OwnerDatasetId:  utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+	}
+	return dataMigrateValue, nil
+}
+
+// genDataMigratePath returns the path in Alluxio namespace of the data to migrate. For the external storage,
+// the mount path, uri and options are set into the DataMigrateInfo, and the secrets of the encrypt options are
+// exposed to the job by the environment variables.
+func (e *AlluxioEngine) genDataMigratePath(data datav1alpha1.DataToMigrate, targetDataset *datav1alpha1.Dataset, mountPath string, info *cdatamigrate.DataMigrateInfo) (string, error) {
+	if data.DataSet != nil {
+		if data.DataSet.Name != targetDataset.Name || (len(data.DataSet.Namespace) > 0 && data.DataSet.Namespace != targetDataset.Namespace) {
+			return "", fmt.Errorf("alluxio can only migrate data between the dataset %s/%s and the external storage, but got dataset %s/%s",
+				targetDataset.Namespace, targetDataset.Name, data.DataSet.Namespace, data.DataSet.Name)
+		}
+		return path.Join("/", data.DataSet.Path), nil
+	}
+
+	if data.ExternalStorage == nil {
+		return "", fmt.Errorf("either dataset or external storage should be set in the data to migrate")
+	}
+	if common.IsFluidNativeScheme(data.ExternalStorage.URI) {
+		return "", fmt.Errorf("alluxio doesn't support migrating data with the external storage %s", data.ExternalStorage.URI)
+	}
+	if len(info.Options["mountUri"]) > 0 {
+		return "", fmt.Errorf("alluxio doesn't support migrating data between two external storages")
+	}
+
+	mountOptions := []string{}
+	for i, encryptOption := range data.ExternalStorage.EncryptOptions {
+		if !alluxioPropertyKeyRegexp.MatchString(encryptOption.Name) {
+			return "", fmt.Errorf("the name of encrypt option %q is not a valid alluxio property key", encryptOption.Name)
+		}
+		envName := fmt.Sprintf("EXTERNAL_ENCRYPT_OPTION_%d", i)
+		info.EncryptOptions = append(info.EncryptOptions, datav1alpha1.EncryptOption{
+			Name:      envName,
+			ValueFrom: encryptOption.ValueFrom,
+		})
+		mountOptions = append(mountOptions, fmt.Sprintf("--option %s=\"${%s}\"", encryptOption.Name, envName))
+	}
+	info.Options["mountPath"] = mountPath
+	info.Options["mountUri"] = data.ExternalStorage.URI
+	info.Options["mountOptions"] = strings.Join(mountOptions, " ")
+	return mountPath, nil
+}
+
+// genDistributedCpOptions converts the options to the flags of `alluxio fs distributedCp` in a stable order.
+func genDistributedCpOptions(options map[string]string) string {
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	flags := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if v := options[k]; v != "" {
+			flags = append(flags, fmt.Sprintf("--%s %s", k, v))
+		} else {
+			flags = append(flags, fmt.Sprintf("--%s", k))
+		}
+	}
+	return strings.Join(flags, " ")
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestGenerateDataMigrateValueFile(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dataset", Namespace: "fluid", UID: "test-uid"},
+	}
+	secretRef := datav1alpha1.EncryptOptionSource{
+		SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "oss-secret", Key: "ak"},
+	}
+
+	tests := []struct {
+		name            string
+		spec            datav1alpha1.DataMigrateSpec
+		wantErr         bool
+		wantFrom        string
+		wantTo          string
+		wantOptions     map[string]string
+		wantEncryptOpts []datav1alpha1.EncryptOption
+	}{
+		{
+			name: "migrate from dataset to external storage",
+			spec: datav1alpha1.DataMigrateSpec{
+				From: datav1alpha1.DataToMigrate{DataSet: &datav1alpha1.DatasetToMigrate{Name: "test-dataset", Namespace: "fluid", Path: "/result"}},
+				To: datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{
+					URI:            "oss://bucket/result",
+					EncryptOptions: []datav1alpha1.EncryptOption{{Name: "fs.oss.accessKeyId", ValueFrom: secretRef}},
+				}},
+				Parallelism: 4,
+				Options:     map[string]string{"batch-size": "10", "timeout": "1h"},
+			},
+			wantFrom: "/result",
+			wantTo:   "/.fluid-datamigrate/test-migrate-migrate",
+			wantOptions: map[string]string{
+				"option":       "--active-jobs 4 --batch-size 10",
+				"timeout":      "1h",
+				"mountPath":    "/.fluid-datamigrate/test-migrate-migrate",
+				"mountUri":     "oss://bucket/result",
+				"mountOptions": `--option fs.oss.accessKeyId="${EXTERNAL_ENCRYPT_OPTION_0}"`,
+			},
+			wantEncryptOpts: []datav1alpha1.EncryptOption{{Name: "EXTERNAL_ENCRYPT_OPTION_0", ValueFrom: secretRef}},
+		},
+		{
+			name: "migrate from external storage to dataset",
+			spec: datav1alpha1.DataMigrateSpec{
+				From:        datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "s3://bucket/data"}},
+				To:          datav1alpha1.DataToMigrate{DataSet: &datav1alpha1.DatasetToMigrate{Name: "test-dataset"}},
+				Parallelism: 1,
+			},
+			wantFrom: "/.fluid-datamigrate/test-migrate-migrate",
+			wantTo:   "/",
+			wantOptions: map[string]string{
+				"option":       "",
+				"timeout":      DefaultDataMigrateTimeout,
+				"mountPath":    "/.fluid-datamigrate/test-migrate-migrate",
+				"mountUri":     "s3://bucket/data",
+				"mountOptions": "",
+			},
+		},
+		{
+			name: "invalid encrypt option name",
+			spec: datav1alpha1.DataMigrateSpec{
+				From: datav1alpha1.DataToMigrate{DataSet: &datav1alpha1.DatasetToMigrate{Name: "test-dataset"}},
+				To: datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{
+					URI:            "oss://bucket/result",
+					EncryptOptions: []datav1alpha1.EncryptOption{{Name: "key; rm -rf /", ValueFrom: secretRef}},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fluid native external storage",
+			spec: datav1alpha1.DataMigrateSpec{
+				From: datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "pvc://my-pvc/data"}},
+				To:   datav1alpha1.DataToMigrate{DataSet: &datav1alpha1.DatasetToMigrate{Name: "test-dataset"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewFakeClientWithScheme(testScheme, []runtime.Object{dataset.DeepCopy()}...)
+			engine := AlluxioEngine{
+				name:      "test-dataset",
+				namespace: "fluid",
+				Client:    client,
+				Log:       fake.NullLogger(),
+			}
+			dataMigrate := &datav1alpha1.DataMigrate{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-migrate", Namespace: "fluid"},
+				Spec:       tt.spec,
+			}
+
+			valueFileName, err := engine.generateDataMigrateValueFile(cruntime.ReconcileRequestContext{Client: client}, dataMigrate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("generateDataMigrateValueFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			defer os.Remove(valueFileName)
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value cdatamigrate.DataMigrateValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+
+			info := value.DataMigrateInfo
+			if info.MigrateFrom != tt.wantFrom || info.MigrateTo != tt.wantTo {
+				t.Errorf("got migrate from %q to %q, want from %q to %q", info.MigrateFrom, info.MigrateTo, tt.wantFrom, tt.wantTo)
+			}
+			if !reflect.DeepEqual(info.Options, tt.wantOptions) {
+				t.Errorf("got options %v, want %v", info.Options, tt.wantOptions)
+			}
+			if !reflect.DeepEqual(info.EncryptOptions, tt.wantEncryptOpts) {
+				t.Errorf("got encrypt options %v, want %v", info.EncryptOptions, tt.wantEncryptOpts)
+			}
+			if info.TargetDataset != "test-dataset" || len(info.Image) == 0 {
+				t.Errorf("got target dataset %q and image %q", info.TargetDataset, info.Image)
+			}
+			if value.OwnerDatasetId != "fluid-test-dataset" {
+				t.Errorf("got owner dataset id %q", value.OwnerDatasetId)
+			}
+		})
+	}
+}
+
+func TestGenDistributedCpOptions(t *testing.T) {
+	got := genDistributedCpOptions(map[string]string{"overwrite": "", "batch-size": "5"})
+	if want := "--batch-size 5 --overwrite"; got != want {
+		t.Errorf("genDistributedCpOptions() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"fmt"
+	"strconv"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdataevict "github.com/fluid-cloudnative/fluid/pkg/dataevict"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// evictData frees the cache of the target paths with `alluxio fs free` in the master pod,
+// and returns the cached bytes of the target paths before freeing as the evicted bytes.
+func (e *AlluxioEngine) evictData(ctx cruntime.ReconcileRequestContext, object client.Object) (infos map[string]string, err error) {
+	dataEvict, ok := object.(*datav1alpha1.DataEvict)
+	if !ok {
+		return nil, fmt.Errorf("object %v is not of type DataEvict", object)
+	}
+
+	cleanCacheGracePeriodSeconds, err := e.getCleanCacheGracePeriodSeconds()
+	if err != nil {
+		return nil, err
+	}
+
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, ctx.Log)
+
+	var evictedBytes int64
+	for _, path := range cdataevict.GetTargetPaths(dataEvict) {
+		_, cached, _, err := fileUtils.Du(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the cached bytes of path %s", path)
+		}
+
+		ctx.Log.Info("evict the cache of path", "path", path, "cached", cached)
+		if err = fileUtils.CleanCache(path, cleanCacheGracePeriodSeconds); err != nil {
+			return nil, errors.Wrapf(err, "failed to evict the cache of path %s", path)
+		}
+		evictedBytes += cached
+	}
+
+	return map[string]string{
+		cdataevict.EvictedBytes: strconv.FormatInt(evictedBytes, 10),
+	}, nil
+}
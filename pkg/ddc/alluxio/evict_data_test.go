@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/agiledragon/gomonkey/v2"
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdataevict "github.com/fluid-cloudnative/fluid/pkg/dataevict"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AlluxioEngine evictData Tests", Label("pkg.ddc.alluxio.evict_data_test.go"), func() {
+	var (
+		dataset        *datav1alpha1.Dataset
+		alluxioruntime *datav1alpha1.AlluxioRuntime
+		engine         *AlluxioEngine
+		dataEvict      *datav1alpha1.DataEvict
+		ctx            cruntime.ReconcileRequestContext
+		freedPaths     []string
+		patches        *gomonkey.Patches
+	)
+
+	BeforeEach(func() {
+		dataset, alluxioruntime = mockFluidObjectsForTests(types.NamespacedName{Namespace: "fluid", Name: "hbase"})
+		engine = mockAlluxioEngineForTests(dataset, alluxioruntime)
+		engine.Client = fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, []runtime.Object{dataset, alluxioruntime}...)
+
+		dataEvict = &datav1alpha1.DataEvict{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-evict", Namespace: "fluid"},
+			Spec: datav1alpha1.DataEvictSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "hbase", Namespace: "fluid"},
+			},
+		}
+		ctx = cruntime.ReconcileRequestContext{Context: context.TODO(), Log: fake.NullLogger()}
+
+		freedPaths = []string{}
+		cachedBytes := map[string]int64{"/": 3072, "/a": 1024, "/b": 2048}
+		patches = gomonkey.ApplyMethodFunc(reflect.TypeOf(operations.AlluxioFileUtils{}), "Du", func(path string) (int64, int64, string, error) {
+			cached, ok := cachedBytes[path]
+			if !ok {
+				return 0, 0, "", fmt.Errorf("path %s not found", path)
+			}
+			return cached, cached, "100%", nil
+		})
+		patches.ApplyMethodFunc(reflect.TypeOf(operations.AlluxioFileUtils{}), "CleanCache", func(path string, timeout int32) error {
+			freedPaths = append(freedPaths, path)
+			return nil
+		})
+	})
+
+	AfterEach(func() {
+		patches.Reset()
+	})
+
+	It("should free the whole dataset if no target is set", func() {
+		infos, err := engine.evictData(ctx, dataEvict)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(freedPaths).To(Equal([]string{"/"}))
+		Expect(infos).To(HaveKeyWithValue(cdataevict.EvictedBytes, "3072"))
+	})
+
+	It("should free the target paths and sum up the evicted bytes", func() {
+		dataEvict.Spec.Target = []datav1alpha1.EvictTargetPath{{Path: "/a"}, {Path: "/b"}}
+		infos, err := engine.evictData(ctx, dataEvict)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(freedPaths).To(Equal([]string{"/a", "/b"}))
+		Expect(infos).To(HaveKeyWithValue(cdataevict.EvictedBytes, "3072"))
+	})
+
+	It("should return an error if failed to get the cached bytes of a path", func() {
+		dataEvict.Spec.Target = []datav1alpha1.EvictTargetPath{{Path: "/not-exist"}}
+		_, err := engine.evictData(ctx, dataEvict)
+		Expect(err).To(HaveOccurred())
+		Expect(freedPaths).To(BeEmpty())
+	})
+
+	It("should return an error if the object is not a DataEvict", func() {
+		_, err := engine.evictData(ctx, &datav1alpha1.DataLoad{})
+		Expect(err).To(HaveOccurred())
+	})
+})
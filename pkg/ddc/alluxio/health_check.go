@@ -47,7 +47,14 @@ func (e *AlluxioEngine) CheckRuntimeHealthy() (err error) {
 		return fmt.Errorf("the master \"%s\" is not healthy, expect at least one replica is ready", e.getMasterName())
 	}
 
-	// 2. Check the healthy of the workers
+	// 2. Restore the metadata of the master if its journal is lost
+	err = e.checkAndRestoreMasterMetadata()
+	if err != nil {
+		e.Log.Error(err, "failed  to check and restore the metadata of the master")
+		return
+	}
+
+	// 3. Check the healthy of the workers
 	workerReady, err := e.CheckWorkersReady()
 	if err != nil {
 		e.Log.Error(err, "failed  to check if workers are ready")
@@ -62,7 +69,7 @@ func (e *AlluxioEngine) CheckRuntimeHealthy() (err error) {
 		return fmt.Errorf("the worker \"%s\" is not healthy, expect at least one replica is ready", e.getWorkerName())
 	}
 
-	// 3. Check the healthy of the fuse
+	// 4. Check the healthy of the fuse
 	fuseReady, err := e.checkFuseHealthy()
 	if err != nil {
 		e.Log.Error(err, "failed  to check fuse is healthy")
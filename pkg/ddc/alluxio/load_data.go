@@ -119,6 +119,7 @@ func (e *AlluxioEngine) genDataLoadValue(image string, targetDataset *datav1alph
 		ImagePullSecrets: imagePullSecrets,
 		Policy:           string(dataload.Spec.Policy),
 		Schedule:         dataload.Spec.Schedule,
+		CronJobPolicy:    cdataload.GenCronJobPolicy(dataload),
 		Resources:        dataload.Spec.Resources,
 	}
 
@@ -165,6 +166,7 @@ func (e *AlluxioEngine) genDataLoadValue(image string, targetDataset *datav1alph
 		})
 	}
 	dataloadInfo.TargetPaths = targetPaths
+	cdataload.SetTargetFilters(&dataloadInfo, dataload)
 	dataLoadValue := &cdataload.DataLoadValue{
 		Name:           dataload.Name,
 		OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
@@ -195,6 +195,58 @@ func Test_genDataLoadValue(t *testing.T) {
 				},
 			},
 		},
+		"test case with manifest and file patterns": {
+			image: "fluid:v0.0.1",
+			targetDataset: &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-dataset",
+					Namespace: "fluid",
+				},
+			},
+			dataload: &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-dataload",
+					Namespace: "fluid",
+				},
+				Spec: datav1alpha1.DataLoadSpec{
+					Dataset: datav1alpha1.TargetDataset{
+						Name:      "test-dataset",
+						Namespace: "fluid",
+					},
+					Manifest: &datav1alpha1.DataLoadManifest{
+						Path:     "/manifests/shards.txt",
+						Replicas: 2,
+					},
+					Include: []string{"*.tar"},
+					Exclude: []string{"/tmp/*"},
+				},
+			},
+			want: &cdataload.DataLoadValue{
+				Name:           "test-dataload",
+				OwnerDatasetId: "fluid-test-dataset",
+				Owner: &common.OwnerReference{
+					Kind:               "DataLoad",
+					APIVersion:         "data.fluid.io/v1alpha1",
+					Enabled:            true,
+					Name:               "test-dataload",
+					BlockOwnerDeletion: false,
+					Controller:         true,
+				},
+				DataLoadInfo: cdataload.DataLoadInfo{
+					BackoffLimit:  3,
+					Image:         "fluid:v0.0.1",
+					TargetDataset: "test-dataset",
+					TargetPaths:   []cdataload.TargetPath{},
+					Manifest: &cdataload.Manifest{
+						Path:     "/manifests/shards.txt",
+						Replicas: 2,
+					},
+					Include:          []string{"*.tar"},
+					Exclude:          []string{"/tmp/*"},
+					ImagePullSecrets: []corev1.LocalObjectReference{},
+				},
+			},
+		},
 		"test case with affinity": {
 			image: "fluid:v0.0.1",
 			targetDataset: &datav1alpha1.Dataset{
@@ -37,6 +37,9 @@ func (e *AlluxioEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestC
 	case dataoperation.DataProcessType:
 		valueFileName, err = e.generateDataProcessValueFile(ctx, object)
 		return valueFileName, err
+	case dataoperation.DataMigrateType:
+		valueFileName, err = e.generateDataMigrateValueFile(ctx, object)
+		return valueFileName, err
 	default:
 		return "", errors.NewNotSupported(
 			schema.GroupResource{
@@ -45,3 +48,20 @@ func (e *AlluxioEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestC
 			}, "AlluxioRuntime")
 	}
 }
+
+// ExecuteDataOperation executes the data operations like DataEvict in the alluxio master pod directly.
+func (e *AlluxioEngine) ExecuteDataOperation(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface) (infos map[string]string, err error) {
+	operationType := operation.GetOperationType()
+	object := operation.GetOperationObject()
+
+	switch operationType {
+	case dataoperation.DataEvictType:
+		return e.evictData(ctx, object)
+	default:
+		return nil, errors.NewNotSupported(
+			schema.GroupResource{
+				Group:    object.GetObjectKind().GroupVersionKind().Group,
+				Resource: object.GetObjectKind().GroupVersionKind().Kind,
+			}, "AlluxioRuntime")
+	}
+}
@@ -134,6 +134,62 @@ func (a AlluxioFileUtils) ReportCapacity() (report string, err error) {
 	return stdout, err
 }
 
+// GetLiveWorkers gets the names of the live workers from the output of `alluxio fsadmin report capacity` command
+func (a AlluxioFileUtils) GetLiveWorkers() (workers []string, err error) {
+	report, err := a.ReportCapacity()
+	if err != nil {
+		return
+	}
+
+	// The workers are listed after the header line, e.g.
+	// Worker Name      Last Heartbeat   Storage       MEM
+	// 192.168.1.147    0                capacity      2048.00MB
+	//                                   used          443.89MB (21%)
+	started := false
+	for _, line := range strings.Split(report, "\n") {
+		if strings.HasPrefix(line, "Worker Name") {
+			started = true
+			continue
+		}
+		if !started || len(strings.TrimSpace(line)) == 0 || strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+		workers = append(workers, strings.Fields(line)[0])
+	}
+	return
+}
+
+// DecommissionWorkers decommissions the workers by running `alluxio fsadmin decommissionWorker` command, the
+// workers are disabled so that they can't register to the master again until they are enabled.
+func (a AlluxioFileUtils) DecommissionWorkers(addresses []string) (err error) {
+	var (
+		command = []string{"alluxio", "fsadmin", "decommissionWorker", "--addresses", strings.Join(addresses, ","), "--disable"}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, true)
+	if err != nil {
+		a.log.Error(err, "AlluxioFileUtils.DecommissionWorkers() failed", "stdout", stdout, "stderr", stderr)
+	}
+	return
+}
+
+// EnableWorkers enables the decommissioned workers by running `alluxio fsadmin enableWorker` command
+func (a AlluxioFileUtils) EnableWorkers(addresses []string) (err error) {
+	var (
+		command = []string{"alluxio", "fsadmin", "enableWorker", "--addresses", strings.Join(addresses, ",")}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, true)
+	if err != nil {
+		a.log.Error(err, "AlluxioFileUtils.EnableWorkers() failed", "stdout", stdout, "stderr", stderr)
+	}
+	return
+}
+
 // Load the metadata without timeout
 func (a AlluxioFileUtils) LoadMetadataWithoutTimeout(alluxioPath string) (err error) {
 	var (
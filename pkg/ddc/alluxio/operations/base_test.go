@@ -670,6 +670,87 @@ func TestAlluxioFIleUtils_ReportCapacity(t *testing.T) {
 	}
 }
 
+// TestAlluxioFileUtils_GetLiveWorkers tests parsing the worker names from the capacity report.
+func TestAlluxioFileUtils_GetLiveWorkers(t *testing.T) {
+	report := `Capacity information for all workers:
+    Total Capacity: 4096.00MB
+        Tier: MEM  Size: 4096.00MB
+    Used Capacity: 443.89MB
+        Tier: MEM  Size: 443.89MB
+    Used Percentage: 10.84%
+    Free Percentage: 89.16%
+
+Worker Name      Last Heartbeat   Storage       MEM
+192.168.1.147    0                capacity      2048.00MB
+                                  used          443.89MB (21%)
+192.168.1.146    0                capacity      2048.00MB
+                                  used          0B (0%)
+`
+	ExecCommon := func(a AlluxioFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return report, "", nil
+	}
+	ExecErr := func(a AlluxioFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "", "", errors.New("fail to run the command")
+	}
+
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(AlluxioFileUtils{}), "exec", ExecErr)
+	defer patches.Reset()
+
+	a := &AlluxioFileUtils{log: fake.NullLogger()}
+	if _, err := a.GetLiveWorkers(); err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyPrivateMethod(reflect.TypeOf(AlluxioFileUtils{}), "exec", ExecCommon)
+	workers, err := a.GetLiveWorkers()
+	if err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if !reflect.DeepEqual(workers, []string{"192.168.1.147", "192.168.1.146"}) {
+		t.Errorf("check failure, unexpected workers %v", workers)
+	}
+}
+
+// TestAlluxioFileUtils_DecommissionWorkers tests the commands to decommission and enable the workers.
+func TestAlluxioFileUtils_DecommissionWorkers(t *testing.T) {
+	var command []string
+	ExecCommon := func(a AlluxioFileUtils, cmd []string, verbose bool) (stdout string, stderr string, err error) {
+		command = cmd
+		return "", "", nil
+	}
+	ExecErr := func(a AlluxioFileUtils, cmd []string, verbose bool) (stdout string, stderr string, err error) {
+		return "", "", errors.New("fail to run the command")
+	}
+
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(AlluxioFileUtils{}), "exec", ExecErr)
+	defer patches.Reset()
+
+	a := &AlluxioFileUtils{log: fake.NullLogger()}
+	addresses := []string{"192.168.1.146:30000", "192.168.1.147:30000"}
+	if err := a.DecommissionWorkers(addresses); err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+	if err := a.EnableWorkers(addresses); err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyPrivateMethod(reflect.TypeOf(AlluxioFileUtils{}), "exec", ExecCommon)
+	if err := a.DecommissionWorkers(addresses); err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	want := []string{"alluxio", "fsadmin", "decommissionWorker", "--addresses", "192.168.1.146:30000,192.168.1.147:30000", "--disable"}
+	if !reflect.DeepEqual(command, want) {
+		t.Errorf("check failure, want %v, got %v", want, command)
+	}
+	if err := a.EnableWorkers(addresses); err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	want = []string{"alluxio", "fsadmin", "enableWorker", "--addresses", "192.168.1.146:30000,192.168.1.147:30000"}
+	if !reflect.DeepEqual(command, want) {
+		t.Errorf("check failure, want %v, got %v", want, command)
+	}
+}
+
 // TestAlluxioFileUtils_exec tests the private exec method of AlluxioFileUtils.
 // It mocks the exec implementation to verify that an error is returned when
 // command execution fails and that no error is returned when execution succeeds.
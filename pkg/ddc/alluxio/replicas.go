@@ -88,7 +88,7 @@ func (e *AlluxioEngine) SyncReplicas(ctx cruntime.ReconcileRequestContext) (err
 			return err
 		}
 		runtimeToUpdate := runtime.DeepCopy()
-		err = e.Helper.SyncReplicas(ctx, runtimeToUpdate, runtimeToUpdate.Status, workers)
+		err = e.Helper.SyncReplicasGracefully(ctx, runtimeToUpdate, runtimeToUpdate.Status, workers, runtimeToUpdate.Spec.ScaleInPolicy, e)
 		return err
 	})
 	if err != nil {
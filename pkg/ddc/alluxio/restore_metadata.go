@@ -0,0 +1,389 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const (
+	journalBackupEnv = "JOURNAL_BACKUP"
+	hostnameLabel    = "kubernetes.io/hostname"
+)
+
+// checkAndRestoreMasterMetadata restores the metadata of the master from the latest completed DataBackup of the
+// dataset if the metadata restore policy is enabled. The journal of the master is kept in an emptyDir, so it's lost
+// once all the master pods holding it are recreated. The uids of these pods are recorded in the master statefulset.
+func (e *AlluxioEngine) checkAndRestoreMasterMetadata() (err error) {
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return
+	}
+	if !runtime.Spec.RuntimeManagement.MetadataRestorePolicy.AutoRestoreEnabled() {
+		return
+	}
+
+	master, err := kubeclient.GetStatefulSet(e.Client, e.getMasterName(), e.namespace)
+	if err != nil {
+		return
+	}
+	pods, err := e.getMasterPods(master)
+	if err != nil {
+		return
+	}
+
+	journalPods := parseJournalPods(master.Annotations[common.AnnotationMasterJournalPods])
+	currentPods := sets.New[string]()
+	for _, pod := range pods {
+		if pod.DeletionTimestamp == nil {
+			currentPods.Insert(string(pod.UID))
+		}
+	}
+
+	if backupName, restoring := master.Annotations[common.AnnotationMetadataRestoringFrom]; restoring {
+		return e.waitForMetadataRestored(runtime, master, pods, journalPods, backupName)
+	}
+
+	if currentPods.Len() == 0 {
+		return
+	}
+	// the journal is replicated to the recreated pods if some of the pods holding it are still running
+	if journalPods.Len() == 0 || journalPods.HasAny(sets.List(currentPods)...) {
+		if journalPods.Equal(currentPods) {
+			return
+		}
+		masterToUpdate := master.DeepCopy()
+		setRestoreAnnotations(masterToUpdate, currentPods, "")
+		return e.Client.Update(context.TODO(), masterToUpdate)
+	}
+
+	return e.restoreMasterMetadata(runtime, master, pods, currentPods)
+}
+
+// restoreMasterMetadata adds the location of the latest completed DataBackup to the master statefulset and restarts
+// the master pods with the empty journal, so that the master initializes the journal from the backup.
+func (e *AlluxioEngine) restoreMasterMetadata(runtime *datav1alpha1.AlluxioRuntime,
+	master *appsv1.StatefulSet,
+	pods []corev1.Pod,
+	journalPods sets.Set[string]) (err error) {
+
+	databackup, err := utils.GetLatestCompletedDataBackup(e.Client, e.name, e.namespace)
+	if err != nil {
+		return
+	}
+	if databackup == nil {
+		return e.reportMetadataRestoreFailure(runtime, master, journalPods, datav1alpha1.RuntimeNoDataBackupToRestoreReason,
+			"The metadata of the master is lost because all the master pods are recreated, but no completed DataBackup of the dataset is found to restore it")
+	}
+
+	volume, backupFile, nodeName, err := e.getRestoreSource(databackup)
+	if err != nil {
+		return e.reportMetadataRestoreFailure(runtime, master, journalPods, datav1alpha1.RuntimeMetadataRestoreFailedReason,
+			fmt.Sprintf("The metadata of the master is lost because all the master pods are recreated, but it can't be restored from DataBackup %s: %v", databackup.Name, err))
+	}
+
+	_, containerName := e.getMasterPodInfo()
+	masterToUpdate := master.DeepCopy()
+	setRestoreSource(&masterToUpdate.Spec.Template.Spec, containerName, volume, backupFile, nodeName, e.transformMasterSelector(runtime))
+	setRestoreAnnotations(masterToUpdate, journalPods, databackup.Name)
+	if err = e.Client.Update(context.TODO(), masterToUpdate); err != nil {
+		return
+	}
+
+	message := fmt.Sprintf("The metadata of the master is lost because all the master pods are recreated, restarting the master to restore it from DataBackup %s", databackup.Name)
+	e.Log.Info("Restoring the metadata of the master", "databackup", databackup.Name, "backupFile", backupFile)
+	e.Recorder.Event(runtime, corev1.EventTypeNormal, common.MetadataRestoring, message)
+	if err = e.setMetadataRestoringCondition(datav1alpha1.RuntimeRestoringMetadataReason, message, corev1.ConditionTrue); err != nil {
+		return
+	}
+
+	return e.waitForMetadataRestored(runtime, masterToUpdate, pods, journalPods, databackup.Name)
+}
+
+// waitForMetadataRestored restarts the master pods with the empty journal and waits for the restarted pods to be ready
+func (e *AlluxioEngine) waitForMetadataRestored(runtime *datav1alpha1.AlluxioRuntime,
+	master *appsv1.StatefulSet,
+	pods []corev1.Pod,
+	journalPods sets.Set[string],
+	backupName string) (err error) {
+
+	restoredPods := sets.New[string]()
+	for i := range pods {
+		pod := &pods[i]
+		if journalPods.Has(string(pod.UID)) {
+			if pod.DeletionTimestamp == nil {
+				if err = e.Client.Delete(context.TODO(), pod); utils.IgnoreNotFound(err) != nil {
+					return
+				}
+				err = nil
+				e.Log.Info("Restarting the master pod to restore the metadata", "pod", pod.Name, "databackup", backupName)
+			}
+			continue
+		}
+		if pod.DeletionTimestamp == nil && podutil.IsPodReady(pod) {
+			restoredPods.Insert(string(pod.UID))
+		}
+	}
+
+	if int32(restoredPods.Len()) < ptr.Deref(master.Spec.Replicas, 1) {
+		e.Log.Info("Waiting for the master pods to be restarted to restore the metadata", "databackup", backupName,
+			"ready", restoredPods.Len())
+		return
+	}
+
+	// the master statefulset is updated on delete, so the restored pods keep running with the restore source
+	_, containerName := e.getMasterPodInfo()
+	masterToUpdate := master.DeepCopy()
+	clearRestoreSource(&masterToUpdate.Spec.Template.Spec, containerName, e.transformMasterSelector(runtime))
+	setRestoreAnnotations(masterToUpdate, restoredPods, "")
+	if err = e.Client.Update(context.TODO(), masterToUpdate); err != nil {
+		return
+	}
+
+	message := fmt.Sprintf("The metadata of the master is restored from DataBackup %s", backupName)
+	e.Recorder.Event(runtime, corev1.EventTypeNormal, common.MetadataRestored, message)
+	return e.setMetadataRestoringCondition(datav1alpha1.RuntimeMetadataRestoredReason, message, corev1.ConditionFalse)
+}
+
+// reportMetadataRestoreFailure reports the metadata can't be restored, and takes the recreated master pods as the
+// ones holding the journal so that the failure is reported only once.
+func (e *AlluxioEngine) reportMetadataRestoreFailure(runtime *datav1alpha1.AlluxioRuntime,
+	master *appsv1.StatefulSet,
+	journalPods sets.Set[string],
+	reason string,
+	message string) (err error) {
+
+	e.Log.Info("Failed to restore the metadata of the master", "reason", message)
+	e.Recorder.Event(runtime, corev1.EventTypeWarning, common.MetadataRestoreFailed, message)
+	if err = e.setMetadataRestoringCondition(reason, message, corev1.ConditionFalse); err != nil {
+		return
+	}
+
+	masterToUpdate := master.DeepCopy()
+	setRestoreAnnotations(masterToUpdate, journalPods, "")
+	return e.Client.Update(context.TODO(), masterToUpdate)
+}
+
+// getMasterPods gets the pods of the master statefulset
+func (e *AlluxioEngine) getMasterPods(master *appsv1.StatefulSet) (pods []corev1.Pod, err error) {
+	selector, err := metav1.LabelSelectorAsSelector(master.Spec.Selector)
+	if err != nil {
+		return
+	}
+	podList := &corev1.PodList{}
+	err = e.Client.List(context.TODO(), podList, &client.ListOptions{Namespace: master.Namespace, LabelSelector: selector})
+	if err != nil {
+		return
+	}
+	return podList.Items, nil
+}
+
+// getRestoreSource returns the volume saving the backup of the DataBackup, the path of the backup file in the master
+// container and the node to run the master on if the backup is saved on the host.
+func (e *AlluxioEngine) getRestoreSource(databackup *datav1alpha1.DataBackup) (volume corev1.Volume, backupFile string, nodeName string, err error) {
+	backupPath := databackup.Status.Infos[cdatabackup.BackupLocationPath]
+	if len(backupPath) == 0 {
+		backupPath = databackup.Spec.BackupPath
+	}
+	pvcName, path, err := utils.ParseBackupRestorePath(backupPath)
+	if err != nil {
+		return
+	}
+
+	// the volumes are the same as the ones of the restore location of the dataset in the master chart
+	if len(pvcName) != 0 {
+		volume = corev1.Volume{
+			Name: "pvc",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvcName},
+			},
+		}
+		backupFile = "/pvc" + path + e.GetMetadataFileName()
+		return
+	}
+
+	nodeName = databackup.Status.Infos[cdatabackup.BackupLocationNodeName]
+	if len(nodeName) == 0 {
+		err = fmt.Errorf("the node saving the backup in %s is unknown", backupPath)
+		return
+	}
+	volume = corev1.Volume{
+		Name: "host",
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: path, Type: ptr.To(corev1.HostPathDirectoryOrCreate)},
+		},
+	}
+	backupFile = "/host/" + e.GetMetadataFileName()
+	return
+}
+
+// setRestoreSource mounts the volume saving the backup to the master container, and tells the master to initialize
+// the journal from the backup file. The volume of the previous restore source is removed.
+func setRestoreSource(spec *corev1.PodSpec,
+	containerName string,
+	volume corev1.Volume,
+	backupFile string,
+	nodeName string,
+	nodeSelector map[string]string) {
+	for _, name := range []string{"pvc", "host"} {
+		if name != volume.Name {
+			spec.Volumes = removeVolume(spec.Volumes, name)
+		}
+	}
+	spec.Volumes = utils.AppendOrOverrideVolume(spec.Volumes, volume)
+
+	if idx := utils.GetContainerIndex(spec.Containers, containerName); idx >= 0 {
+		container := &spec.Containers[idx]
+		container.VolumeMounts = removeVolumeMount(container.VolumeMounts, "pvc")
+		container.VolumeMounts = removeVolumeMount(container.VolumeMounts, "host")
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: volume.Name, MountPath: "/" + volume.Name})
+
+		env := make([]corev1.EnvVar, 0, len(container.Env)+1)
+		for _, envVar := range container.Env {
+			if envVar.Name != journalBackupEnv {
+				env = append(env, envVar)
+			}
+		}
+		container.Env = append(env, corev1.EnvVar{Name: journalBackupEnv, Value: backupFile})
+	}
+
+	// the master runs on the node saving the backup, or the node specified in the runtime
+	if len(nodeName) == 0 {
+		nodeName = nodeSelector[hostnameLabel]
+	}
+	if len(nodeName) != 0 {
+		if spec.NodeSelector == nil {
+			spec.NodeSelector = map[string]string{}
+		}
+		spec.NodeSelector[hostnameLabel] = nodeName
+	} else {
+		delete(spec.NodeSelector, hostnameLabel)
+	}
+}
+
+// clearRestoreSource removes the volume saving the backup and the backup file from the master once the metadata is
+// restored, so that the master recreated later doesn't initialize the journal from the stale backup. The master runs
+// on the node specified in the runtime again.
+func clearRestoreSource(spec *corev1.PodSpec, containerName string, nodeSelector map[string]string) {
+	spec.Volumes = removeVolume(spec.Volumes, "pvc")
+	spec.Volumes = removeVolume(spec.Volumes, "host")
+
+	if idx := utils.GetContainerIndex(spec.Containers, containerName); idx >= 0 {
+		container := &spec.Containers[idx]
+		container.VolumeMounts = removeVolumeMount(container.VolumeMounts, "pvc")
+		container.VolumeMounts = removeVolumeMount(container.VolumeMounts, "host")
+
+		env := make([]corev1.EnvVar, 0, len(container.Env))
+		for _, envVar := range container.Env {
+			if envVar.Name != journalBackupEnv {
+				env = append(env, envVar)
+			}
+		}
+		container.Env = env
+	}
+
+	if nodeName, found := nodeSelector[hostnameLabel]; found {
+		if spec.NodeSelector == nil {
+			spec.NodeSelector = map[string]string{}
+		}
+		spec.NodeSelector[hostnameLabel] = nodeName
+	} else {
+		delete(spec.NodeSelector, hostnameLabel)
+	}
+}
+
+// setRestoreAnnotations records the master pods holding the journal and the DataBackup the metadata is being
+// restored from, the latter is removed if it's empty.
+func setRestoreAnnotations(master *appsv1.StatefulSet, journalPods sets.Set[string], restoringFrom string) {
+	if master.Annotations == nil {
+		master.Annotations = map[string]string{}
+	}
+	master.Annotations[common.AnnotationMasterJournalPods] = strings.Join(sets.List(journalPods), ",")
+	if len(restoringFrom) != 0 {
+		master.Annotations[common.AnnotationMetadataRestoringFrom] = restoringFrom
+	} else {
+		delete(master.Annotations, common.AnnotationMetadataRestoringFrom)
+	}
+}
+
+// parseJournalPods parses the uids of the master pods holding the journal
+func parseJournalPods(value string) sets.Set[string] {
+	journalPods := sets.New[string]()
+	for _, uid := range strings.Split(value, ",") {
+		if uid = strings.TrimSpace(uid); len(uid) != 0 {
+			journalPods.Insert(uid)
+		}
+	}
+	return journalPods
+}
+
+// setMetadataRestoringCondition updates the MetadataRestoring condition of the runtime
+func (e *AlluxioEngine) setMetadataRestoringCondition(reason, message string, status corev1.ConditionStatus) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, err := e.getRuntime()
+		if err != nil {
+			return err
+		}
+		runtimeToUpdate := runtime.DeepCopy()
+
+		cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeMetadataRestoring, reason, message, status)
+		runtimeToUpdate.Status.Conditions = utils.UpdateRuntimeCondition(runtimeToUpdate.Status.Conditions, cond)
+
+		if !reflect.DeepEqual(runtime.Status, runtimeToUpdate.Status) {
+			return e.Client.Status().Update(context.TODO(), runtimeToUpdate)
+		}
+		return nil
+	})
+}
+
+func removeVolume(volumes []corev1.Volume, name string) []corev1.Volume {
+	result := make([]corev1.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		if volume.Name != name {
+			result = append(result, volume)
+		}
+	}
+	return result
+}
+
+func removeVolumeMount(volumeMounts []corev1.VolumeMount, name string) []corev1.VolumeMount {
+	result := make([]corev1.VolumeMount, 0, len(volumeMounts))
+	for _, volumeMount := range volumeMounts {
+		if volumeMount.Name != name {
+			result = append(result, volumeMount)
+		}
+	}
+	return result
+}
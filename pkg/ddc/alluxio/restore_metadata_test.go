@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AlluxioEngine metadata restore", Label("pkg.ddc.alluxio.restore_metadata_test.go"), func() {
+	var (
+		dataset        *datav1alpha1.Dataset
+		alluxioruntime *datav1alpha1.AlluxioRuntime
+		engine         *AlluxioEngine
+		master         *appsv1.StatefulSet
+		masterPod      *corev1.Pod
+		databackup     *datav1alpha1.DataBackup
+		recorder       *record.FakeRecorder
+		k8sClient      client.Client
+	)
+
+	newMasterPod := func(uid types.UID) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      master.Name + "-0",
+				Namespace: master.Namespace,
+				UID:       uid,
+				Labels:    master.Spec.Selector.MatchLabels,
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		dataset, alluxioruntime = mockFluidObjectsForTests(types.NamespacedName{Namespace: "fluid", Name: "hbase"})
+		alluxioruntime.Spec.RuntimeManagement.MetadataRestorePolicy = &datav1alpha1.MetadataRestorePolicy{AutoRestore: true}
+		engine = mockAlluxioEngineForTests(dataset, alluxioruntime)
+		master = mockAlluxioObjectsForTests(dataset, alluxioruntime, engine).MasterSts
+		master.Spec.Replicas = ptr.To[int32](1)
+		master.Annotations = map[string]string{common.AnnotationMasterJournalPods: "old-uid"}
+		masterPod = newMasterPod("new-uid")
+		databackup = &datav1alpha1.DataBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: dataset.Namespace},
+			Spec:       datav1alpha1.DataBackupSpec{Dataset: dataset.Name, BackupPath: "pvc://backup-pvc/subpath"},
+			Status: datav1alpha1.OperationStatus{
+				Phase: common.PhaseComplete,
+				Conditions: []datav1alpha1.Condition{
+					{Type: common.Complete, LastTransitionTime: metav1.NewTime(time.Now())},
+				},
+				Infos: map[string]string{
+					cdatabackup.BackupLocationPath:     "pvc://backup-pvc/subpath",
+					cdatabackup.BackupLocationNodeName: "NA",
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = fake.NewFakeClientWithScheme(testScheme, []runtime.Object{dataset, alluxioruntime, master, masterPod, databackup}...)
+		recorder = record.NewFakeRecorder(10)
+		engine.Client = k8sClient
+		engine.Recorder = recorder
+	})
+
+	getMaster := func() *appsv1.StatefulSet {
+		sts, err := kubeclient.GetStatefulSet(k8sClient, master.Name, master.Namespace)
+		Expect(err).NotTo(HaveOccurred())
+		return sts
+	}
+
+	getCondition := func() *datav1alpha1.RuntimeCondition {
+		runtime, err := engine.getRuntime()
+		Expect(err).NotTo(HaveOccurred())
+		_, cond := utils.GetRuntimeCondition(runtime.Status.Conditions, datav1alpha1.RuntimeMetadataRestoring)
+		return cond
+	}
+
+	When("the master pods holding the journal are recreated", func() {
+		It("should restart the master to restore the metadata from the latest DataBackup", func() {
+			Expect(engine.checkAndRestoreMasterMetadata()).To(Succeed())
+
+			restoring := getMaster()
+			Expect(restoring.Annotations[common.AnnotationMetadataRestoringFrom]).To(Equal(databackup.Name))
+			Expect(restoring.Annotations[common.AnnotationMasterJournalPods]).To(Equal("new-uid"))
+			podSpec := restoring.Spec.Template.Spec
+			Expect(podSpec.Volumes).To(ContainElement(HaveField("Name", "pvc")))
+			Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "pvc", MountPath: "/pvc"}))
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name:  journalBackupEnv,
+				Value: "/pvc/subpath/" + engine.GetMetadataFileName(),
+			}))
+			Expect(podSpec.Containers[1].Env).To(BeEmpty())
+
+			pod := &corev1.Pod{}
+			err := k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(masterPod), pod)
+			Expect(utils.IgnoreNotFound(err)).To(Succeed())
+			Expect(err).To(HaveOccurred())
+
+			cond := getCondition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeRestoringMetadataReason))
+			Expect(recorder.Events).To(Receive(ContainSubstring(common.MetadataRestoring)))
+
+			By("the master pod is restarted and ready")
+			Expect(k8sClient.Create(context.TODO(), newMasterPod("restored-uid"))).To(Succeed())
+			Expect(engine.checkAndRestoreMasterMetadata()).To(Succeed())
+
+			restored := getMaster()
+			Expect(restored.Annotations).NotTo(HaveKey(common.AnnotationMetadataRestoringFrom))
+			Expect(restored.Annotations[common.AnnotationMasterJournalPods]).To(Equal("restored-uid"))
+			podSpec = restored.Spec.Template.Spec
+			Expect(podSpec.Volumes).To(BeEmpty())
+			Expect(podSpec.Containers[0].VolumeMounts).To(BeEmpty())
+			Expect(podSpec.Containers[0].Env).NotTo(ContainElement(HaveField("Name", journalBackupEnv)))
+			cond = getCondition()
+			Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeMetadataRestoredReason))
+			Expect(recorder.Events).To(Receive(ContainSubstring(common.MetadataRestored)))
+		})
+
+		When("the backup is saved on the host", func() {
+			BeforeEach(func() {
+				databackup.Status.Infos = map[string]string{
+					cdatabackup.BackupLocationPath:     "local:///backup",
+					cdatabackup.BackupLocationNodeName: "node-1",
+				}
+			})
+
+			It("should run the master on the node saving the backup", func() {
+				Expect(engine.checkAndRestoreMasterMetadata()).To(Succeed())
+
+				podSpec := getMaster().Spec.Template.Spec
+				Expect(podSpec.NodeSelector).To(HaveKeyWithValue(hostnameLabel, "node-1"))
+				Expect(podSpec.Volumes).To(ContainElement(HaveField("HostPath.Path", "/backup/")))
+				Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+					Name:  journalBackupEnv,
+					Value: "/host/" + engine.GetMetadataFileName(),
+				}))
+
+				By("the master pod is restarted and ready")
+				Expect(k8sClient.Create(context.TODO(), newMasterPod("restored-uid"))).To(Succeed())
+				Expect(engine.checkAndRestoreMasterMetadata()).To(Succeed())
+
+				podSpec = getMaster().Spec.Template.Spec
+				Expect(podSpec.NodeSelector).NotTo(HaveKey(hostnameLabel))
+				Expect(podSpec.Volumes).To(BeEmpty())
+			})
+		})
+
+		When("no DataBackup of the dataset is completed", func() {
+			BeforeEach(func() {
+				databackup.Status.Phase = common.PhaseFailed
+			})
+
+			It("should report the metadata can't be restored only once", func() {
+				Expect(engine.checkAndRestoreMasterMetadata()).To(Succeed())
+
+				updated := getMaster()
+				Expect(updated.Annotations).NotTo(HaveKey(common.AnnotationMetadataRestoringFrom))
+				Expect(updated.Annotations[common.AnnotationMasterJournalPods]).To(Equal("new-uid"))
+				Expect(updated.Spec.Template.Spec.Volumes).To(BeEmpty())
+				cond := getCondition()
+				Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+				Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeNoDataBackupToRestoreReason))
+				Expect(recorder.Events).To(Receive(ContainSubstring(common.MetadataRestoreFailed)))
+
+				Expect(engine.checkAndRestoreMasterMetadata()).To(Succeed())
+				Expect(recorder.Events).NotTo(Receive())
+			})
+		})
+	})
+
+	When("some master pods holding the journal are still running", func() {
+		BeforeEach(func() {
+			master.Annotations[common.AnnotationMasterJournalPods] = "new-uid,old-uid"
+		})
+
+		It("should take the journal as replicated to the recreated pods", func() {
+			Expect(engine.checkAndRestoreMasterMetadata()).To(Succeed())
+
+			updated := getMaster()
+			Expect(updated.Annotations[common.AnnotationMasterJournalPods]).To(Equal("new-uid"))
+			Expect(updated.Annotations).NotTo(HaveKey(common.AnnotationMetadataRestoringFrom))
+			Expect(getCondition()).To(BeNil())
+		})
+	})
+
+	When("the journal is not recorded yet", func() {
+		BeforeEach(func() {
+			master.Annotations = nil
+		})
+
+		It("should record the master pods holding the journal", func() {
+			Expect(engine.checkAndRestoreMasterMetadata()).To(Succeed())
+
+			updated := getMaster()
+			Expect(updated.Annotations[common.AnnotationMasterJournalPods]).To(Equal("new-uid"))
+			Expect(updated.Spec.Template.Spec.Volumes).To(BeEmpty())
+			Expect(getCondition()).To(BeNil())
+		})
+	})
+
+	When("the restore policy is not enabled", func() {
+		BeforeEach(func() {
+			alluxioruntime.Spec.RuntimeManagement.MetadataRestorePolicy = nil
+		})
+
+		It("should not restore the metadata", func() {
+			Expect(engine.checkAndRestoreMasterMetadata()).To(Succeed())
+
+			updated := getMaster()
+			Expect(updated.Annotations[common.AnnotationMasterJournalPods]).To(Equal("old-uid"))
+			Expect(updated.Spec.Template.Spec.Volumes).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+)
+
+const workerContainerName = "alluxio-worker"
+
+// DrainWorkers decommissions the workers which are still registered in the master. The decommissioned workers are
+// removed from the live workers of the master, so the clients won't read the cached blocks from them any more.
+// The cached blocks are not copied to the other workers, they're dropped with the removed workers.
+func (e *AlluxioEngine) DrainWorkers(workers []corev1.Pod) (drained int, err error) {
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
+
+	liveWorkers, err := fileUtils.GetLiveWorkers()
+	if err != nil {
+		return
+	}
+	live := map[string]bool{}
+	for _, worker := range liveWorkers {
+		live[worker] = true
+	}
+
+	var addresses []string
+	for _, worker := range workers {
+		// the workers not scheduled yet have nothing to drain
+		if len(worker.Status.HostIP) == 0 || !live[worker.Status.HostIP] {
+			drained++
+			continue
+		}
+		addresses = append(addresses, getWorkerAddress(worker))
+	}
+
+	if len(addresses) > 0 {
+		e.Log.Info("Decommission the workers before scaling in", "workers", addresses)
+		err = fileUtils.DecommissionWorkers(addresses)
+	}
+	return
+}
+
+// RestoreWorkers enables the decommissioned workers so that the workers on the same nodes can register again
+func (e *AlluxioEngine) RestoreWorkers(workers []corev1.Pod) error {
+	var addresses []string
+	for _, worker := range workers {
+		if len(worker.Status.HostIP) > 0 {
+			addresses = append(addresses, getWorkerAddress(worker))
+		}
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
+	return fileUtils.EnableWorkers(addresses)
+}
+
+// getWorkerAddress returns the address of the worker used by decommission, which consists of the
+// worker hostname and the web port.
+func getWorkerAddress(worker corev1.Pod) string {
+	for _, container := range worker.Spec.Containers {
+		if container.Name != workerContainerName {
+			continue
+		}
+		for _, port := range container.Ports {
+			if port.Name == "web" {
+				return fmt.Sprintf("%s:%d", worker.Status.HostIP, port.ContainerPort)
+			}
+		}
+	}
+	return worker.Status.HostIP
+}
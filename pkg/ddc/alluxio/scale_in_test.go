@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"github.com/agiledragon/gomonkey/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("AlluxioEngine graceful scale-in", func() {
+	var (
+		engine  *AlluxioEngine
+		patches *gomonkey.Patches
+		workers []corev1.Pod
+	)
+
+	newWorker := func(name, hostIP string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fluid"},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:  workerContainerName,
+					Ports: []corev1.ContainerPort{{Name: "rpc", ContainerPort: 29999}, {Name: "web", ContainerPort: 30000}},
+				}},
+			},
+			Status: corev1.PodStatus{HostIP: hostIP},
+		}
+	}
+
+	BeforeEach(func() {
+		engine = &AlluxioEngine{name: "hbase", namespace: "fluid", Log: fake.NullLogger()}
+		workers = []corev1.Pod{
+			newWorker("hbase-worker-1", "192.168.1.146"),
+			newWorker("hbase-worker-2", "192.168.1.147"),
+			newWorker("hbase-worker-3", ""),
+		}
+	})
+
+	AfterEach(func() {
+		if patches != nil {
+			patches.Reset()
+		}
+	})
+
+	It("should decommission the live workers", func() {
+		var decommissioned []string
+		patches = gomonkey.ApplyMethodFunc(operations.AlluxioFileUtils{}, "GetLiveWorkers", func() ([]string, error) {
+			return []string{"192.168.1.145", "192.168.1.147"}, nil
+		})
+		patches.ApplyMethodFunc(operations.AlluxioFileUtils{}, "DecommissionWorkers", func(addresses []string) error {
+			decommissioned = addresses
+			return nil
+		})
+
+		drained, err := engine.DrainWorkers(workers)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drained).To(Equal(2))
+		Expect(decommissioned).To(Equal([]string{"192.168.1.147:30000"}))
+	})
+
+	It("should enable the scheduled workers when restoring", func() {
+		var enabled []string
+		patches = gomonkey.ApplyMethodFunc(operations.AlluxioFileUtils{}, "EnableWorkers", func(addresses []string) error {
+			enabled = addresses
+			return nil
+		})
+
+		Expect(engine.RestoreWorkers(workers)).To(Succeed())
+		Expect(enabled).To(Equal([]string{"192.168.1.146:30000", "192.168.1.147:30000"}))
+	})
+
+	It("should use the host as the address without web port", func() {
+		worker := newWorker("hbase-worker-0", "192.168.1.145")
+		worker.Spec.Containers[0].Ports = nil
+		Expect(getWorkerAddress(worker)).To(Equal("192.168.1.145"))
+	})
+})
@@ -16,9 +16,49 @@ limitations under the License.
 
 package alluxio
 
-import cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	runtimeOpts "github.com/fluid-cloudnative/fluid/pkg/utils/runtimes/options"
+)
 
-// SyncRuntime syncs the runtime spec
+const fuseContainerName = "alluxio-fuse"
+
+// SyncRuntime syncs the runtime spec. When the version of alluxio or fuse is changed, the master is upgraded
+// first, then the workers are upgraded in batches, and the fuse is marked as outdated at last.
 func (e *AlluxioEngine) SyncRuntime(ctx cruntime.ReconcileRequestContext) (changed bool, err error) {
-	return
+	if runtimeOpts.ShouldSkipSyncingRuntime() {
+		e.Log.V(1).Info("Skipping runtime sync due to CONTROLLER_SKIP_SYNCING_RUNTIME being enabled")
+		return
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return
+	}
+
+	_, masterContainerName := e.getMasterPodInfo()
+	target := ctrl.UpgradeTarget{
+		Master: ctrl.UpgradeComponent{
+			Name:      e.getMasterName(),
+			Container: masterContainerName,
+			Image:     runtime.Spec.AlluxioVersion.Image,
+			ImageTag:  runtime.Spec.AlluxioVersion.ImageTag,
+		},
+		Worker: ctrl.UpgradeComponent{
+			Name:      e.getWorkerName(),
+			Container: workerContainerName,
+			Image:     runtime.Spec.AlluxioVersion.Image,
+			ImageTag:  runtime.Spec.AlluxioVersion.ImageTag,
+		},
+		Fuse: ctrl.UpgradeComponent{
+			Name:      e.getFuseName(),
+			Container: fuseContainerName,
+			Image:     runtime.Spec.Fuse.Image,
+			ImageTag:  runtime.Spec.Fuse.ImageTag,
+		},
+		Policy: runtime.Spec.UpgradePolicy,
+	}
+
+	return e.Helper.SyncUpgrade(ctx, runtime, target, e.CheckRuntimeHealthy)
 }
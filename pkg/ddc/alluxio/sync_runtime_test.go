@@ -17,72 +17,129 @@ limitations under the License.
 package alluxio
 
 import (
-	"testing"
+	"context"
+	"errors"
 
+	"github.com/agiledragon/gomonkey/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 )
 
-func TestAlluxioEngine_SyncRuntime(t *testing.T) {
-	type fields struct {
-		// runtime                *datav1alpha1.AlluxioRuntime
-		// name                   string
-		// namespace              string
-		// runtimeType            string
-		// Log                    logr.Logger
-		// Client                 client.Client
-		// defaultGracefulShutdownLimits int32
-		// retryShutdown          int32
-		// initImage              string
-		// MetadataSyncDoneCh     chan MetadataSyncResult
-		// runtimeInfo            base.RuntimeInfoInterface
-		// UnitTest               bool
-		// lastCacheHitStates     *cacheHitStates
-		// Helper                 *ctrl.Helper
-		// Recorder               record.EventRecorder
-	}
-	type args struct {
-		ctx cruntime.ReconcileRequestContext
+var _ = Describe("AlluxioEngine SyncRuntime", Label("pkg.ddc.alluxio.sync_runtime_test.go"), func() {
+	const (
+		currentImage = "fluidcloudnative/alluxio:2.9.0"
+		fuseImage    = "fluidcloudnative/alluxio-fuse:2.9.0"
+	)
+
+	var (
+		dataset        *datav1alpha1.Dataset
+		alluxioruntime *datav1alpha1.AlluxioRuntime
+		engine         *AlluxioEngine
+		mockedObjects  mockedObjects
+		k8sClient      client.Client
+		resources      []runtime.Object
+		ctx            cruntime.ReconcileRequestContext
+		patches        *gomonkey.Patches
+	)
+
+	setImage := func(spec *corev1.PodSpec, image string) {
+		for i := range spec.Containers {
+			spec.Containers[i].Image = image
+		}
 	}
-	tests := []struct {
-		name        string
-		fields      fields
-		args        args
-		wantChanged bool
-		wantErr     bool
-	}{
-		{
-			name:        "default",
-			wantChanged: false,
-			wantErr:     false,
-		},
+
+	getStatefulSetImage := func(name string) string {
+		sts := &appsv1.StatefulSet{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: name}, sts)).To(Succeed())
+		return sts.Spec.Template.Spec.Containers[0].Image
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			e := &AlluxioEngine{
-				// runtime:                tt.fields.runtime,
-				// name:                   tt.fields.name,
-				// namespace:              tt.fields.namespace,
-				// runtimeType:            tt.fields.runtimeType,
-				// Log:                    tt.fields.Log,
-				// Client:                 tt.fields.Client,
-				// defaultGracefulShutdownLimits: tt.fields.defaultGracefulShutdownLimits,
-				// retryShutdown:          tt.fields.retryShutdown,
-				// initImage:              tt.fields.initImage,
-				// MetadataSyncDoneCh:     tt.fields.MetadataSyncDoneCh,
-				// runtimeInfo:            tt.fields.runtimeInfo,
-				// UnitTest:               tt.fields.UnitTest,
-				// lastCacheHitStates:     tt.fields.lastCacheHitStates,
-				// Helper:                 tt.fields.Helper,
-				// Recorder:               tt.fields.Recorder,
-			}
-			gotChanged, err := e.SyncRuntime(tt.args.ctx)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("AlluxioEngine.SyncRuntime() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if gotChanged != tt.wantChanged {
-				t.Errorf("AlluxioEngine.SyncRuntime() = %v, want %v", gotChanged, tt.wantChanged)
-			}
+
+	BeforeEach(func() {
+		dataset, alluxioruntime = mockFluidObjectsForTests(types.NamespacedName{Namespace: "fluid", Name: "hbase"})
+		engine = mockAlluxioEngineForTests(dataset, alluxioruntime)
+		mockedObjects = mockAlluxioObjectsForTests(dataset, alluxioruntime, engine)
+		setImage(&mockedObjects.MasterSts.Spec.Template.Spec, currentImage)
+		setImage(&mockedObjects.WorkerSts.Spec.Template.Spec, currentImage)
+		setImage(&mockedObjects.FuseDs.Spec.Template.Spec, fuseImage)
+		resources = []runtime.Object{
+			dataset,
+			alluxioruntime,
+			mockedObjects.MasterSts,
+			mockedObjects.WorkerSts,
+			mockedObjects.FuseDs,
+		}
+		ctx = cruntime.ReconcileRequestContext{Log: fake.NullLogger(), Recorder: record.NewFakeRecorder(10)}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, resources...)
+		engine.Client = k8sClient
+		engine.Helper = ctrl.BuildHelper(engine.runtimeInfo, k8sClient, engine.Log)
+	})
+
+	AfterEach(func() {
+		if patches != nil {
+			patches.Reset()
+			patches = nil
+		}
+	})
+
+	It("should record the versions if the version is not changed", func() {
+		changed, err := engine.SyncRuntime(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		updatedRuntime, err := engine.getRuntime()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updatedRuntime.Status.Upgrade).NotTo(BeNil())
+		Expect(updatedRuntime.Status.Upgrade.Master.CurrentVersion).To(Equal(currentImage))
+		Expect(updatedRuntime.Status.Upgrade.Fuse.TargetVersion).To(Equal(fuseImage))
+	})
+
+	When("the alluxio version is changed", func() {
+		BeforeEach(func() {
+			alluxioruntime.Spec.AlluxioVersion.ImageTag = "2.9.1"
 		})
-	}
-}
+
+		It("should upgrade the master first", func() {
+			patches = gomonkey.ApplyMethodFunc(engine, "CheckRuntimeHealthy", func() error {
+				return nil
+			})
+
+			changed, err := engine.SyncRuntime(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(getStatefulSetImage(engine.getMasterName())).To(Equal("fluidcloudnative/alluxio:2.9.1"))
+			Expect(getStatefulSetImage(engine.getWorkerName())).To(Equal(currentImage))
+		})
+
+		It("should pause upgrading if the runtime is not healthy", func() {
+			patches = gomonkey.ApplyMethodFunc(engine, "CheckRuntimeHealthy", func() error {
+				return errors.New("the master is not healthy")
+			})
+
+			changed, err := engine.SyncRuntime(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+			Expect(getStatefulSetImage(engine.getMasterName())).To(Equal(currentImage))
+
+			updatedRuntime, err := engine.getRuntime()
+			Expect(err).NotTo(HaveOccurred())
+			_, cond := utils.GetRuntimeCondition(updatedRuntime.Status.Conditions, datav1alpha1.RuntimeUpgrading)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeUpgradePausedReason))
+		})
+	})
+})
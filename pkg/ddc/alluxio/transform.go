@@ -81,6 +81,8 @@ func (e *AlluxioEngine) transform(runtime *datav1alpha1.AlluxioRuntime) (value *
 		return
 	}
 
+	e.transformFuseDownwardAPIVolumes(runtime, value)
+
 	// 5.transform the hadoop non-default configurations
 	err = e.transformHadoopConfig(runtime, value)
 	if err != nil {
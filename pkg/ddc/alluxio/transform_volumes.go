@@ -24,6 +24,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 )
 
 // transform master volumes
@@ -151,3 +152,44 @@ func (e *AlluxioEngine) transformFuseVolumes(runtime *datav1alpha1.AlluxioRuntim
 
 	return err
 }
+
+// transformFuseDownwardAPIVolumes exposes the labels of the fuse pod to the CSI plugin, so that the fuse generation
+// can be compared with the one in the pvc to check if the fuse pod is outdated.
+func (e *AlluxioEngine) transformFuseDownwardAPIVolumes(runtime *datav1alpha1.AlluxioRuntime, value *Alluxio) {
+	if runtime.Spec.Fuse.CleanPolicy != datav1alpha1.OnFuseChangedCleanPolicy {
+		return
+	}
+
+	// the generation is the same as the one recorded in the pvc when the fuse daemonset is created
+	if value.Fuse.Labels == nil {
+		value.Fuse.Labels = map[string]string{}
+	}
+	value.Fuse.Labels[common.LabelRuntimeFuseGeneration] = "1"
+
+	volumeName := "fuse-labels-downward-api-volume"
+	var mode int32 = 0755
+	volume := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			DownwardAPI: &corev1.DownwardAPIVolumeSource{
+				Items: []corev1.DownwardAPIVolumeFile{
+					{
+						Path: utils.MetaDataFuseLabelFileName,
+						FieldRef: &corev1.ObjectFieldSelector{
+							APIVersion: "v1",
+							FieldPath:  "metadata.labels",
+						},
+					},
+				},
+				DefaultMode: ptr.To(mode),
+			},
+		},
+	}
+	volumeMount := corev1.VolumeMount{
+		Name:      volumeName,
+		ReadOnly:  true,
+		MountPath: utils.GetRuntimeFuseMetadataPath(runtime.Namespace, runtime.Name, common.AlluxioRuntime),
+	}
+	value.Fuse.Volumes = append(value.Fuse.Volumes, volume)
+	value.Fuse.VolumeMounts = append(value.Fuse.VolumeMounts, volumeMount)
+}
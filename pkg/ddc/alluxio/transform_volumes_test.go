@@ -21,7 +21,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Constants for test values
@@ -215,4 +218,37 @@ var _ = Describe("AlluxioEngine Transform Volumes Tests", Label("pkg.ddc.alluxio
 			})
 		})
 	})
+
+	Describe("transformFuseDownwardAPIVolumes", func() {
+		var runtime *datav1alpha1.AlluxioRuntime
+
+		BeforeEach(func() {
+			runtime = &datav1alpha1.AlluxioRuntime{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+			}
+		})
+
+		Context("when the fuse clean policy is OnFuseChanged", func() {
+			It("should expose the fuse labels with the generation", func() {
+				runtime.Spec.Fuse.CleanPolicy = datav1alpha1.OnFuseChangedCleanPolicy
+				engine.transformFuseDownwardAPIVolumes(runtime, got)
+
+				Expect(got.Fuse.Labels).To(HaveKeyWithValue(common.LabelRuntimeFuseGeneration, "1"))
+				Expect(got.Fuse.Volumes).To(HaveLen(1))
+				Expect(got.Fuse.Volumes[0].DownwardAPI).NotTo(BeNil())
+				Expect(got.Fuse.VolumeMounts).To(HaveLen(1))
+				Expect(got.Fuse.VolumeMounts[0].MountPath).To(Equal(utils.GetRuntimeFuseMetadataPath("fluid", "hbase", common.AlluxioRuntime)))
+			})
+		})
+
+		Context("when the fuse clean policy is not OnFuseChanged", func() {
+			It("should not change the fuse", func() {
+				runtime.Spec.Fuse.CleanPolicy = datav1alpha1.OnRuntimeDeletedCleanPolicy
+				engine.transformFuseDownwardAPIVolumes(runtime, got)
+
+				Expect(got.Fuse.Labels).To(BeEmpty())
+				Expect(got.Fuse.Volumes).To(BeEmpty())
+			})
+		})
+	})
 })
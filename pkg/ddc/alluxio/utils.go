@@ -120,6 +120,11 @@ func (e *AlluxioEngine) getWorkerName() (dsName string) {
 	return e.name + "-worker"
 }
 
+// GetHibernationStatefulSetNames returns the worker and master statefulsets to scale to zero when hibernating
+func (e *AlluxioEngine) GetHibernationStatefulSetNames() (worker string, master string) {
+	return e.getWorkerName(), e.getMasterName()
+}
+
 func (e *AlluxioEngine) getFuseName() (dsName string) {
 	return e.name + "-fuse"
 }
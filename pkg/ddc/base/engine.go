@@ -65,6 +65,20 @@ type DataOperatorYamlGenerator interface {
 	GetDataOperationValueFile(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface) (valueFileName string, err error)
 }
 
+// DataOperationExecutor is implemented by the runtime engine which executes data operations like DataEvict
+// in the runtime pods directly. The returned infos are recorded in the OperationStatus of the data operation.
+type DataOperationExecutor interface {
+	ExecuteDataOperation(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface) (infos map[string]string, err error)
+}
+
+// Hibernator is implemented by the runtime engine which supports hibernation. The TemplateEngine scales the
+// returned statefulsets to zero when the dataset is idle and restores them when it's used again.
+type Hibernator interface {
+	// GetHibernationStatefulSetNames returns the name of the worker statefulset and the name of the master
+	// statefulset, the master name is empty if the runtime has no master.
+	GetHibernationStatefulSetNames() (worker string, master string)
+}
+
 // Implement is what the real engine should implement if it use the TemplateEngine
 type Implement interface {
 	UnderFileSystemService
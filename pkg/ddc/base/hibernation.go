@@ -0,0 +1,350 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// GetHibernationPolicy returns the hibernation policy of the runtime, nil is returned if the runtime
+// doesn't support hibernation or the policy is not set.
+func GetHibernationPolicy(runtime client.Object) *datav1alpha1.HibernationPolicy {
+	switch r := runtime.(type) {
+	case *datav1alpha1.AlluxioRuntime:
+		return r.Spec.RuntimeManagement.HibernationPolicy
+	case *datav1alpha1.JuiceFSRuntime:
+		return r.Spec.RuntimeManagement.HibernationPolicy
+	case *datav1alpha1.ThinRuntime:
+		return r.Spec.RuntimeManagement.HibernationPolicy
+	default:
+		return nil
+	}
+}
+
+// IsRuntimeHibernated checks if the runtime is hibernated by its conditions
+func IsRuntimeHibernated(status *datav1alpha1.RuntimeStatus) bool {
+	if status == nil {
+		return false
+	}
+	_, cond := utils.GetRuntimeCondition(status.Conditions, datav1alpha1.RuntimeHibernated)
+	return cond != nil && cond.Status == corev1.ConditionTrue
+}
+
+// syncHibernation hibernates the runtime by scaling its statefulsets to zero after its dataset has been idle for the
+// configured minutes, and wakes it up when the dataset is used again. The pods held by the wake-up scheduling gate are
+// released once the runtime is awake. It returns true if the runtime stays hibernated and the rest of the sync should
+// be skipped.
+func (t *TemplateEngine) syncHibernation(ctx cruntime.ReconcileRequestContext) (hibernated bool, err error) {
+	runtime, ok := ctx.Runtime.(RuntimeInterface)
+	if !ok || runtime == nil {
+		return false, nil
+	}
+	if _, ok := t.Implement.(Hibernator); !ok {
+		return false, nil
+	}
+	policy := GetHibernationPolicy(runtime)
+	status := runtime.GetStatus()
+	_, hibernatedCond := utils.GetRuntimeCondition(status.Conditions, datav1alpha1.RuntimeHibernated)
+	if policy == nil && hibernatedCond == nil {
+		return false, nil
+	}
+
+	consumers, gatedPods, err := t.getDatasetConsumers(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if IsRuntimeHibernated(status) {
+		if policy != nil && consumers == 0 && !isWakeUpRequested(runtime, hibernatedCond) {
+			// keep the statefulsets scaled to zero in case they are scaled out by others
+			return true, t.hibernateStatefulSets(ctx, policy)
+		}
+		return false, t.wakeUp(ctx, runtime)
+	}
+
+	if len(gatedPods) > 0 {
+		if err = t.releaseGatedPods(ctx, gatedPods); err != nil {
+			return false, err
+		}
+	}
+
+	if policy == nil {
+		return false, nil
+	}
+
+	if consumers > 0 || (ctx.Dataset != nil && len(ctx.Dataset.Status.OperationRef) > 0) {
+		if status.IdleSince != nil {
+			return false, t.updateHibernationStatus(ctx, func(status *datav1alpha1.RuntimeStatus) {
+				status.IdleSince = nil
+			})
+		}
+		return false, nil
+	}
+
+	if status.IdleSince == nil {
+		now := metav1.Now()
+		return false, t.updateHibernationStatus(ctx, func(status *datav1alpha1.RuntimeStatus) {
+			status.IdleSince = &now
+		})
+	}
+
+	if time.Since(status.IdleSince.Time) < time.Duration(policy.IdleMinutes)*time.Minute {
+		return false, nil
+	}
+
+	return true, t.hibernate(ctx, runtime, policy)
+}
+
+// hibernate scales the runtime to zero and marks it hibernated
+func (t *TemplateEngine) hibernate(ctx cruntime.ReconcileRequestContext, runtime RuntimeInterface, policy *datav1alpha1.HibernationPolicy) error {
+	if err := t.hibernateStatefulSets(ctx, policy); err != nil {
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.RuntimeHibernationFailed, "Failed to hibernate the runtime: %v", err)
+		return err
+	}
+
+	err := t.updateHibernationStatus(ctx, func(status *datav1alpha1.RuntimeStatus) {
+		message := fmt.Sprintf("No pod mounts the dataset for %d minutes", policy.IdleMinutes)
+		cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeHibernated, datav1alpha1.RuntimeHibernatedReason, message, corev1.ConditionTrue)
+		status.Conditions = utils.UpdateRuntimeCondition(status.Conditions, cond)
+		status.WorkerPhase = datav1alpha1.RuntimePhaseHibernated
+		if policy.HibernateMaster {
+			status.MasterPhase = datav1alpha1.RuntimePhaseHibernated
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	t.Log.Info("The runtime hibernates because its dataset is idle", "idleMinutes", policy.IdleMinutes)
+	ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.RuntimeHibernated,
+		"Runtime hibernates because no pod mounts the dataset for %d minutes", policy.IdleMinutes)
+	return nil
+}
+
+// wakeUp restores the statefulsets of the runtime to the replicas before hibernation
+func (t *TemplateEngine) wakeUp(ctx cruntime.ReconcileRequestContext, runtime RuntimeInterface) error {
+	for _, name := range t.getHibernationStatefulSetNames() {
+		if err := t.restoreStatefulSet(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	err := t.updateHibernationStatus(ctx, func(status *datav1alpha1.RuntimeStatus) {
+		cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeHibernated, datav1alpha1.RuntimeWokenUpReason,
+			"The runtime is woken up", corev1.ConditionFalse)
+		status.Conditions = utils.UpdateRuntimeCondition(status.Conditions, cond)
+		status.IdleSince = nil
+		if status.WorkerPhase == datav1alpha1.RuntimePhaseHibernated {
+			status.WorkerPhase = datav1alpha1.RuntimePhaseNotReady
+		}
+		if status.MasterPhase == datav1alpha1.RuntimePhaseHibernated {
+			status.MasterPhase = datav1alpha1.RuntimePhaseNotReady
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	t.Log.Info("The runtime is woken up because its dataset is used")
+	ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.RuntimeWokenUp, "Runtime is woken up because the dataset is used")
+	return nil
+}
+
+// hibernateStatefulSets scales the workers, and the masters if required, to zero. The replicas before hibernation
+// are recorded in the annotation of the statefulsets.
+func (t *TemplateEngine) hibernateStatefulSets(ctx cruntime.ReconcileRequestContext, policy *datav1alpha1.HibernationPolicy) error {
+	names := t.getHibernationStatefulSetNames()
+	if !policy.HibernateMaster {
+		names = names[:1]
+	}
+
+	for _, name := range names {
+		err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			sts, err := kubeclient.GetStatefulSet(t.Client, name, ctx.Namespace)
+			if err != nil {
+				return err
+			}
+			replicas := ptr.Deref(sts.Spec.Replicas, 1)
+			if replicas == 0 {
+				return nil
+			}
+
+			stsToUpdate := sts.DeepCopy()
+			if stsToUpdate.Annotations == nil {
+				stsToUpdate.Annotations = map[string]string{}
+			}
+			if _, found := stsToUpdate.Annotations[common.AnnotationHibernatedReplicas]; !found {
+				stsToUpdate.Annotations[common.AnnotationHibernatedReplicas] = strconv.Itoa(int(replicas))
+			}
+			stsToUpdate.Spec.Replicas = ptr.To[int32](0)
+			return t.Client.Update(context.TODO(), stsToUpdate)
+		})
+		if utils.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreStatefulSet restores the replicas of the statefulset recorded before hibernation
+func (t *TemplateEngine) restoreStatefulSet(ctx cruntime.ReconcileRequestContext, name string) error {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		sts, err := kubeclient.GetStatefulSet(t.Client, name, ctx.Namespace)
+		if err != nil {
+			return err
+		}
+		value, found := sts.Annotations[common.AnnotationHibernatedReplicas]
+		if !found {
+			return nil
+		}
+		replicas, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("failed to parse the replicas %q before hibernation of statefulset %s: %v", value, name, err)
+		}
+
+		stsToUpdate := sts.DeepCopy()
+		delete(stsToUpdate.Annotations, common.AnnotationHibernatedReplicas)
+		stsToUpdate.Spec.Replicas = ptr.To(int32(replicas))
+		return t.Client.Update(context.TODO(), stsToUpdate)
+	})
+	return utils.IgnoreNotFound(err)
+}
+
+// releaseGatedPods removes the wake-up scheduling gate of the pods once the runtime is awake
+func (t *TemplateEngine) releaseGatedPods(ctx cruntime.ReconcileRequestContext, pods []corev1.Pod) error {
+	for _, name := range t.getHibernationStatefulSetNames() {
+		sts, err := kubeclient.GetStatefulSet(t.Client, name, ctx.Namespace)
+		if err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return err
+		}
+		if !isStatefulSetAwake(sts) {
+			t.Log.V(1).Info("Wait for the statefulset to be awake before releasing the gated pods", "statefulset", name)
+			return nil
+		}
+	}
+
+	for i := range pods {
+		podToUpdate := pods[i].DeepCopy()
+		var gates []corev1.PodSchedulingGate
+		for _, gate := range podToUpdate.Spec.SchedulingGates {
+			if gate.Name != common.SchedulingGateRuntimeWakeUp {
+				gates = append(gates, gate)
+			}
+		}
+		podToUpdate.Spec.SchedulingGates = gates
+		if err := t.Client.Patch(context.TODO(), podToUpdate, client.MergeFrom(&pods[i])); utils.IgnoreNotFound(err) != nil {
+			return err
+		}
+		t.Log.Info("Released the pod waiting for the runtime to wake up", "pod", types.NamespacedName{Namespace: pods[i].Namespace, Name: pods[i].Name})
+	}
+	return nil
+}
+
+// getDatasetConsumers returns the number of the unfinished pods mounting the dataset and the pods among them
+// which are held by the wake-up scheduling gate.
+func (t *TemplateEngine) getDatasetConsumers(ctx cruntime.ReconcileRequestContext) (consumers int, gatedPods []corev1.Pod, err error) {
+	pods, err := kubeclient.GetPvcMountPods(t.Client, ctx.Name, ctx.Namespace)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, pod := range pods {
+		if kubeclient.IsFinishedPod(&pod) {
+			continue
+		}
+		consumers++
+		for _, gate := range pod.Spec.SchedulingGates {
+			if gate.Name == common.SchedulingGateRuntimeWakeUp {
+				gatedPods = append(gatedPods, pod)
+				break
+			}
+		}
+	}
+	return consumers, gatedPods, nil
+}
+
+// getHibernationStatefulSetNames returns the names of the worker statefulset and the master statefulset if any,
+// which are provided by the engine implementation.
+func (t *TemplateEngine) getHibernationStatefulSetNames() []string {
+	hibernator, ok := t.Implement.(Hibernator)
+	if !ok {
+		return nil
+	}
+	worker, master := hibernator.GetHibernationStatefulSetNames()
+	names := []string{worker}
+	if len(master) > 0 {
+		names = append(names, master)
+	}
+	return names
+}
+
+// updateHibernationStatus updates the status of the latest runtime with retries on conflict
+func (t *TemplateEngine) updateHibernationStatus(ctx cruntime.ReconcileRequestContext, mutate func(status *datav1alpha1.RuntimeStatus)) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, ok := ctx.Runtime.DeepCopyObject().(RuntimeInterface)
+		if !ok {
+			return fmt.Errorf("runtime %s/%s doesn't support hibernation", ctx.Namespace, ctx.Name)
+		}
+		if err := t.Client.Get(context.TODO(), types.NamespacedName{Namespace: ctx.Namespace, Name: ctx.Name}, runtime); err != nil {
+			return err
+		}
+		mutate(runtime.GetStatus())
+		return t.Client.Status().Update(context.TODO(), runtime)
+	})
+}
+
+// isWakeUpRequested checks if the wake-up is requested after the runtime hibernates, the request is recorded
+// by the webhook before the pods mounting the dataset are created.
+func isWakeUpRequested(runtime RuntimeInterface, hibernatedCond *datav1alpha1.RuntimeCondition) bool {
+	value, found := runtime.GetAnnotations()[common.AnnotationWakeUpRequested]
+	if !found {
+		return false
+	}
+	requestTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+	return !requestTime.Before(hibernatedCond.LastTransitionTime.Time)
+}
+
+// isStatefulSetAwake checks if the statefulset has ready replicas after waking up
+func isStatefulSetAwake(sts *appsv1.StatefulSet) bool {
+	if _, found := sts.Annotations[common.AnnotationHibernatedReplicas]; found {
+		return false
+	}
+	return ptr.Deref(sts.Spec.Replicas, 1) == 0 || sts.Status.ReadyReplicas > 0
+}
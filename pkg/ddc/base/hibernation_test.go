@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+// fakeHibernator is the engine implementation providing the statefulsets to hibernate
+type fakeHibernator struct {
+	Implement
+	worker string
+	master string
+}
+
+func (f *fakeHibernator) GetHibernationStatefulSetNames() (worker string, master string) {
+	return f.worker, f.master
+}
+
+var _ = Describe("Runtime hibernation", func() {
+	const (
+		name      = "hbase"
+		namespace = "fluid"
+	)
+
+	var (
+		alluxioRuntime *datav1alpha1.AlluxioRuntime
+		dataset        *datav1alpha1.Dataset
+		worker         *appsv1.StatefulSet
+		master         *appsv1.StatefulSet
+		objects        []runtime.Object
+		fakeClient     client.Client
+		engine         *TemplateEngine
+		impl           Implement
+	)
+
+	newPod := func(podName string, gated bool) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: namespace},
+			Spec: corev1.PodSpec{
+				Volumes: []corev1.Volume{{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: name},
+					},
+				}},
+			},
+		}
+		if gated {
+			pod.Spec.SchedulingGates = []corev1.PodSchedulingGate{{Name: common.SchedulingGateRuntimeWakeUp}}
+		}
+		return pod
+	}
+
+	hibernatedCondition := func(transitionTime time.Time) datav1alpha1.RuntimeCondition {
+		cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeHibernated, datav1alpha1.RuntimeHibernatedReason, "", corev1.ConditionTrue)
+		cond.LastTransitionTime = metav1.NewTime(transitionTime)
+		return cond
+	}
+
+	syncHibernation := func() (bool, error) {
+		s := runtime.NewScheme()
+		_ = datav1alpha1.AddToScheme(s)
+		_ = corev1.AddToScheme(s)
+		_ = appsv1.AddToScheme(s)
+		fakeClient = fake.NewFakeClientWithScheme(s, append(objects, alluxioRuntime, dataset, worker, master)...)
+		ctx := cruntime.ReconcileRequestContext{
+			Context:        context.TODO(),
+			NamespacedName: types.NamespacedName{Name: name, Namespace: namespace},
+			Client:         fakeClient,
+			Log:            fake.NullLogger(),
+			Recorder:       record.NewFakeRecorder(10),
+			Runtime:        alluxioRuntime,
+			Dataset:        dataset,
+		}
+		engine = &TemplateEngine{Implement: impl, Client: fakeClient, Log: fake.NullLogger(), Context: ctx}
+		return engine.syncHibernation(ctx)
+	}
+
+	getRuntimeStatus := func() datav1alpha1.RuntimeStatus {
+		got := &datav1alpha1.AlluxioRuntime{}
+		Expect(fakeClient.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, got)).To(Succeed())
+		return got.Status
+	}
+
+	getStatefulSet := func(stsName string) *appsv1.StatefulSet {
+		got := &appsv1.StatefulSet{}
+		Expect(fakeClient.Get(context.TODO(), types.NamespacedName{Name: stsName, Namespace: namespace}, got)).To(Succeed())
+		return got
+	}
+
+	BeforeEach(func() {
+		alluxioRuntime = &datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: datav1alpha1.AlluxioRuntimeSpec{
+				RuntimeManagement: datav1alpha1.RuntimeManagement{
+					HibernationPolicy: &datav1alpha1.HibernationPolicy{IdleMinutes: 30},
+				},
+			},
+		}
+		dataset = &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		worker = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-worker", Namespace: namespace},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](3)},
+		}
+		master = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-master", Namespace: namespace},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](1)},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1},
+		}
+		objects = nil
+		impl = &fakeHibernator{worker: worker.Name, master: master.Name}
+	})
+
+	It("should do nothing if the engine doesn't support hibernation", func() {
+		impl = nil
+		alluxioRuntime.Status.IdleSince = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+		hibernated, err := syncHibernation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+		Expect(*getStatefulSet(worker.Name).Spec.Replicas).To(Equal(int32(3)))
+	})
+
+	It("should do nothing without hibernation policy", func() {
+		alluxioRuntime.Spec.RuntimeManagement.HibernationPolicy = nil
+		hibernated, err := syncHibernation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+		Expect(getRuntimeStatus().IdleSince).To(BeNil())
+	})
+
+	It("should start counting the idle time when no pod mounts the dataset", func() {
+		hibernated, err := syncHibernation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+		Expect(getRuntimeStatus().IdleSince).NotTo(BeNil())
+	})
+
+	It("should reset the idle time when a pod mounts the dataset", func() {
+		alluxioRuntime.Status.IdleSince = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+		objects = append(objects, newPod("consumer", false))
+		hibernated, err := syncHibernation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+		Expect(getRuntimeStatus().IdleSince).To(BeNil())
+	})
+
+	It("should not hibernate before the idle minutes", func() {
+		alluxioRuntime.Status.IdleSince = ptr.To(metav1.NewTime(time.Now().Add(-10 * time.Minute)))
+		hibernated, err := syncHibernation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+		Expect(*getStatefulSet(worker.Name).Spec.Replicas).To(Equal(int32(3)))
+	})
+
+	It("should hibernate the workers after the idle minutes", func() {
+		alluxioRuntime.Status.IdleSince = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+		hibernated, err := syncHibernation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hibernated).To(BeTrue())
+
+		gotWorker := getStatefulSet(worker.Name)
+		Expect(*gotWorker.Spec.Replicas).To(Equal(int32(0)))
+		Expect(gotWorker.Annotations).To(HaveKeyWithValue(common.AnnotationHibernatedReplicas, "3"))
+		Expect(*getStatefulSet(master.Name).Spec.Replicas).To(Equal(int32(1)))
+
+		status := getRuntimeStatus()
+		Expect(IsRuntimeHibernated(&status)).To(BeTrue())
+		Expect(status.WorkerPhase).To(Equal(datav1alpha1.RuntimePhaseHibernated))
+		Expect(status.MasterPhase).NotTo(Equal(datav1alpha1.RuntimePhaseHibernated))
+	})
+
+	It("should hibernate the masters if required", func() {
+		alluxioRuntime.Spec.RuntimeManagement.HibernationPolicy.HibernateMaster = true
+		alluxioRuntime.Status.IdleSince = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+		hibernated, err := syncHibernation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hibernated).To(BeTrue())
+		Expect(*getStatefulSet(master.Name).Spec.Replicas).To(Equal(int32(0)))
+		Expect(getRuntimeStatus().MasterPhase).To(Equal(datav1alpha1.RuntimePhaseHibernated))
+	})
+
+	It("should only hibernate the workers if the runtime has no master", func() {
+		impl = &fakeHibernator{worker: worker.Name}
+		alluxioRuntime.Spec.RuntimeManagement.HibernationPolicy.HibernateMaster = true
+		alluxioRuntime.Status.IdleSince = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+		hibernated, err := syncHibernation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hibernated).To(BeTrue())
+		Expect(*getStatefulSet(worker.Name).Spec.Replicas).To(Equal(int32(0)))
+		Expect(*getStatefulSet(master.Name).Spec.Replicas).To(Equal(int32(1)))
+	})
+
+	It("should not hibernate when the dataset is under operation", func() {
+		alluxioRuntime.Status.IdleSince = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+		dataset.Status.OperationRef = map[string]string{"DataLoad": "warmup"}
+		hibernated, err := syncHibernation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+		Expect(*getStatefulSet(worker.Name).Spec.Replicas).To(Equal(int32(3)))
+	})
+
+	Context("when the runtime is hibernated", func() {
+		BeforeEach(func() {
+			alluxioRuntime.Status.Conditions = []datav1alpha1.RuntimeCondition{hibernatedCondition(time.Now().Add(-time.Minute))}
+			alluxioRuntime.Status.WorkerPhase = datav1alpha1.RuntimePhaseHibernated
+			worker.Annotations = map[string]string{common.AnnotationHibernatedReplicas: "3"}
+			worker.Spec.Replicas = ptr.To[int32](0)
+		})
+
+		It("should stay hibernated and keep the workers scaled to zero", func() {
+			worker.Spec.Replicas = ptr.To[int32](2)
+			hibernated, err := syncHibernation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hibernated).To(BeTrue())
+			Expect(*getStatefulSet(worker.Name).Spec.Replicas).To(Equal(int32(0)))
+			Expect(getStatefulSet(worker.Name).Annotations).To(HaveKeyWithValue(common.AnnotationHibernatedReplicas, "3"))
+		})
+
+		It("should wake up when a pod mounts the dataset", func() {
+			objects = append(objects, newPod("consumer", true))
+			hibernated, err := syncHibernation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hibernated).To(BeFalse())
+
+			gotWorker := getStatefulSet(worker.Name)
+			Expect(*gotWorker.Spec.Replicas).To(Equal(int32(3)))
+			Expect(gotWorker.Annotations).NotTo(HaveKey(common.AnnotationHibernatedReplicas))
+
+			status := getRuntimeStatus()
+			Expect(IsRuntimeHibernated(&status)).To(BeFalse())
+			Expect(status.WorkerPhase).To(Equal(datav1alpha1.RuntimePhaseNotReady))
+		})
+
+		It("should wake up when the wake-up is requested", func() {
+			alluxioRuntime.Annotations = map[string]string{common.AnnotationWakeUpRequested: time.Now().UTC().Format(time.RFC3339)}
+			hibernated, err := syncHibernation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hibernated).To(BeFalse())
+			Expect(*getStatefulSet(worker.Name).Spec.Replicas).To(Equal(int32(3)))
+		})
+
+		It("should ignore the wake-up requested before hibernation", func() {
+			alluxioRuntime.Annotations = map[string]string{common.AnnotationWakeUpRequested: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)}
+			hibernated, err := syncHibernation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hibernated).To(BeTrue())
+		})
+
+		It("should wake up when the hibernation policy is removed", func() {
+			alluxioRuntime.Spec.RuntimeManagement.HibernationPolicy = nil
+			hibernated, err := syncHibernation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hibernated).To(BeFalse())
+			Expect(*getStatefulSet(worker.Name).Spec.Replicas).To(Equal(int32(3)))
+		})
+	})
+
+	Context("when pods wait for the runtime to wake up", func() {
+		BeforeEach(func() {
+			objects = append(objects, newPod("consumer", true))
+		})
+
+		It("should hold the pods until the workers are ready", func() {
+			_, err := syncHibernation()
+			Expect(err).NotTo(HaveOccurred())
+
+			pod := &corev1.Pod{}
+			Expect(fakeClient.Get(context.TODO(), types.NamespacedName{Name: "consumer", Namespace: namespace}, pod)).To(Succeed())
+			Expect(pod.Spec.SchedulingGates).To(HaveLen(1))
+		})
+
+		It("should release the pods when the workers are ready", func() {
+			worker.Status.ReadyReplicas = 1
+			_, err := syncHibernation()
+			Expect(err).NotTo(HaveOccurred())
+
+			pod := &corev1.Pod{}
+			Expect(fakeClient.Get(context.TODO(), types.NamespacedName{Name: "consumer", Namespace: namespace}, pod)).To(Succeed())
+			Expect(pod.Spec.SchedulingGates).To(BeEmpty())
+		})
+	})
+})
@@ -30,6 +30,8 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -62,6 +64,8 @@ func (e *EngineOperationReconciler) ReconcileOperation(ctx cruntime.ReconcileReq
 		return e.reconcileComplete(ctx, opStatus, operation)
 	case common.PhaseFailed:
 		return e.reconcileFailed(ctx, opStatus, operation)
+	case common.PhaseCancelled:
+		return e.reconcileCancelled(ctx, opStatus, operation)
 	default:
 		ctx.Log.Error(fmt.Errorf("unknown phase"), "won't reconcile it", "phase", opStatus.Phase)
 		return utils.NoRequeue()
@@ -119,7 +123,16 @@ func (e *EngineOperationReconciler) reconcilePending(ctx cruntime.ReconcileReque
 		return utils.NoRequeue()
 	}
 
-	// 2. set current data operation to dataset
+	// 2. wait until the data operation is resumed, the target dataset is not locked while suspended
+	if operation.IsSuspended() {
+		// when the data operation is resumed, a new reconciliation loop will be triggered, so no requeue here.
+		object := operation.GetOperationObject()
+		ctx.Recorder.Eventf(object, v1.EventTypeNormal, common.DataOperationSuspended, "%s %s is suspended", operation.GetOperationType(), object.GetName())
+		log.V(1).Info("data operation is suspended, wait until it's resumed")
+		return utils.NoRequeue()
+	}
+
+	// 3. set current data operation to dataset
 	err := SetDataOperationInTargetDataset(ctx, operation, e.Engine)
 	if err != nil {
 		return utils.RequeueAfterInterval(20 * time.Second)
@@ -127,6 +140,8 @@ func (e *EngineOperationReconciler) reconcilePending(ctx cruntime.ReconcileReque
 
 	log.Info("Set data operation on target dataset, try to update phase")
 	opStatus.Phase = common.PhaseExecuting
+	opStatus.Attempts = 1
+	opStatus.StartTime = &metav1.Time{Time: time.Now()}
 	if err = operation.UpdateOperationApiStatus(opStatus); err != nil {
 		log.Error(err, fmt.Sprintf("failed to update %s status to Executing, will retry", operation.GetOperationType()))
 		return utils.RequeueIfError(err)
@@ -140,7 +155,18 @@ func (e *EngineOperationReconciler) reconcileExecuting(ctx cruntime.ReconcileReq
 	operation dataoperation.OperationInterface) (ctrl.Result, error) {
 	log := ctx.Log.WithName("reconcileExecuting")
 
-	// 1. Install the helm chart if not exists
+	// data operations like DataEvict are executed by the engine in the runtime pods, no helm chart to install
+	if dataoperation.IsExecutedByEngine(operation.GetOperationType()) {
+		return e.reconcileExecutingByEngine(ctx, opStatus, operation)
+	}
+
+	// 1. Terminate the data operation if it exceeds the active deadline
+	remaining, hasDeadline := operation.GetRetryPolicy().GetRemainingTime(opStatus.StartTime, time.Now())
+	if hasDeadline && remaining <= 0 {
+		return e.terminateExceededOperation(ctx, opStatus, operation)
+	}
+
+	// 2. Install the helm chart if not exists
 	err := InstallDataOperationHelmIfNotExist(ctx, operation, e.Engine)
 	if err != nil {
 		object := operation.GetOperationObject()
@@ -151,6 +177,8 @@ func (e *EngineOperationReconciler) reconcileExecuting(ctx cruntime.ReconcileReq
 				"RuntimeType %s not support %s", ctx.RuntimeType, operation.GetOperationType())
 
 			opStatus.Phase = common.PhaseFailed
+			// retrying doesn't help if the runtime does not support it
+			opStatus.Conditions = []datav1alpha1.Condition{newFailedCondition(common.DataOperationNotSupport, err.Error())}
 			if err = operation.UpdateOperationApiStatus(opStatus); err != nil {
 				log.Error(err, "failed to update api status")
 				return utils.RequeueIfError(err)
@@ -163,7 +191,7 @@ func (e *EngineOperationReconciler) reconcileExecuting(ctx cruntime.ReconcileReq
 		return utils.RequeueAfterInterval(20 * time.Second)
 	}
 
-	// 2. update data operation's status by helm status
+	// 3. update data operation's status by helm status
 	statusHandler := operation.GetStatusHandler()
 	if statusHandler == nil {
 		err = fmt.Errorf("fail to get status handler")
@@ -183,9 +211,132 @@ func (e *EngineOperationReconciler) reconcileExecuting(ctx cruntime.ReconcileReq
 		log.V(1).Info(fmt.Sprintf("update operation status to %s successfully", opStatusToUpdate.Phase), "opstatus", opStatusToUpdate)
 	}
 
+	// requeue before the active deadline to terminate the data operation in time
+	if hasDeadline && remaining < 20*time.Second {
+		return utils.RequeueAfterInterval(remaining)
+	}
 	return utils.RequeueAfterInterval(20 * time.Second)
 }
 
+// terminateExceededOperation deletes the job of the data operation which exceeds the active deadline and marks it as failed.
+func (e *EngineOperationReconciler) terminateExceededOperation(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus,
+	operation dataoperation.OperationInterface) (ctrl.Result, error) {
+	log := ctx.Log.WithName("terminateExceededOperation")
+	object := operation.GetOperationObject()
+
+	if err := DeleteDataOperationHelmIfExists(ctx, operation); err != nil {
+		return utils.RequeueIfError(err)
+	}
+
+	message := fmt.Sprintf("%s has been executing for longer than the active deadline %s",
+		operation.GetOperationType(), operation.GetRetryPolicy().ActiveDeadline)
+	ctx.Recorder.Event(object, v1.EventTypeWarning, common.DataOperationDeadlineExceeded, message)
+
+	opStatusToUpdate := opStatus.DeepCopy()
+	opStatusToUpdate.Phase = common.PhaseFailed
+	opStatusToUpdate.Conditions = []datav1alpha1.Condition{newFailedCondition(common.DataOperationDeadlineExceeded, message)}
+	opStatusToUpdate.Duration = utils.CalculateDuration(opStatus.StartTime.Time, time.Now())
+	if err := operation.UpdateOperationApiStatus(opStatusToUpdate); err != nil {
+		log.Error(err, "failed to update api status")
+		return utils.RequeueIfError(err)
+	}
+	log.Info("data operation exceeds the active deadline, set status to failed", "startTime", opStatus.StartTime)
+	// update operation status would trigger requeue, no need to requeue here
+	return utils.NoRequeue()
+}
+
+// newFailedCondition builds the condition for the data operation failed with the reason.
+func newFailedCondition(reason, message string) datav1alpha1.Condition {
+	now := metav1.Now()
+	return datav1alpha1.Condition{
+		Type:               common.Failed,
+		Status:             v1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	}
+}
+
+// reconcileExecutingByEngine executes the data operation in the runtime pods and updates the status with the result directly,
+// because there is no job to check the status of. The execution runs in the background, the reconciliation requeues until it finishes.
+func (e *EngineOperationReconciler) reconcileExecutingByEngine(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus,
+	operation dataoperation.OperationInterface) (ctrl.Result, error) {
+	log := ctx.Log.WithName("reconcileExecutingByEngine")
+	object := operation.GetOperationObject()
+
+	startTime := metav1.Now()
+	var infos map[string]string
+	var err error
+	if executor, ok := e.Engine.(DataOperationExecutor); ok {
+		// execute in the background so that a slow engine doesn't block the controller worker
+		execution, finished := executeDataOperationInBackground(ctx, executor, operation)
+		if !finished {
+			log.V(1).Info("data operation is still being executed by the engine, requeue", "startTime", execution.startTime)
+			return utils.RequeueAfterInterval(20 * time.Second)
+		}
+		startTime = metav1.NewTime(execution.startTime)
+		infos, err = execution.infos, execution.err
+	} else {
+		err = fluiderrs.NewNotSupported(schema.GroupResource{
+			Group:    object.GetObjectKind().GroupVersionKind().Group,
+			Resource: object.GetObjectKind().GroupVersionKind().Kind,
+		}, ctx.RuntimeType)
+	}
+
+	// runtime does not support current data operation, set status to failed
+	if fluiderrs.IsNotSupported(err) {
+		log.Error(err, "not support current data operation, set status to failed")
+		ctx.Recorder.Eventf(object, v1.EventTypeWarning, common.DataOperationNotSupport,
+			"RuntimeType %s not support %s", ctx.RuntimeType, operation.GetOperationType())
+
+		opStatus.Phase = common.PhaseFailed
+		opStatus.Conditions = []datav1alpha1.Condition{newFailedCondition(common.DataOperationNotSupport, err.Error())}
+		if err = operation.UpdateOperationApiStatus(opStatus); err != nil {
+			log.Error(err, "failed to update api status")
+			return utils.RequeueIfError(err)
+		}
+		return utils.NoRequeue()
+	}
+
+	opStatusToUpdate := opStatus.DeepCopy()
+	finishTime := metav1.Now()
+	condition := datav1alpha1.Condition{
+		Status:             v1.ConditionTrue,
+		LastProbeTime:      finishTime,
+		LastTransitionTime: finishTime,
+	}
+	if err != nil {
+		log.Error(err, "failed to execute data operation")
+		ctx.Recorder.Eventf(object, v1.EventTypeWarning, common.DataOperationExecutionFailed, "fail to execute data operation: %v", err)
+		condition.Type = common.Failed
+		condition.Reason = common.DataOperationExecutionFailed
+		condition.Message = err.Error()
+		opStatusToUpdate.Phase = common.PhaseFailed
+	} else {
+		condition.Type = common.Complete
+		opStatusToUpdate.Phase = common.PhaseComplete
+		opStatusToUpdate.LastSuccessfulTime = &finishTime
+		if opStatusToUpdate.Infos == nil {
+			opStatusToUpdate.Infos = map[string]string{}
+		}
+		for key, value := range infos {
+			opStatusToUpdate.Infos[key] = value
+		}
+	}
+	opStatusToUpdate.Conditions = []datav1alpha1.Condition{condition}
+	opStatusToUpdate.LastScheduleTime = &startTime
+	opStatusToUpdate.Duration = utils.CalculateDuration(startTime.Time, finishTime.Time)
+
+	if err = operation.UpdateOperationApiStatus(opStatusToUpdate); err != nil {
+		log.Error(err, "failed to update api status")
+		return utils.RequeueIfError(err)
+	}
+	log.V(1).Info(fmt.Sprintf("update operation status to %s successfully", opStatusToUpdate.Phase), "opstatus", opStatusToUpdate)
+	// update operation status would trigger requeue, no need to requeue here
+	return utils.NoRequeue()
+}
+
 func (e *EngineOperationReconciler) reconcileComplete(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus,
 	operation dataoperation.OperationInterface) (ctrl.Result, error) {
 	log := ctx.Log.WithName("reconcileComplete")
@@ -257,8 +408,9 @@ func (e *EngineOperationReconciler) reconcileComplete(ctx cruntime.ReconcileRequ
 		// if the status not Complete, there is a new starting job, not scale the statefulset to zero.
 		if operation.GetParallelTaskNumber() > 1 {
 			releaseNameSpacedName := operation.GetReleaseNameSpacedName()
+			// the runtime may distribute the parallel tasks by itself without the workers statefulset, e.g. Alluxio
 			err = kubeclient.ScaleStatefulSet(client, utils.GetParallelOperationWorkersName(releaseNameSpacedName.Name), releaseNameSpacedName.Namespace, 0)
-			if err != nil {
+			if utils.IgnoreNotFound(err) != nil {
 				return utils.RequeueIfError(err)
 			}
 		}
@@ -298,7 +450,12 @@ func (e *EngineOperationReconciler) reconcileFailed(ctx cruntime.ReconcileReques
 
 	client := e.Client
 
-	// 0. clean up if ttl after finished expired
+	// 0. retry the data operation if it has not reached the backoff limit, the target dataset is kept locked
+	if canRetry(opStatus, operation.GetRetryPolicy()) {
+		return e.retryFailedOperation(ctx, opStatus, operation)
+	}
+
+	// 1. clean up if ttl after finished expired
 	var ttl *time.Duration
 	if utils.NeedCleanUp(opStatus, operation) {
 		var err error
@@ -311,13 +468,13 @@ func (e *EngineOperationReconciler) reconcileFailed(ctx cruntime.ReconcileReques
 		}
 	}
 
-	// 1. remove current data operation on target dataset
+	// 2. remove current data operation on target dataset
 	err := ReleaseTargetDataset(ctx, operation)
 	if err != nil {
 		return utils.RequeueIfError(err)
 	}
 
-	// 2. check and update data operation's status by helm status
+	// 3. check and update data operation's status by helm status
 	statusHandler := operation.GetStatusHandler()
 	if statusHandler == nil {
 		err := fmt.Errorf("fail to get status handler")
@@ -337,7 +494,7 @@ func (e *EngineOperationReconciler) reconcileFailed(ctx cruntime.ReconcileReques
 		log.V(1).Info(fmt.Sprintf("update operation status to %s successfully", opStatusToUpdate.Phase), "opstatus", opStatusToUpdate)
 	}
 
-	// 2. record and no requeue
+	// 4. record and no requeue
 	// For cron operations, the phase may be updated to pending here, and we only log bellow messages in failed phase
 	if opStatusToUpdate.Phase == common.PhaseFailed {
 		object := operation.GetOperationObject()
@@ -347,17 +504,96 @@ func (e *EngineOperationReconciler) reconcileFailed(ctx cruntime.ReconcileReques
 		// if the status not PhaseFailed, there is a new starting job, not scale the statefulset to zero.
 		if operation.GetParallelTaskNumber() > 1 {
 			releaseNameSpacedName := operation.GetReleaseNameSpacedName()
+			// the runtime may distribute the parallel tasks by itself without the workers statefulset, e.g. Alluxio
 			err = kubeclient.ScaleStatefulSet(client, utils.GetParallelOperationWorkersName(releaseNameSpacedName.Name), releaseNameSpacedName.Namespace, 0)
-			if err != nil {
+			if utils.IgnoreNotFound(err) != nil {
 				return utils.RequeueIfError(err)
 			}
 		}
 	}
 
-	// 3. Requeue if data operation set ttl after finished and has not expired
+	// 5. Requeue if data operation set ttl after finished and has not expired
 	if ttl != nil && *ttl > 0 {
 		log.V(1).Info("get remaining time to clean up data operation", "timeToLive", ttl)
 		return utils.RequeueAfterInterval(*ttl)
 	}
 	return utils.NoRequeue()
 }
+
+// canRetry checks if the failed data operation can be retried. The data operations failed before executing, not
+// supported by the runtime or exceeding the active deadline are not retried.
+func canRetry(opStatus *datav1alpha1.OperationStatus, policy dataoperation.RetryPolicy) bool {
+	if !policy.CanRetry(opStatus.Attempts) {
+		return false
+	}
+	for _, condition := range opStatus.Conditions {
+		if condition.Reason == common.DataOperationNotSupport || condition.Reason == common.DataOperationDeadlineExceeded {
+			return false
+		}
+	}
+	return true
+}
+
+// retryFailedOperation cleans up the helm release of the failed attempt and executes the data operation again after the retry backoff.
+func (e *EngineOperationReconciler) retryFailedOperation(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus,
+	operation dataoperation.OperationInterface) (ctrl.Result, error) {
+	log := ctx.Log.WithName("retryFailedOperation")
+	policy := operation.GetRetryPolicy()
+
+	// 1. wait for the retry backoff since the last failure
+	failedTime := time.Now()
+	for _, condition := range opStatus.Conditions {
+		if condition.Type == common.Failed && !condition.LastTransitionTime.IsZero() {
+			failedTime = condition.LastTransitionTime.Time
+		}
+	}
+	delay := policy.GetRetryDelay(opStatus.Attempts)
+	if wait := time.Until(failedTime.Add(delay)); wait > 0 {
+		// the retry backoff is aligned to seconds as the condition time is serialized in seconds
+		log.V(1).Info("wait for the retry backoff", "attempts", opStatus.Attempts, "wait", wait)
+		return utils.RequeueAfterInterval(wait)
+	}
+
+	// 2. clean up the helm release of the failed attempt
+	if err := DeleteDataOperationHelmIfExists(ctx, operation); err != nil {
+		return utils.RequeueIfError(err)
+	}
+
+	// 3. execute the data operation again
+	object := operation.GetOperationObject()
+	opStatusToUpdate := opStatus.DeepCopy()
+	opStatusToUpdate.Attempts++
+	opStatusToUpdate.Phase = common.PhaseExecuting
+	opStatusToUpdate.Duration = "Unfinished"
+	opStatusToUpdate.Progress = nil
+	if err := operation.UpdateOperationApiStatus(opStatusToUpdate); err != nil {
+		log.Error(err, "failed to update api status")
+		return utils.RequeueIfError(err)
+	}
+	ctx.Recorder.Eventf(object, v1.EventTypeNormal, common.DataOperationRetrying, "Retry %s %s, attempt %d of %d",
+		operation.GetOperationType(), object.GetName(), opStatusToUpdate.Attempts, policy.BackoffLimit+1)
+	log.Info("retry the failed data operation", "attempts", opStatusToUpdate.Attempts)
+	// update operation status would trigger requeue, no need to requeue here
+	return utils.NoRequeue()
+}
+
+// reconcileCancelled cleans up the cancelled data operation after its ttl, the job and the lock on the target dataset
+// have been removed when it's cancelled.
+func (e *EngineOperationReconciler) reconcileCancelled(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus,
+	operation dataoperation.OperationInterface) (ctrl.Result, error) {
+	log := ctx.Log.WithName("reconcileCancelled")
+
+	if !utils.NeedCleanUp(opStatus, operation) {
+		return utils.NoRequeue()
+	}
+
+	ttl, err := processTTL(opStatus, operation, log, e.Client)
+	if err != nil {
+		return utils.RequeueIfError(err)
+	}
+	if ttl != nil && *ttl > 0 {
+		log.V(1).Info("requeue after remaining time to clean up data operation", "timeToLive", ttl)
+		return utils.RequeueAfterInterval(*ttl)
+	}
+	return utils.NoRequeue()
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+)
+
+// dataOperationExecutionWaitTimeout is how long a reconciliation waits for the data operation executed by the engine
+// before it gives up the controller worker and requeues.
+var dataOperationExecutionWaitTimeout = 5 * time.Second
+
+// dataOperationExecutions tracks the data operations being executed by the engines in the background,
+// keyed by getDataOperationExecutionKey. The reconcilers are created per request, so it lives at package level.
+var dataOperationExecutions sync.Map
+
+// dataOperationExecution is the result of a data operation executed by the engine in the background.
+type dataOperationExecution struct {
+	startTime time.Time
+	done      chan struct{}
+	infos     map[string]string
+	err       error
+}
+
+func getDataOperationExecutionKey(operation dataoperation.OperationInterface) string {
+	object := operation.GetOperationObject()
+	return fmt.Sprintf("%s/%s/%s/%s", operation.GetOperationType(), object.GetNamespace(), object.GetName(), object.GetUID())
+}
+
+// executeDataOperationInBackground starts executing the data operation with the executor unless it's already running,
+// and waits at most dataOperationExecutionWaitTimeout for it. It returns finished=false if the execution is still running,
+// the caller should requeue and call it again later to get the result.
+func executeDataOperationInBackground(ctx cruntime.ReconcileRequestContext, executor DataOperationExecutor,
+	operation dataoperation.OperationInterface) (execution *dataOperationExecution, finished bool) {
+	key := getDataOperationExecutionKey(operation)
+	value, loaded := dataOperationExecutions.LoadOrStore(key, &dataOperationExecution{
+		startTime: time.Now(),
+		done:      make(chan struct{}),
+	})
+	execution = value.(*dataOperationExecution)
+	if !loaded {
+		go func() {
+			defer close(execution.done)
+			execution.infos, execution.err = executor.ExecuteDataOperation(ctx, operation)
+		}()
+	}
+
+	select {
+	case <-execution.done:
+		dataOperationExecutions.Delete(key)
+		return execution, true
+	case <-time.After(dataOperationExecutionWaitTimeout):
+		return execution, false
+	}
+}
@@ -68,3 +68,15 @@ func InstallDataOperationHelmIfNotExist(ctx cruntime.ReconcileRequestContext, op
 
 	return nil
 }
+
+// DeleteDataOperationHelmIfExists deletes the helm release of the data operation, which removes the job of the
+// data operation, e.g. before retrying the data operation or when it exceeds the active deadline.
+func DeleteDataOperationHelmIfExists(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface) (err error) {
+	releaseNamespacedName := operation.GetReleaseNameSpacedName()
+	err = helm.DeleteReleaseIfExists(releaseNamespacedName.Name, releaseNamespacedName.Namespace)
+	if err != nil {
+		ctx.Log.Error(err, "failed to delete release", "releaseName", releaseNamespacedName.Name,
+			"namespace", releaseNamespacedName.Namespace)
+	}
+	return err
+}
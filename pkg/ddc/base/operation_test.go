@@ -19,6 +19,7 @@ package base_test
 import (
 	"errors"
 	"os"
+	"time"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
@@ -27,6 +28,9 @@ import (
 	enginemock "github.com/fluid-cloudnative/fluid/pkg/ddc/base/mock"
 	"github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+
+	"github.com/agiledragon/gomonkey/v2"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -143,20 +147,243 @@ var _ = Describe("Operate", func() {
 			})
 
 		})
+
+		Context("when phase is Executing and the operation is executed by engine", func() {
+			BeforeEach(func() {
+				opStatus.Phase = common.PhaseExecuting
+				operation.opType = dataoperation.DataEvictType
+			})
+
+			It("should fail when the engine does not support executing data operations", func() {
+				result, err := t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Requeue).To(BeFalse())
+				Expect(opStatus.Phase).To(Equal(common.PhaseFailed))
+				Expect(opStatus.Conditions).To(HaveLen(1))
+				Expect(opStatus.Conditions[0].Type).To(Equal(common.Failed))
+				Expect(opStatus.Conditions[0].Reason).To(Equal(common.DataOperationNotSupport))
+			})
+
+			It("should complete with the infos returned by the engine", func() {
+				executor := &mockExecutorImplement{MockImplement: impl, infos: map[string]string{"EvictedBytes": "1024"}}
+				t = base.NewTemplateEngine(executor, "test-engine", fakeCtx)
+
+				result, err := t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Requeue).To(BeFalse())
+				Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseComplete))
+				Expect(operation.updatedStatus.Infos).To(HaveKeyWithValue("EvictedBytes", "1024"))
+				Expect(operation.updatedStatus.Conditions).To(HaveLen(1))
+				Expect(operation.updatedStatus.Conditions[0].Type).To(Equal(common.Complete))
+				Expect(operation.updatedStatus.LastScheduleTime).NotTo(BeNil())
+				Expect(operation.updatedStatus.LastSuccessfulTime).NotTo(BeNil())
+			})
+
+			It("should fail with the error message when the engine fails to execute", func() {
+				executor := &mockExecutorImplement{MockImplement: impl, err: errors.New("exec failed")}
+				t = base.NewTemplateEngine(executor, "test-engine", fakeCtx)
+
+				result, err := t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Requeue).To(BeFalse())
+				Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseFailed))
+				Expect(operation.updatedStatus.Conditions).To(HaveLen(1))
+				Expect(operation.updatedStatus.Conditions[0].Type).To(Equal(common.Failed))
+				Expect(operation.updatedStatus.Conditions[0].Message).To(Equal("exec failed"))
+				Expect(operation.updatedStatus.LastSuccessfulTime).To(BeNil())
+			})
+
+			It("should requeue without blocking until the engine finishes executing", func() {
+				release := make(chan struct{})
+				executor := &mockExecutorImplement{MockImplement: impl, infos: map[string]string{"EvictedBytes": "1024"}, release: release}
+				t = base.NewTemplateEngine(executor, "test-engine", fakeCtx)
+
+				result, err := t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(20 * time.Second))
+				Expect(operation.updatedStatus).To(BeNil())
+
+				close(release)
+				result, err = t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Requeue).To(BeFalse())
+				Expect(executor.executions).To(Equal(1))
+				Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseComplete))
+				Expect(operation.updatedStatus.Infos).To(HaveKeyWithValue("EvictedBytes", "1024"))
+			})
+		})
+	})
+
+	Describe("Suspend and cancel", func() {
+		It("should wait in Pending without locking the dataset when suspended", func() {
+			opStatus.Phase = common.PhasePending
+			operation.suspended = true
+
+			result, err := t.Operate(fakeCtx, opStatus, operation)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeFalse())
+			Expect(result.RequeueAfter).To(BeZero())
+			Expect(operation.updatedStatus).To(BeNil())
+		})
+
+		It("should do nothing for the cancelled data operation without ttl", func() {
+			opStatus.Phase = common.PhaseCancelled
+			opStatus.Conditions = []datav1alpha1.Condition{{Type: common.Cancelled}}
+
+			result, err := t.Operate(fakeCtx, opStatus, operation)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeFalse())
+			Expect(operation.updatedStatus).To(BeNil())
+		})
+	})
+
+	Describe("Retry and active deadline", func() {
+		var (
+			patches        *gomonkey.Patches
+			deletedRelease bool
+		)
+
+		BeforeEach(func() {
+			deletedRelease = false
+			patches = gomonkey.ApplyFunc(helm.DeleteReleaseIfExists, func(name, namespace string) error {
+				deletedRelease = true
+				return nil
+			})
+		})
+
+		AfterEach(func() {
+			patches.Reset()
+		})
+
+		Context("when phase is Executing", func() {
+			It("should fail and clean up the release when the active deadline is exceeded", func() {
+				operation.retryPolicy = dataoperation.RetryPolicy{BackoffLimit: 3, ActiveDeadline: time.Minute}
+				opStatus.Phase = common.PhaseExecuting
+				opStatus.Attempts = 1
+				opStatus.StartTime = &metav1.Time{Time: time.Now().Add(-2 * time.Minute)}
+
+				result, err := t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Requeue).To(BeFalse())
+				Expect(deletedRelease).To(BeTrue())
+				Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseFailed))
+				Expect(operation.updatedStatus.Conditions).To(HaveLen(1))
+				Expect(operation.updatedStatus.Conditions[0].Reason).To(Equal(common.DataOperationDeadlineExceeded))
+			})
+		})
+
+		Context("when phase is Failed", func() {
+			BeforeEach(func() {
+				operation.retryPolicy = dataoperation.RetryPolicy{BackoffLimit: 2, RetryBackoff: time.Minute}
+				opStatus.Phase = common.PhaseFailed
+				opStatus.Attempts = 1
+			})
+
+			It("should wait for the retry backoff", func() {
+				opStatus.Conditions = []datav1alpha1.Condition{{
+					Type:               common.Failed,
+					LastTransitionTime: metav1.Now(),
+				}}
+
+				result, err := t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+				Expect(result.RequeueAfter).To(BeNumerically("<=", time.Minute))
+				Expect(deletedRelease).To(BeFalse())
+				Expect(operation.updatedStatus).To(BeNil())
+			})
+
+			It("should clean up the release and execute again after the retry backoff", func() {
+				opStatus.Conditions = []datav1alpha1.Condition{{
+					Type:               common.Failed,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+				}}
+
+				result, err := t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Requeue).To(BeFalse())
+				Expect(deletedRelease).To(BeTrue())
+				Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseExecuting))
+				Expect(operation.updatedStatus.Attempts).To(Equal(int32(2)))
+				Expect(operation.updatedStatus.Duration).To(Equal("Unfinished"))
+			})
+
+			It("should not retry when the active deadline is exceeded", func() {
+				opStatus.Conditions = []datav1alpha1.Condition{{
+					Type:   common.Failed,
+					Reason: common.DataOperationDeadlineExceeded,
+				}}
+
+				// falls through to check the status of the failed data operation
+				_, err := t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).To(MatchError("fail to get status handler"))
+				Expect(deletedRelease).To(BeFalse())
+				Expect(operation.updatedStatus).To(BeNil())
+			})
+
+			It("should not retry when the backoff limit is reached", func() {
+				opStatus.Attempts = 3
+				opStatus.Conditions = []datav1alpha1.Condition{{
+					Type:               common.Failed,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+				}}
+
+				// falls through to check the status of the failed data operation
+				_, err := t.Operate(fakeCtx, opStatus, operation)
+
+				Expect(err).To(MatchError("fail to get status handler"))
+				Expect(deletedRelease).To(BeFalse())
+				Expect(operation.updatedStatus).To(BeNil())
+			})
+		})
 	})
 })
 
+// mockExecutorImplement is a mock Implement which executes data operations in the runtime pods
+type mockExecutorImplement struct {
+	*enginemock.MockImplement
+	infos map[string]string
+	err   error
+	// release blocks the execution until it's closed if set
+	release    chan struct{}
+	executions int
+}
+
+func (m *mockExecutorImplement) ExecuteDataOperation(ctx runtime.ReconcileRequestContext, operation dataoperation.OperationInterface) (map[string]string, error) {
+	m.executions++
+	if m.release != nil {
+		<-m.release
+	}
+	return m.infos, m.err
+}
+
 // mockOperation implements dataoperation.OperationInterface for testing
 type mockOperation struct {
 	validateErr     error
 	updateStatusErr error
 	hasPreceding    bool
 	parallelTasks   int32
+	opType          dataoperation.OperationType
+	updatedStatus   *datav1alpha1.OperationStatus
+	retryPolicy     dataoperation.RetryPolicy
+	suspended       bool
 }
 
 func newMockOperation() *mockOperation {
 	return &mockOperation{
 		parallelTasks: 1,
+		opType:        dataoperation.DataLoadType,
 	}
 }
 
@@ -197,10 +424,11 @@ func (m *mockOperation) GetChartsDirectory() string {
 }
 
 func (m *mockOperation) GetOperationType() dataoperation.OperationType {
-	return dataoperation.DataLoadType
+	return m.opType
 }
 
 func (m *mockOperation) UpdateOperationApiStatus(opStatus *datav1alpha1.OperationStatus) error {
+	m.updatedStatus = opStatus
 	return m.updateStatusErr
 }
 
@@ -234,3 +462,11 @@ func (m *mockOperation) GetTTL() (ttl *int32, err error) {
 func (m *mockOperation) GetParallelTaskNumber() int32 {
 	return m.parallelTasks
 }
+
+func (m *mockOperation) GetRetryPolicy() dataoperation.RetryPolicy {
+	return m.retryPolicy
+}
+
+func (m *mockOperation) IsSuspended() bool {
+	return m.suspended
+}
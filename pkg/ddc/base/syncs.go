@@ -37,6 +37,12 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 
 	defer utils.TimeTrack(time.Now(), "base.Sync", "ctx", ctx)
 
+	// 0. Hibernate the runtime if its dataset is idle, or wake it up
+	hibernated, err := t.syncHibernation(ctx)
+	if err != nil || hibernated {
+		return
+	}
+
 	if permitSyncEngineStatus {
 		err = t.Implement.SyncMetadata()
 		if err != nil {
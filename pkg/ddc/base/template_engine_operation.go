@@ -19,7 +19,9 @@ package base
 import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	fluiderrs "github.com/fluid-cloudnative/fluid/pkg/errors"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -41,3 +43,17 @@ func (t *TemplateEngine) Operate(ctx cruntime.ReconcileRequestContext, opStatus
 func (t *TemplateEngine) CheckRuntimeReady() bool {
 	return t.Implement.CheckRuntimeReady()
 }
+
+// ExecuteDataOperation executes the data operation by the real engine if it implements DataOperationExecutor
+func (t *TemplateEngine) ExecuteDataOperation(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface) (infos map[string]string, err error) {
+	executor, ok := t.Implement.(DataOperationExecutor)
+	if !ok {
+		object := operation.GetOperationObject()
+		return nil, fluiderrs.NewNotSupported(
+			schema.GroupResource{
+				Group:    object.GetObjectKind().GroupVersionKind().Group,
+				Resource: object.GetObjectKind().GroupVersionKind().Kind,
+			}, ctx.RuntimeType)
+	}
+	return executor.ExecuteDataOperation(ctx, operation)
+}
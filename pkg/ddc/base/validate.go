@@ -1,6 +1,11 @@
 package base
 
 import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	fluiderrs "github.com/fluid-cloudnative/fluid/pkg/errors"
 )
 
@@ -15,3 +20,22 @@ func ValidateRuntimeInfo(runtimeInfo RuntimeInfoInterface) (err error) {
 
 	return nil
 }
+
+// ValidateMetadataRestorePolicy rejects the metadata restore policy of the runtime which doesn't restore the metadata
+// of its master automatically.
+func ValidateMetadataRestorePolicy(management datav1alpha1.RuntimeManagement, runtimeType string) error {
+	if management.MetadataRestorePolicy == nil {
+		return nil
+	}
+	return field.Forbidden(field.NewPath("spec", "management", "metadataRestorePolicy"),
+		fmt.Sprintf("restoring the metadata automatically is not supported by %s", runtimeType))
+}
+
+// ValidateHibernationPolicy rejects the hibernation policy of the runtime whose engine can't hibernate it.
+func ValidateHibernationPolicy(management datav1alpha1.RuntimeManagement, runtimeType string) error {
+	if management.HibernationPolicy == nil {
+		return nil
+	}
+	return field.Forbidden(field.NewPath("spec", "management", "hibernationPolicy"),
+		fmt.Sprintf("hibernation is not supported by %s", runtimeType))
+}
@@ -27,6 +27,36 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var _ = Describe("ValidateMetadataRestorePolicy", func() {
+	It("should accept the runtime without the metadata restore policy", func() {
+		Expect(ValidateMetadataRestorePolicy(datav1alpha1.RuntimeManagement{}, common.JuiceFSRuntime)).To(Succeed())
+	})
+
+	It("should reject the metadata restore policy", func() {
+		management := datav1alpha1.RuntimeManagement{
+			MetadataRestorePolicy: &datav1alpha1.MetadataRestorePolicy{AutoRestore: true},
+		}
+		err := ValidateMetadataRestorePolicy(management, common.JuiceFSRuntime)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("spec.management.metadataRestorePolicy"))
+	})
+})
+
+var _ = Describe("ValidateHibernationPolicy", func() {
+	It("should accept the runtime without the hibernation policy", func() {
+		Expect(ValidateHibernationPolicy(datav1alpha1.RuntimeManagement{}, common.ThinRuntime)).To(Succeed())
+	})
+
+	It("should reject the hibernation policy", func() {
+		management := datav1alpha1.RuntimeManagement{
+			HibernationPolicy: &datav1alpha1.HibernationPolicy{IdleMinutes: 30},
+		}
+		err := ValidateHibernationPolicy(management, common.ThinRuntime)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("spec.management.hibernationPolicy"))
+	})
+})
+
 var _ = Describe("ValidateRuntimeInfo", func() {
 	var (
 		runtimeInfo *mockRuntimeInfoForValidate
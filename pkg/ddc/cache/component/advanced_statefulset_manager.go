@@ -240,6 +240,66 @@ func (s *AdvancedStatefulSetManager) SyncComponentSpec(ctx context.Context, iden
 	return nil
 }
 
+// SyncReplicas scales the AdvancedStatefulSet to the desired replicas, which may be changed by `kubectl scale`
+// or HPA through the scale subresource of the runtime. It returns the replicas before scaling.
+func (s *AdvancedStatefulSetManager) SyncReplicas(ctx context.Context, identity *common.ComponentIdentity, replicas int32) (previousReplicas int32, err error) {
+	logger := log.FromContext(ctx)
+
+	asts := &workloadv1alpha1.AdvancedStatefulSet{}
+	err = s.client.Get(ctx, types.NamespacedName{Name: identity.Name, Namespace: identity.Namespace}, asts)
+	if err != nil {
+		logger.Error(err, "failed to get advanced statefulset")
+		return 0, err
+	}
+	if asts.Spec.Replicas != nil {
+		previousReplicas = *asts.Spec.Replicas
+	}
+
+	astsToUpdate := asts.DeepCopy()
+	if !s.updateReplicas(astsToUpdate, replicas, logger) {
+		return previousReplicas, nil
+	}
+
+	err = s.client.Patch(ctx, astsToUpdate, client.MergeFrom(asts))
+	if err != nil {
+		logger.Error(err, "failed to scale advanced statefulset", "replicas", replicas)
+		return previousReplicas, err
+	}
+
+	return previousReplicas, nil
+}
+
+// SyncComponentTemplate replaces the pod template of the AdvancedStatefulSet with the one re-rendered from the
+// CacheRuntimeClass. The selector labels are kept, and the pods are updated by the rolling update strategy.
+func (s *AdvancedStatefulSetManager) SyncComponentTemplate(ctx context.Context, component *common.CacheRuntimeComponentValue) error {
+	logger := log.FromContext(ctx)
+
+	asts := &workloadv1alpha1.AdvancedStatefulSet{}
+	err := s.client.Get(ctx, types.NamespacedName{Name: component.Name, Namespace: component.Namespace}, asts)
+	if err != nil {
+		logger.Error(err, "failed to get advanced statefulset")
+		return err
+	}
+
+	podTemplateSpec := *component.PodTemplateSpec.DeepCopy()
+	podTemplateSpec.Labels = utils.UnionMapsWithOverride(podTemplateSpec.Labels, getCommonLabelsFromComponent(component))
+	if reflect.DeepEqual(asts.Spec.Template, podTemplateSpec) {
+		logger.Info("no template changes detected, skip update", "component", component.Name)
+		return nil
+	}
+
+	astsToUpdate := asts.DeepCopy()
+	astsToUpdate.Spec.Template = podTemplateSpec
+	err = s.client.Patch(ctx, astsToUpdate, client.MergeFrom(asts))
+	if err != nil {
+		logger.Error(err, "failed to patch advanced statefulset template")
+		return err
+	}
+
+	logger.Info("successfully patched advanced statefulset with new template", "component", component.Name)
+	return nil
+}
+
 // updateReplicas updates the replica count if changed
 // Returns true if update is needed
 func (s *AdvancedStatefulSetManager) updateReplicas(asts *workloadv1alpha1.AdvancedStatefulSet, newReplicas int32, logger logr.Logger) bool {
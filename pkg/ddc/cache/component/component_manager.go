@@ -34,6 +34,10 @@ type ComponentManager interface {
 	GetNodeAffinity(identity *common.ComponentIdentity) (*corev1.NodeAffinity, error)
 	// SyncComponentSpec synchronizes component specification changes to the workload
 	SyncComponentSpec(ctx context.Context, identity *common.ComponentIdentity, newSpec ComponentSpec) error
+	// SyncReplicas scales the workload to the desired replicas, and returns the replicas of the workload before scaling
+	SyncReplicas(ctx context.Context, identity *common.ComponentIdentity, replicas int32) (previousReplicas int32, err error)
+	// SyncComponentTemplate re-renders the pod template of the workload, used when the CacheRuntimeClass changes
+	SyncComponentTemplate(ctx context.Context, component *common.CacheRuntimeComponentValue) error
 }
 
 // ComponentSpec represents the specification that can be synchronized to a component
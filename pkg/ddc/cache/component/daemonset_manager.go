@@ -147,3 +147,13 @@ func (s *DaemonSetManager) ConstructComponentStatus(ctx context.Context, identit
 func (s *DaemonSetManager) SyncComponentSpec(ctx context.Context, identity *common.ComponentIdentity, spec ComponentSpec) error {
 	return fmt.Errorf("SyncComponentSpec is not supported for DaemonSet component %s/%s, client component does not support to be modified after created", identity.Namespace, identity.Name)
 }
+
+// SyncReplicas is not supported for DaemonSet, the replicas of Client Component are decided by the nodes it runs on.
+func (s *DaemonSetManager) SyncReplicas(ctx context.Context, identity *common.ComponentIdentity, replicas int32) (int32, error) {
+	return 0, fmt.Errorf("SyncReplicas is not supported for DaemonSet component %s/%s", identity.Namespace, identity.Name)
+}
+
+// SyncComponentTemplate is not supported for DaemonSet, re-rendering the Client Component would break the FUSE mount points on the nodes.
+func (s *DaemonSetManager) SyncComponentTemplate(ctx context.Context, component *common.CacheRuntimeComponentValue) error {
+	return fmt.Errorf("SyncComponentTemplate is not supported for DaemonSet component %s/%s, client component does not support to be modified after created", component.Namespace, component.Name)
+}
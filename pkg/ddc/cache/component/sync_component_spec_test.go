@@ -510,4 +510,80 @@ var _ = Describe("AdvancedStatefulSetManager SyncComponentSpec", func() {
 			Expect(result).To(BeFalse())
 		})
 	})
+
+	Describe("SyncReplicas", func() {
+		It("should scale the replicas and return the previous replicas", func() {
+			previousReplicas, err := manager.SyncReplicas(ctx, identity, 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(previousReplicas).To(Equal(int32(3)))
+
+			updatedAsts := &workloadv1alpha1.AdvancedStatefulSet{}
+			err = manager.client.Get(ctx, types.NamespacedName{
+				Name:      identity.Name,
+				Namespace: identity.Namespace,
+			}, updatedAsts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*updatedAsts.Spec.Replicas).To(Equal(int32(5)))
+			// other fields are kept
+			Expect(updatedAsts.Spec.Template.Spec.Containers[0].Resources.Limits).To(HaveLen(2))
+		})
+
+		It("should not update when replicas unchanged", func() {
+			previousReplicas, err := manager.SyncReplicas(ctx, identity, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(previousReplicas).To(Equal(int32(3)))
+		})
+
+		It("should return error when the component does not exist", func() {
+			_, err := manager.SyncReplicas(ctx, &common.ComponentIdentity{Name: "non-existent", Namespace: "fluid"}, 5)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("SyncComponentTemplate", func() {
+		var value *common.CacheRuntimeComponentValue
+
+		BeforeEach(func() {
+			value = &common.CacheRuntimeComponentValue{
+				Name:      identity.Name,
+				Namespace: identity.Namespace,
+				Owner:     &common.OwnerReference{Name: "test-runtime"},
+				PodTemplateSpec: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  "worker",
+								Image: "fluid-cache:v1.1.0",
+								Args:  []string{"--new-flag"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should replace the template and keep the selector labels", func() {
+			err := manager.SyncComponentTemplate(ctx, value)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedAsts := &workloadv1alpha1.AdvancedStatefulSet{}
+			err = manager.client.Get(ctx, types.NamespacedName{
+				Name:      identity.Name,
+				Namespace: identity.Namespace,
+			}, updatedAsts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedAsts.Spec.Template.Spec.Containers[0].Image).To(Equal("fluid-cache:v1.1.0"))
+			Expect(updatedAsts.Spec.Template.Spec.Containers[0].Args).To(Equal([]string{"--new-flag"}))
+			Expect(updatedAsts.Spec.Template.Labels).To(HaveKeyWithValue(common.LabelCacheRuntimeName, "test-runtime"))
+			Expect(updatedAsts.Spec.Template.Labels).To(HaveKeyWithValue(common.LabelCacheRuntimeComponentName, identity.Name))
+			// replicas are kept
+			Expect(*updatedAsts.Spec.Replicas).To(Equal(int32(3)))
+		})
+
+		It("should return error when the component does not exist", func() {
+			value.Name = "non-existent"
+			err := manager.SyncComponentTemplate(ctx, value)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
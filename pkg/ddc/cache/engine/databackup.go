@@ -17,16 +17,205 @@
 package engine
 
 import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	fluiderrors "github.com/fluid-cloudnative/fluid/pkg/errors"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// backup pod envs injected for the backup command defined in runtime class
+	envDataBackupDatasetName      = "FLUID_DATABACKUP_DATASET_NAME"
+	envDataBackupDatasetNamespace = "FLUID_DATABACKUP_DATASET_NAMESPACE"
+	// FLUID_DATABACKUP_TARGET_DIR is generated and set in the helm pod yaml, it is /pvc/<path> or /host/.
 )
 
-func (e *CacheEngine) generateDataBackupValueFile(_ cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
-	return "", fluiderrors.NewNotSupported(
-		schema.GroupResource{
-			Group:    object.GetObjectKind().GroupVersionKind().Group,
-			Resource: object.GetObjectKind().GroupVersionKind().Kind,
-		}, "CacheRuntime["+e.name+"]")
+func (e *CacheEngine) generateDataBackupValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	databackup, ok := object.(*v1alpha1.DataBackup)
+	if !ok {
+		return "", fmt.Errorf("object %v is not a DataBackup", object)
+	}
+
+	targetDataset, err := utils.GetDataset(e.Client, databackup.Spec.Dataset, databackup.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return "", err
+	}
+
+	runtimeClass, err := e.getRuntimeClass(runtime.Spec.RuntimeClassName)
+	if err != nil {
+		return "", err
+	}
+
+	dataBackupValue, err := e.genDataBackupValue(ctx, targetDataset, runtime, runtimeClass, databackup)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataBackupValue)
+	if err != nil {
+		return "", err
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-%s-backuper-values.yaml", databackup.Namespace, databackup.Name, common.CacheRuntime))
+	if err != nil {
+		return "", err
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return "", err
+	}
+	return valueFile.Name(), nil
+}
+
+func (e *CacheEngine) genDataBackupValue(ctx cruntime.ReconcileRequestContext, targetDataset *v1alpha1.Dataset, runtime *v1alpha1.CacheRuntime,
+	runtimeClass *v1alpha1.CacheRuntimeClass, databackup *v1alpha1.DataBackup) (value *cdatabackup.CacheDataBackupValue, err error) {
+
+	// check runtime class defines the DataBackup or not.
+	opSpec := findDataOperationSpec(runtimeClass.DataOperationSpecs, dataoperation.DataBackupType)
+	if opSpec == nil {
+		return nil, fluiderrors.NewNotSupported(
+			schema.GroupResource{
+				Group:    databackup.GetObjectKind().GroupVersionKind().Group,
+				Resource: databackup.GetObjectKind().GroupVersionKind().Kind,
+			}, "CacheRuntime["+e.name+"]")
+	}
+
+	if len(opSpec.Command) == 0 && len(opSpec.Args) == 0 {
+		ctx.Recorder.Eventf(databackup, corev1.EventTypeWarning, common.DataOperationExecutionFailed, "dataBackup command and args defined in cache runtime class can not be both empty")
+		return nil, errors.New("dataBackup command and args defined in cache runtime class can not be both empty")
+	}
+
+	backupInfo := cdatabackup.CacheDataBackupInfo{
+		Namespace: databackup.Namespace,
+		Dataset:   databackup.Spec.Dataset,
+		Command:   opSpec.Command,
+		Args:      opSpec.Args,
+	}
+
+	// DataOperationSpecs image takes precedence; falls back to worker image if empty.
+	backupInfo.Image = opSpec.Image
+	if len(backupInfo.Image) == 0 {
+		backupInfo.Image, err = e.getDataOperationImage(runtime, runtimeClass)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// get image pull secrets from runtime class worker pod template
+	if runtimeClass.Topology.Worker != nil {
+		backupInfo.ImagePullSecrets = runtimeClass.Topology.Worker.Template.Spec.ImagePullSecrets
+	}
+
+	backupInfo.PVCName, backupInfo.Path, err = utils.ParseBackupRestorePath(databackup.Spec.BackupPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// run the backup pod on the node of the execution pod(e.g. master), so that local backup files
+	// can be found with the node name recorded in the status.
+	backupInfo.NodeName, err = e.getDataBackupNodeName(runtime, runtimeClass)
+	if err != nil {
+		return nil, err
+	}
+
+	// databackup.Spec.RunAs > the image default user
+	if databackup.Spec.RunAs != nil {
+		backupInfo.RunAsUser = databackup.Spec.RunAs.UID
+		backupInfo.RunAsGroup = databackup.Spec.RunAs.GID
+	}
+
+	// inject the node affinity by previous operation pod.
+	backupInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(e.Client, databackup.Spec.RunAfter, databackup.Namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// injected envs
+	backupInfo.Envs = []corev1.EnvVar{
+		{
+			Name:  "FLUID_RUNTIME_CONFIG_PATH",
+			Value: e.getRuntimeConfigPath(),
+		},
+		{
+			Name:  envDataBackupDatasetName,
+			Value: databackup.Spec.Dataset,
+		},
+		{
+			Name:  envDataBackupDatasetNamespace,
+			Value: databackup.Namespace,
+		},
+	}
+
+	volumeName := e.getRuntimeConfigVolumeName()
+	backupInfo.Volumes = []corev1.Volume{
+		{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: common.GetCacheRuntimeConfigConfigMapName(e.name),
+					},
+				},
+			},
+		},
+	}
+	backupInfo.VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      volumeName,
+			MountPath: e.getRuntimeConfigDir(),
+			ReadOnly:  true,
+		},
+	}
+
+	value = &cdatabackup.CacheDataBackupValue{
+		Name:           databackup.Name,
+		OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+		DataBackupInfo: backupInfo,
+		Owner:          transformer.GenerateOwnerReferenceFromObject(databackup),
+	}
+
+	return value, nil
+}
+
+// getDataBackupNodeName returns the node of the pod executing runtime operations in master-worker architecture,
+// and returns empty in workers-only architecture which lets kubernetes schedule the backup pod.
+func (e *CacheEngine) getDataBackupNodeName(runtime *v1alpha1.CacheRuntime, runtimeClass *v1alpha1.CacheRuntimeClass) (nodeName string, err error) {
+	archApi := resolveArchitectureApi(e.name, e.namespace, runtime, runtimeClass)
+	if _, ok := archApi.(*masterWorkerArchApi); !ok {
+		return "", nil
+	}
+
+	podName, _, err := archApi.GetExecutionPodInfo()
+	if err != nil {
+		return "", err
+	}
+
+	pod, err := kubeclient.GetPodByName(e.Client, podName, e.namespace)
+	if err != nil {
+		return "", err
+	}
+	if pod == nil || len(pod.Spec.NodeName) == 0 {
+		return "", fmt.Errorf("the pod %s/%s to execute data backup is not scheduled", e.namespace, podName)
+	}
+
+	return pod.Spec.NodeName, nil
 }
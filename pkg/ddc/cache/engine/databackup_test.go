@@ -0,0 +1,202 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DataBackup genDataBackupValue Tests", Label("pkg.ddc.cache.engine.databackup_test.go"), func() {
+	var (
+		scheme       *runtime.Scheme
+		engine       *CacheEngine
+		ctx          cruntime.ReconcileRequestContext
+		dataset      *datav1alpha1.Dataset
+		runtimeObj   *datav1alpha1.CacheRuntime
+		runtimeClass *datav1alpha1.CacheRuntimeClass
+		databackup   *datav1alpha1.DataBackup
+		objs         []runtime.Object
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		_ = corev1.AddToScheme(scheme)
+		_ = datav1alpha1.AddToScheme(scheme)
+
+		runtimeObj = &datav1alpha1.CacheRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: datav1alpha1.CacheRuntimeSpec{
+				RuntimeClassName: "test-class",
+			},
+		}
+
+		runtimeClass = &datav1alpha1.CacheRuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-class"},
+			Topology: &datav1alpha1.RuntimeTopology{
+				Master: &datav1alpha1.RuntimeComponentDefinition{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "master", Image: "fluidio/fluid:v1.0.0"}},
+						},
+					},
+				},
+				Worker: &datav1alpha1.RuntimeComponentDefinition{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers:       []corev1.Container{{Name: "worker", Image: "fluidio/fluid:v1.0.0"}},
+							ImagePullSecrets: []corev1.LocalObjectReference{{Name: "secret"}},
+						},
+					},
+				},
+			},
+			DataOperationSpecs: []datav1alpha1.DataOperationSpec{
+				{
+					Name:    "DataBackup",
+					Image:   "fluidio/backup:v1.0.0",
+					Command: []string{"/usr/local/bin/backup"},
+				},
+			},
+		}
+
+		dataset = &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+		}
+
+		databackup = &datav1alpha1.DataBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-backup", Namespace: "default"},
+			Spec: datav1alpha1.DataBackupSpec{
+				Dataset:    "demo",
+				BackupPath: "pvc://backup-pvc/subpath/",
+			},
+		}
+
+		masterPod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: common.GetCacheComponentName("demo", common.ComponentTypeMaster) + "-0", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "master-node"},
+		}
+		objs = []runtime.Object{runtimeObj, runtimeClass, dataset, masterPod}
+		ctx = cruntime.ReconcileRequestContext{Context: context.Background(), Recorder: record.NewFakeRecorder(10)}
+	})
+
+	JustBeforeEach(func() {
+		var baseClient client.Client = fake.NewFakeClientWithScheme(scheme, objs...)
+		engine = &CacheEngine{Client: baseClient, name: "demo", namespace: "default"}
+	})
+
+	Context("when DataBackup is defined in runtime class", func() {
+		It("should generate the backup value with backup path, image and master node", func() {
+			val, err := engine.genDataBackupValue(ctx, dataset, runtimeObj, runtimeClass, databackup)
+			Expect(err).NotTo(HaveOccurred())
+
+			info := val.DataBackupInfo
+			Expect(val.Name).To(Equal("test-backup"))
+			Expect(info.Image).To(Equal("fluidio/backup:v1.0.0"))
+			Expect(info.Command).To(Equal([]string{"/usr/local/bin/backup"}))
+			Expect(info.PVCName).To(Equal("backup-pvc"))
+			Expect(info.Path).To(Equal("/subpath/"))
+			Expect(info.NodeName).To(Equal("master-node"))
+			Expect(info.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{{Name: "secret"}}))
+			Expect(info.RunAsUser).To(BeNil())
+
+			Expect(info.Envs).To(ContainElement(corev1.EnvVar{Name: "FLUID_RUNTIME_CONFIG_PATH", Value: engine.getRuntimeConfigPath()}))
+			Expect(info.Envs).To(ContainElement(corev1.EnvVar{Name: envDataBackupDatasetName, Value: "demo"}))
+			Expect(info.Volumes).To(HaveLen(1))
+			Expect(info.Volumes[0].ConfigMap.Name).To(Equal(common.GetCacheRuntimeConfigConfigMapName("demo")))
+			Expect(info.VolumeMounts).To(HaveLen(1))
+			Expect(info.VolumeMounts[0].MountPath).To(Equal(engine.getRuntimeConfigDir()))
+		})
+
+		It("should use the runAs in DataBackup spec", func() {
+			databackup.Spec.RunAs = &datav1alpha1.User{UID: ptr.To[int64](1000), GID: ptr.To[int64](2000)}
+			val, err := engine.genDataBackupValue(ctx, dataset, runtimeObj, runtimeClass, databackup)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*val.DataBackupInfo.RunAsUser).To(Equal(int64(1000)))
+			Expect(*val.DataBackupInfo.RunAsGroup).To(Equal(int64(2000)))
+		})
+	})
+
+	Context("when the master is disabled", func() {
+		BeforeEach(func() {
+			runtimeObj.Spec.Master.Disabled = true
+			runtimeClass.DataOperationSpecs[0].Image = ""
+		})
+
+		It("should not pin the backup pod to a node and fall back to the worker image", func() {
+			val, err := engine.genDataBackupValue(ctx, dataset, runtimeObj, runtimeClass, databackup)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(val.DataBackupInfo.NodeName).To(BeEmpty())
+			Expect(val.DataBackupInfo.Image).To(Equal("fluidio/fluid:v1.0.0"))
+		})
+	})
+
+	Context("when the master pod is not found", func() {
+		BeforeEach(func() {
+			objs = []runtime.Object{runtimeObj, runtimeClass, dataset}
+		})
+
+		It("should return an error", func() {
+			_, err := engine.genDataBackupValue(ctx, dataset, runtimeObj, runtimeClass, databackup)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when DataBackup is not defined in runtime class", func() {
+		BeforeEach(func() {
+			runtimeClass.DataOperationSpecs = nil
+		})
+
+		It("should return a not-supported error", func() {
+			_, err := engine.genDataBackupValue(ctx, dataset, runtimeObj, runtimeClass, databackup)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when DataBackup command and args are both empty", func() {
+		BeforeEach(func() {
+			runtimeClass.DataOperationSpecs = []datav1alpha1.DataOperationSpec{{Name: "DataBackup"}}
+		})
+
+		It("should return an error", func() {
+			_, err := engine.genDataBackupValue(ctx, dataset, runtimeObj, runtimeClass, databackup)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when backup path is invalid", func() {
+		BeforeEach(func() {
+			databackup.Spec.BackupPath = "s3://bucket/path"
+		})
+
+		It("should return an error", func() {
+			_, err := engine.genDataBackupValue(ctx, dataset, runtimeObj, runtimeClass, databackup)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
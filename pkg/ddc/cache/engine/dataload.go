@@ -107,6 +107,7 @@ func (e *CacheEngine) genDataLoadValue(ctx cruntime.ReconcileRequestContext, tar
 		ImagePullSecrets: imagePullSecrets,
 		Policy:           string(dataload.Spec.Policy),
 		Schedule:         dataload.Spec.Schedule,
+		CronJobPolicy:    cdataload.GenCronJobPolicy(dataload),
 		Resources:        dataload.Spec.Resources,
 	}
 
@@ -194,6 +195,10 @@ func (e *CacheEngine) genDataLoadValue(ctx cruntime.ReconcileRequestContext, tar
 		},
 	}
 
+	// the manifest and file patterns are passed to the loader by envs
+	cdataload.SetTargetFilters(&dataloadInfo, dataload)
+	cdataload.InjectTargetFilterEnvs(&dataloadInfo)
+
 	dataLoadValue := &cdataload.DataLoadValue{
 		Name:           dataload.Name,
 		OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
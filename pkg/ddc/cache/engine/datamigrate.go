@@ -17,16 +17,363 @@
 package engine
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	fluiderrors "github.com/fluid-cloudnative/fluid/pkg/errors"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// migrate pod envs injected for the migrate command defined in runtime class
+	envDataMigrateFrom      = "FLUID_DATAMIGRATE_FROM"
+	envDataMigrateTo        = "FLUID_DATAMIGRATE_TO"
+	envDataMigrateDirection = "FLUID_DATAMIGRATE_DIRECTION"
+	envDataMigrateOptions   = "FLUID_DATAMIGRATE_OPTIONS"
+	envDataMigrateWorkers   = "FLUID_DATAMIGRATE_WORKERS"
+
+	// dataMigrateDirectionIn means migrating data from external storage into the dataset,
+	// dataMigrateDirectionOut means migrating data from the dataset to external storage.
+	dataMigrateDirectionIn  = "in"
+	dataMigrateDirectionOut = "out"
+
+	nativeVolumeMigrateName = "native-vol"
+	nativeVolumeMigratePath = "/mnt/fluid-native"
+
+	externalEncryptOptionPrefix = "EXTERNAL_"
 )
 
-func (e *CacheEngine) generateDataMigrateValueFile(_ cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
-	return "", fluiderrors.NewNotSupported(
-		schema.GroupResource{
-			Group:    object.GetObjectKind().GroupVersionKind().Group,
-			Resource: object.GetObjectKind().GroupVersionKind().Kind,
-		}, "CacheRuntime["+e.name+"]")
+func (e *CacheEngine) generateDataMigrateValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataMigrate, ok := object.(*v1alpha1.DataMigrate)
+	if !ok {
+		return "", fmt.Errorf("object %v is not a DataMigrate", object)
+	}
+
+	targetDataset, err := utils.GetTargetDatasetOfMigrate(e.Client, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return "", err
+	}
+
+	runtimeClass, err := e.getRuntimeClass(runtime.Spec.RuntimeClassName)
+	if err != nil {
+		return "", err
+	}
+
+	dataMigrateValue, err := e.genDataMigrateValue(ctx, targetDataset, runtime, runtimeClass, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataMigrateValue)
+	if err != nil {
+		return "", err
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-migrate-values.yaml", dataMigrate.Namespace, dataMigrate.Name))
+	if err != nil {
+		return "", err
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return "", err
+	}
+	return valueFile.Name(), nil
+}
+
+func (e *CacheEngine) genDataMigrateValue(ctx cruntime.ReconcileRequestContext, targetDataset *v1alpha1.Dataset, runtime *v1alpha1.CacheRuntime,
+	runtimeClass *v1alpha1.CacheRuntimeClass, dataMigrate *v1alpha1.DataMigrate) (value *cdatamigrate.DataMigrateValue, err error) {
+
+	// check runtime class defines the DataMigrate or not.
+	opSpec := findDataOperationSpec(runtimeClass.DataOperationSpecs, dataoperation.DataMigrateType)
+	if opSpec == nil {
+		return nil, fluiderrors.NewNotSupported(
+			schema.GroupResource{
+				Group:    dataMigrate.GetObjectKind().GroupVersionKind().Group,
+				Resource: dataMigrate.GetObjectKind().GroupVersionKind().Kind,
+			}, "CacheRuntime["+e.name+"]")
+	}
+
+	if len(opSpec.Command) == 0 && len(opSpec.Args) == 0 {
+		ctx.Recorder.Eventf(dataMigrate, corev1.EventTypeWarning, common.DataOperationExecutionFailed, "dataMigrate command and args defined in cache runtime class can not be both empty")
+		return nil, errors.New("dataMigrate command and args defined in cache runtime class can not be both empty")
+	}
+
+	dataMigrateInfo := cdatamigrate.DataMigrateInfo{
+		BackoffLimit:   3,
+		TargetDataset:  targetDataset.Name,
+		EncryptOptions: []v1alpha1.EncryptOption{},
+		Options:        map[string]string{},
+		Labels:         dataMigrate.Spec.PodMetadata.Labels,
+		Annotations:    dataflow.InjectAffinityAnnotation(dataMigrate.Annotations, dataMigrate.Spec.PodMetadata.Annotations),
+		Policy:         string(dataMigrate.Spec.Policy),
+		Schedule:       dataMigrate.Spec.Schedule,
+		CronJobPolicy:  cdatamigrate.GenCronJobPolicy(dataMigrate),
+		Resources:      dataMigrate.Spec.Resources,
+		Parallelism:    dataMigrate.Spec.Parallelism,
+		Command:        opSpec.Command,
+		Args:           opSpec.Args,
+	}
+
+	// DataMigrate spec image > DataOperationSpecs image > worker image.
+	switch {
+	case len(dataMigrate.Spec.Image) > 0 && len(dataMigrate.Spec.ImageTag) > 0:
+		dataMigrateInfo.Image = dataMigrate.Spec.Image + ":" + dataMigrate.Spec.ImageTag
+	case len(opSpec.Image) > 0:
+		dataMigrateInfo.Image = opSpec.Image
+	default:
+		dataMigrateInfo.Image, err = e.getDataOperationImage(runtime, runtimeClass)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// get image pull secrets from runtime class worker pod template
+	if runtimeClass.Topology.Worker != nil {
+		dataMigrateInfo.ImagePullSecrets = runtimeClass.Topology.Worker.Template.Spec.ImagePullSecrets
+	}
+
+	// first set the affinity, below code will add another affinity terms.
+	if dataMigrate.Spec.Affinity != nil {
+		dataMigrateInfo.Affinity = dataMigrate.Spec.Affinity
+	}
+	// generate ssh config for parallel tasks when using parallel tasks
+	if dataMigrateInfo.Parallelism > 1 {
+		err = cdatamigrate.SetParallelMigrateOptions(&dataMigrateInfo, dataMigrate)
+		if err != nil {
+			return nil, err
+		}
+		// the launcher prefers to run on different host with the workers
+		cdatamigrate.AddWorkerPodPreferredAntiAffinity(&dataMigrateInfo, dataMigrate)
+	}
+
+	// inject the node affinity by previous operation pod.
+	dataMigrateInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(e.Client, dataMigrate.Spec.RunAfter, dataMigrate.Namespace, dataMigrateInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	if dataMigrate.Spec.NodeSelector != nil {
+		dataMigrateInfo.NodeSelector = dataMigrate.Spec.NodeSelector
+	}
+
+	if len(dataMigrate.Spec.Tolerations) > 0 {
+		dataMigrateInfo.Tolerations = dataMigrate.Spec.Tolerations
+	}
+
+	if len(dataMigrate.Spec.SchedulerName) > 0 {
+		dataMigrateInfo.SchedulerName = dataMigrate.Spec.SchedulerName
+	}
+
+	volumeName := e.getRuntimeConfigVolumeName()
+	dataMigrateInfo.Volumes = []corev1.Volume{
+		{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: common.GetCacheRuntimeConfigConfigMapName(e.name),
+					},
+				},
+			},
+		},
+	}
+	dataMigrateInfo.VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      volumeName,
+			MountPath: e.getRuntimeConfigDir(),
+			ReadOnly:  true,
+		},
+	}
+
+	// set from & to, one of them must be the dataset bound to this runtime.
+	direction, err := getDataMigrateDirection(dataMigrate, targetDataset)
+	if err != nil {
+		return nil, err
+	}
+	migrateFrom, err := e.genDataMigratePath(dataMigrate.Spec.From, &dataMigrateInfo)
+	if err != nil {
+		return nil, err
+	}
+	migrateTo, err := e.genDataMigratePath(dataMigrate.Spec.To, &dataMigrateInfo)
+	if err != nil {
+		return nil, err
+	}
+	dataMigrateInfo.MigrateFrom = migrateFrom
+	dataMigrateInfo.MigrateTo = migrateTo
+
+	// injected envs
+	dataMigrateInfo.Envs = []corev1.EnvVar{
+		{
+			Name:  "FLUID_RUNTIME_CONFIG_PATH",
+			Value: e.getRuntimeConfigPath(),
+		},
+		{
+			Name:  envDataMigrateFrom,
+			Value: migrateFrom,
+		},
+		{
+			Name:  envDataMigrateTo,
+			Value: migrateTo,
+		},
+		{
+			Name:  envDataMigrateDirection,
+			Value: direction,
+		},
+		{
+			Name:  envDataMigrateOptions,
+			Value: genDataMigrateOptions(dataMigrate.Spec.Options),
+		},
+	}
+	if dataMigrateInfo.Parallelism > 1 {
+		dataMigrateInfo.Envs = append(dataMigrateInfo.Envs, corev1.EnvVar{
+			Name:  envDataMigrateWorkers,
+			Value: genParallelWorkerHosts(utils.GetDataMigrateReleaseName(dataMigrate.Name), dataMigrateInfo.Parallelism),
+		})
+	}
+
+	value = &cdatamigrate.DataMigrateValue{
+		Name:            dataMigrate.Name,
+		OwnerDatasetId:  utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+		DataMigrateInfo: dataMigrateInfo,
+		Owner:           transformer.GenerateOwnerReferenceFromObject(dataMigrate),
+	}
+
+	return value, nil
+}
+
+// getDataMigrateDirection returns whether the data is migrated into or out of the target dataset.
+func getDataMigrateDirection(dataMigrate *v1alpha1.DataMigrate, targetDataset *v1alpha1.Dataset) (string, error) {
+	isTarget := func(data v1alpha1.DataToMigrate) bool {
+		if data.DataSet == nil {
+			return false
+		}
+		namespace := data.DataSet.Namespace
+		if len(namespace) == 0 {
+			namespace = dataMigrate.Namespace
+		}
+		return data.DataSet.Name == targetDataset.Name && namespace == targetDataset.Namespace
+	}
+
+	switch {
+	case isTarget(dataMigrate.Spec.To) && dataMigrate.Spec.From.ExternalStorage != nil:
+		return dataMigrateDirectionIn, nil
+	case isTarget(dataMigrate.Spec.From) && dataMigrate.Spec.To.ExternalStorage != nil:
+		return dataMigrateDirectionOut, nil
+	default:
+		return "", fmt.Errorf("DataMigrate %s/%s for CacheRuntime must migrate between the dataset %s and an external storage",
+			dataMigrate.Namespace, dataMigrate.Name, targetDataset.Name)
+	}
+}
+
+// genDataMigratePath generates the path in the cache file system for the dataset, and the uri for the external storage.
+// For the native scheme pvc:// and local://, the volume is mounted into the migrate pod and the mount path is returned.
+func (e *CacheEngine) genDataMigratePath(data v1alpha1.DataToMigrate, info *cdatamigrate.DataMigrateInfo) (string, error) {
+	if data.DataSet != nil {
+		if len(data.DataSet.Path) == 0 {
+			return "/", nil
+		}
+		return data.DataSet.Path, nil
+	}
+
+	if data.ExternalStorage == nil {
+		return "", errors.New("neither dataset nor external storage is set in DataMigrate")
+	}
+
+	uri := data.ExternalStorage.URI
+	switch {
+	case strings.HasPrefix(uri, common.VolumeScheme.String()):
+		// e.g. pvc://my-pvc/path/to/dir
+		parts := strings.SplitN(strings.TrimPrefix(uri, common.VolumeScheme.String()), "/", 2)
+		var subPath string
+		if len(parts) > 1 {
+			subPath = parts[1]
+		}
+		info.Volumes = append(info.Volumes, corev1.Volume{
+			Name: nativeVolumeMigrateName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: parts[0],
+				},
+			},
+		})
+		info.VolumeMounts = append(info.VolumeMounts, corev1.VolumeMount{
+			Name:      nativeVolumeMigrateName,
+			SubPath:   subPath,
+			MountPath: nativeVolumeMigratePath,
+		})
+		return nativeVolumeMigratePath, nil
+	case strings.HasPrefix(uri, common.PathScheme.String()):
+		// e.g. local:///path/to/dir
+		info.Volumes = append(info.Volumes, corev1.Volume{
+			Name: nativeVolumeMigrateName,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: strings.TrimPrefix(uri, common.PathScheme.String()),
+				},
+			},
+		})
+		info.VolumeMounts = append(info.VolumeMounts, corev1.VolumeMount{
+			Name:      nativeVolumeMigrateName,
+			MountPath: nativeVolumeMigratePath,
+		})
+		return nativeVolumeMigratePath, nil
+	}
+
+	// the encrypt option access-key is exposed as the env EXTERNAL_ACCESS_KEY
+	for _, encryptOption := range data.ExternalStorage.EncryptOptions {
+		info.EncryptOptions = append(info.EncryptOptions, v1alpha1.EncryptOption{
+			Name:      externalEncryptOptionPrefix + strings.ToUpper(strings.ReplaceAll(encryptOption.Name, "-", "_")),
+			ValueFrom: encryptOption.ValueFrom,
+		})
+	}
+	return uri, nil
+}
+
+// genDataMigrateOptions converts the options to sorted command line flags, e.g. "--k1=v1 --k2".
+func genDataMigrateOptions(options map[string]string) string {
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	flags := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if v := options[k]; v != "" {
+			flags = append(flags, fmt.Sprintf("--%s=%s", k, v))
+		} else {
+			flags = append(flags, fmt.Sprintf("--%s", k))
+		}
+	}
+	return strings.Join(flags, " ")
+}
+
+// genParallelWorkerHosts returns the comma separated host names of the parallel workers statefulset pods,
+// the launcher itself is not included.
+func genParallelWorkerHosts(releaseName string, parallelism int32) string {
+	workersName := utils.GetParallelOperationWorkersName(releaseName)
+	hosts := make([]string, 0, parallelism-1)
+	for i := int32(0); i < parallelism-1; i++ {
+		hosts = append(hosts, fmt.Sprintf("%s-%d.%s", workersName, i, workersName))
+	}
+	return strings.Join(hosts, ",")
 }
@@ -0,0 +1,227 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DataMigrate genDataMigrateValue Tests", Label("pkg.ddc.cache.engine.datamigrate_test.go"), func() {
+	var (
+		scheme       *runtime.Scheme
+		engine       *CacheEngine
+		ctx          cruntime.ReconcileRequestContext
+		dataset      *datav1alpha1.Dataset
+		runtimeObj   *datav1alpha1.CacheRuntime
+		runtimeClass *datav1alpha1.CacheRuntimeClass
+		dataMigrate  *datav1alpha1.DataMigrate
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		_ = corev1.AddToScheme(scheme)
+		_ = datav1alpha1.AddToScheme(scheme)
+
+		runtimeObj = &datav1alpha1.CacheRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: datav1alpha1.CacheRuntimeSpec{
+				RuntimeClassName: "test-class",
+			},
+		}
+
+		runtimeClass = &datav1alpha1.CacheRuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-class"},
+			Topology: &datav1alpha1.RuntimeTopology{
+				Worker: &datav1alpha1.RuntimeComponentDefinition{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers:       []corev1.Container{{Name: "worker", Image: "fluidio/fluid:v1.0.0"}},
+							ImagePullSecrets: []corev1.LocalObjectReference{{Name: "secret"}},
+						},
+					},
+				},
+			},
+			DataOperationSpecs: []datav1alpha1.DataOperationSpec{
+				{
+					Name:    "DataMigrate",
+					Image:   "fluidio/migrate:v1.0.0",
+					Command: []string{"/usr/local/bin/migrate"},
+				},
+			},
+		}
+
+		dataset = &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+		}
+
+		dataMigrate = &datav1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-migrate", Namespace: "default"},
+			Spec: datav1alpha1.DataMigrateSpec{
+				From: datav1alpha1.DataToMigrate{
+					ExternalStorage: &datav1alpha1.ExternalStorage{
+						URI: "s3://bucket/path",
+						EncryptOptions: []datav1alpha1.EncryptOption{
+							{
+								Name: "access-key",
+								ValueFrom: datav1alpha1.EncryptOptionSource{
+									SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "s3-secret", Key: "ak"},
+								},
+							},
+						},
+					},
+				},
+				To: datav1alpha1.DataToMigrate{
+					DataSet: &datav1alpha1.DatasetToMigrate{Name: "demo", Path: "/data"},
+				},
+				Options: map[string]string{"update": "", "threads": "10"},
+			},
+		}
+		ctx = cruntime.ReconcileRequestContext{Context: context.Background(), Recorder: record.NewFakeRecorder(10)}
+	})
+
+	JustBeforeEach(func() {
+		var baseClient client.Client = fake.NewFakeClientWithScheme(scheme, runtimeObj, runtimeClass, dataset)
+		engine = &CacheEngine{Client: baseClient, name: "demo", namespace: "default"}
+	})
+
+	Context("when migrating from external storage into the dataset", func() {
+		It("should generate the migrate value with envs and encrypt options", func() {
+			val, err := engine.genDataMigrateValue(ctx, dataset, runtimeObj, runtimeClass, dataMigrate)
+			Expect(err).NotTo(HaveOccurred())
+
+			info := val.DataMigrateInfo
+			Expect(val.Name).To(Equal("test-migrate"))
+			Expect(info.Image).To(Equal("fluidio/migrate:v1.0.0"))
+			Expect(info.Command).To(Equal([]string{"/usr/local/bin/migrate"}))
+			Expect(info.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{{Name: "secret"}}))
+			Expect(info.MigrateFrom).To(Equal("s3://bucket/path"))
+			Expect(info.MigrateTo).To(Equal("/data"))
+
+			Expect(info.Envs).To(ContainElement(corev1.EnvVar{Name: envDataMigrateFrom, Value: "s3://bucket/path"}))
+			Expect(info.Envs).To(ContainElement(corev1.EnvVar{Name: envDataMigrateTo, Value: "/data"}))
+			Expect(info.Envs).To(ContainElement(corev1.EnvVar{Name: envDataMigrateDirection, Value: dataMigrateDirectionIn}))
+			Expect(info.Envs).To(ContainElement(corev1.EnvVar{Name: envDataMigrateOptions, Value: "--threads=10 --update"}))
+			Expect(info.EncryptOptions).To(HaveLen(1))
+			Expect(info.EncryptOptions[0].Name).To(Equal("EXTERNAL_ACCESS_KEY"))
+
+			Expect(info.Volumes).To(HaveLen(1))
+			Expect(info.Volumes[0].ConfigMap.Name).To(Equal(common.GetCacheRuntimeConfigConfigMapName("demo")))
+		})
+
+		It("should use the image in DataMigrate spec first", func() {
+			dataMigrate.Spec.Image = "fluidio/custom"
+			dataMigrate.Spec.ImageTag = "v2"
+			val, err := engine.genDataMigrateValue(ctx, dataset, runtimeObj, runtimeClass, dataMigrate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(val.DataMigrateInfo.Image).To(Equal("fluidio/custom:v2"))
+		})
+	})
+
+	Context("when migrating from the dataset to a pvc", func() {
+		BeforeEach(func() {
+			dataMigrate.Spec.From = datav1alpha1.DataToMigrate{
+				DataSet: &datav1alpha1.DatasetToMigrate{Name: "demo", Namespace: "default"},
+			}
+			dataMigrate.Spec.To = datav1alpha1.DataToMigrate{
+				ExternalStorage: &datav1alpha1.ExternalStorage{URI: "pvc://my-pvc/sub/dir"},
+			}
+			runtimeClass.DataOperationSpecs[0].Image = ""
+		})
+
+		It("should mount the pvc and fall back to the worker image", func() {
+			val, err := engine.genDataMigrateValue(ctx, dataset, runtimeObj, runtimeClass, dataMigrate)
+			Expect(err).NotTo(HaveOccurred())
+
+			info := val.DataMigrateInfo
+			Expect(info.Image).To(Equal("fluidio/fluid:v1.0.0"))
+			Expect(info.MigrateFrom).To(Equal("/"))
+			Expect(info.MigrateTo).To(Equal(nativeVolumeMigratePath))
+			Expect(info.Envs).To(ContainElement(corev1.EnvVar{Name: envDataMigrateDirection, Value: dataMigrateDirectionOut}))
+			Expect(info.Volumes).To(HaveLen(2))
+			Expect(info.Volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("my-pvc"))
+			Expect(info.VolumeMounts).To(HaveLen(2))
+			Expect(info.VolumeMounts[1].SubPath).To(Equal("sub/dir"))
+		})
+	})
+
+	Context("when using parallel tasks", func() {
+		BeforeEach(func() {
+			dataMigrate.Spec.Parallelism = 3
+			dataMigrate.Spec.ParallelOptions = map[string]string{"sshSecretName": "ssh-secret"}
+		})
+
+		It("should set the parallel options and worker hosts", func() {
+			val, err := engine.genDataMigrateValue(ctx, dataset, runtimeObj, runtimeClass, dataMigrate)
+			Expect(err).NotTo(HaveOccurred())
+
+			info := val.DataMigrateInfo
+			Expect(info.ParallelOptions.SSHSecretName).To(Equal("ssh-secret"))
+			Expect(info.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(HaveLen(1))
+			Expect(info.Envs).To(ContainElement(corev1.EnvVar{
+				Name:  envDataMigrateWorkers,
+				Value: "test-migrate-migrate-workers-0.test-migrate-migrate-workers,test-migrate-migrate-workers-1.test-migrate-migrate-workers",
+			}))
+		})
+	})
+
+	Context("when neither side is the target dataset", func() {
+		BeforeEach(func() {
+			dataMigrate.Spec.To = datav1alpha1.DataToMigrate{
+				ExternalStorage: &datav1alpha1.ExternalStorage{URI: "s3://bucket/other"},
+			}
+		})
+
+		It("should return an error", func() {
+			_, err := engine.genDataMigrateValue(ctx, dataset, runtimeObj, runtimeClass, dataMigrate)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when DataMigrate is not defined in runtime class", func() {
+		BeforeEach(func() {
+			runtimeClass.DataOperationSpecs = nil
+		})
+
+		It("should return a not-supported error", func() {
+			_, err := engine.genDataMigrateValue(ctx, dataset, runtimeObj, runtimeClass, dataMigrate)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when DataMigrate command and args are both empty", func() {
+		BeforeEach(func() {
+			runtimeClass.DataOperationSpecs = []datav1alpha1.DataOperationSpec{{Name: "DataMigrate"}}
+		})
+
+		It("should return an error", func() {
+			_, err := engine.genDataMigrateValue(ctx, dataset, runtimeObj, runtimeClass, dataMigrate)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -42,6 +42,20 @@ func (e *CacheEngine) BindToDataset(runtime *datav1alpha1.CacheRuntime, runtimeC
 	return e.UpdateDatasetStatus(datav1alpha1.BoundDatasetPhase, runtime, runtimeClass)
 }
 
+// recoverFailedDataset sets the phase of the dataset back to Bound once the runtime is healthy again
+func (e *CacheEngine) recoverFailedDataset(runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) error {
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		return err
+	}
+	if dataset.Status.Phase != datav1alpha1.FailedDatasetPhase {
+		return nil
+	}
+
+	e.Log.Info("The runtime is healthy again, set the dataset phase back to Bound")
+	return e.UpdateDatasetStatus(datav1alpha1.BoundDatasetPhase, runtime, runtimeClass)
+}
+
 func (e *CacheEngine) UpdateDatasetStatus(phase datav1alpha1.DatasetPhase, runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) (err error) {
 	var cacheStates common.CacheStateList
 
@@ -81,8 +95,13 @@ func (e *CacheEngine) UpdateDatasetStatus(phase datav1alpha1.DatasetPhase, runti
 			// keep previous values if cache states are nil
 			if cacheStates != nil {
 				datasetToUpdate.Status.CacheStates = cacheStates
-				datasetToUpdate.Status.FileNum = cacheStates[common.FileNum]
-				datasetToUpdate.Status.UfsTotal = cacheStates[common.UfsTotal]
+				// keep the file num and ufs total synced by SyncMetadata if not reported
+				if fileNum := cacheStates[common.FileNum]; fileNum != "" {
+					datasetToUpdate.Status.FileNum = fileNum
+				}
+				if ufsTotal := cacheStates[common.UfsTotal]; ufsTotal != "" {
+					datasetToUpdate.Status.UfsTotal = ufsTotal
+				}
 			}
 
 			cond = utils.NewDatasetCondition(datav1alpha1.DatasetReady, datav1alpha1.DatasetReadyReason,
@@ -0,0 +1,59 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/pkg/errors"
+)
+
+// executeEntry executes the command of the execution entry with the given args in the pod resolved by the
+// architecture, and parses the stdout of the command as JSON into output.
+func (e *CacheEngine) executeEntry(archApi ArchitectureApi, entryName string, entry *datav1alpha1.ExecutionCommonEntry,
+	args []string, output interface{}) error {
+	podName, containerName, err := archApi.GetExecutionPodInfo()
+	if err != nil {
+		return err
+	}
+	if podName == "" {
+		return errors.Errorf("no pod available to execute the %s command", entryName)
+	}
+
+	fileUtil := NewCacheFileUtil(podName, containerName, e.namespace, e.Log)
+	// at least 20 seconds
+	timeoutSeconds := max(entry.TimeoutSeconds, common.MinExecutionTimeoutSeconds)
+	command := append(slices.Clone(entry.Command), args...)
+	stdout, err := fileUtil.Execute(command, time.Duration(timeoutSeconds)*time.Second)
+	if err != nil {
+		return err
+	}
+
+	stdout = strings.TrimSpace(stdout)
+	if stdout == "" {
+		return errors.Errorf("%s command produced empty output", entryName)
+	}
+	if err = json.Unmarshal([]byte(stdout), output); err != nil {
+		return errors.Wrapf(err, "failed to parse %s output, output: %q", entryName, stdout)
+	}
+	return nil
+}
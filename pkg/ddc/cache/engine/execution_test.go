@@ -0,0 +1,276 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newExecutionTestRuntimeClass returns a runtime class whose master executes the given execution entries
+func newExecutionTestRuntimeClass(entries *datav1alpha1.ExecutionEntries) *datav1alpha1.CacheRuntimeClass {
+	return &datav1alpha1.CacheRuntimeClass{
+		ObjectMeta:     metav1.ObjectMeta{Name: "test-runtime-class"},
+		FileSystemType: "cache",
+		Topology: &datav1alpha1.RuntimeTopology{
+			Master: &datav1alpha1.RuntimeComponentDefinition{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "master"}},
+					},
+				},
+				ExecutionEntries: entries,
+			},
+		},
+	}
+}
+
+func newExecutionTestRuntime() *datav1alpha1.CacheRuntime {
+	return &datav1alpha1.CacheRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-runtime", Namespace: "default"},
+		Spec: datav1alpha1.CacheRuntimeSpec{
+			RuntimeClassName: "test-runtime-class",
+			Master:           datav1alpha1.CacheRuntimeMasterSpec{Replicas: 1},
+		},
+	}
+}
+
+func newExecutionTestEngine(objs ...runtime.Object) *CacheEngine {
+	testScheme := runtime.NewScheme()
+	Expect(datav1alpha1.AddToScheme(testScheme)).NotTo(HaveOccurred())
+	Expect(corev1.AddToScheme(testScheme)).NotTo(HaveOccurred())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithRuntimeObjects(objs...).
+		WithStatusSubresource(&datav1alpha1.CacheRuntime{}, &datav1alpha1.Dataset{}).Build()
+	return &CacheEngine{
+		Client:    fakeClient,
+		Log:       GinkgoLogr,
+		Recorder:  record.NewFakeRecorder(100),
+		name:      "test-runtime",
+		namespace: "default",
+	}
+}
+
+// mockExecute replaces the execution of the commands in the runtime pods with execute
+func mockExecute(execute func(command []string, timeout time.Duration) (string, error)) *gomonkey.Patches {
+	return gomonkey.ApplyFunc(NewCacheFileUtil, func(podName, containerName, namespace string, log logr.Logger) CacheFileUtil {
+		return &MockExecutions{MockExecute: execute}
+	})
+}
+
+var _ = Describe("CacheEngine execution entries", Label("pkg.ddc.cache.engine.execution_test.go"), func() {
+	var patches *gomonkey.Patches
+
+	AfterEach(func() {
+		if patches != nil {
+			patches.Reset()
+			patches = nil
+		}
+	})
+
+	Describe("executeEntry", func() {
+		var (
+			engine  *CacheEngine
+			archApi ArchitectureApi
+			entry   *datav1alpha1.ExecutionCommonEntry
+		)
+
+		BeforeEach(func() {
+			entry = &datav1alpha1.ExecutionCommonEntry{Command: []string{"/unmount.sh"}, TimeoutSeconds: 5}
+			runtimeClass := newExecutionTestRuntimeClass(&datav1alpha1.ExecutionEntries{UnmountUFS: entry})
+			engine = newExecutionTestEngine()
+			archApi = resolveArchitectureApi(engine.name, engine.namespace, newExecutionTestRuntime(), runtimeClass)
+		})
+
+		It("should append the args to the command and parse the output", func() {
+			var executed []string
+			var executedTimeout time.Duration
+			patches = mockExecute(func(command []string, timeout time.Duration) (string, error) {
+				executed, executedTimeout = command, timeout
+				return ` {"unmounted": ["/a"]} `, nil
+			})
+
+			output := &datav1alpha1.CacheRuntimeUnmountUfsOutput{}
+			err := engine.executeEntry(archApi, "unmount ufs", entry, []string{"/a"}, output)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Unmounted).To(Equal([]string{"/a"}))
+			Expect(executed).To(Equal([]string{"/unmount.sh", "/a"}))
+			Expect(entry.Command).To(Equal([]string{"/unmount.sh"}))
+			Expect(executedTimeout).To(Equal(20 * time.Second))
+		})
+
+		It("should return error if the command fails", func() {
+			patches = mockExecute(func(command []string, timeout time.Duration) (string, error) {
+				return "", errors.New("exec failed")
+			})
+
+			err := engine.executeEntry(archApi, "unmount ufs", entry, nil, &datav1alpha1.CacheRuntimeUnmountUfsOutput{})
+			Expect(err).To(MatchError(ContainSubstring("exec failed")))
+		})
+
+		It("should return error if the output is empty", func() {
+			patches = mockExecute(func(command []string, timeout time.Duration) (string, error) {
+				return "  ", nil
+			})
+
+			err := engine.executeEntry(archApi, "unmount ufs", entry, nil, &datav1alpha1.CacheRuntimeUnmountUfsOutput{})
+			Expect(err).To(MatchError(ContainSubstring("unmount ufs command produced empty output")))
+		})
+	})
+
+	Describe("UsedStorageBytes and FreeStorageBytes", func() {
+		It("should report the storage bytes with the ReportCapacity command", func() {
+			runtimeClass := newExecutionTestRuntimeClass(&datav1alpha1.ExecutionEntries{
+				ReportCapacity: &datav1alpha1.ExecutionCommonEntry{Command: []string{"/capacity.sh"}},
+			})
+			engine := newExecutionTestEngine(newExecutionTestRuntime(), runtimeClass)
+			patches = mockExecute(func(command []string, timeout time.Duration) (string, error) {
+				return `{"usedBytes": 1024, "capacityBytes": 4096}`, nil
+			})
+
+			used, err := engine.UsedStorageBytes()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(used).To(Equal(int64(1024)))
+
+			free, err := engine.FreeStorageBytes()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(free).To(Equal(int64(3072)))
+		})
+
+		It("should return error if the ReportCapacity command is not configured", func() {
+			engine := newExecutionTestEngine(newExecutionTestRuntime(), newExecutionTestRuntimeClass(nil))
+
+			_, err := engine.UsedStorageBytes()
+			Expect(err).To(MatchError(ContainSubstring("ReportCapacity command is empty or not configured")))
+		})
+
+		It("should return error if the runtime is not found", func() {
+			engine := newExecutionTestEngine()
+
+			_, err := engine.FreeStorageBytes()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("CheckRuntimeHealthy", func() {
+		var (
+			engine       *CacheEngine
+			cacheRuntime *datav1alpha1.CacheRuntime
+			runtimeClass *datav1alpha1.CacheRuntimeClass
+		)
+
+		BeforeEach(func() {
+			cacheRuntime = newExecutionTestRuntime()
+			runtimeClass = newExecutionTestRuntimeClass(&datav1alpha1.ExecutionEntries{
+				HealthCheck: &datav1alpha1.ExecutionCommonEntry{Command: []string{"/health.sh"}},
+			})
+			engine = newExecutionTestEngine()
+		})
+
+		It("should be healthy if the HealthCheck command is not configured", func() {
+			runtimeClass.Topology.Master.ExecutionEntries = nil
+			Expect(engine.CheckRuntimeHealthy(cacheRuntime, runtimeClass)).To(Succeed())
+		})
+
+		It("should be healthy if the command reports healthy", func() {
+			patches = mockExecute(func(command []string, timeout time.Duration) (string, error) {
+				return `{"healthy": true}`, nil
+			})
+			Expect(engine.CheckRuntimeHealthy(cacheRuntime, runtimeClass)).To(Succeed())
+		})
+
+		It("should return the reported message if the command reports unhealthy", func() {
+			patches = mockExecute(func(command []string, timeout time.Duration) (string, error) {
+				return `{"healthy": false, "message": "no worker is alive"}`, nil
+			})
+
+			err := engine.CheckRuntimeHealthy(cacheRuntime, runtimeClass)
+			Expect(err).To(MatchError(ContainSubstring("no worker is alive")))
+		})
+	})
+
+	Describe("SyncMetadata", func() {
+		var (
+			dataset      *datav1alpha1.Dataset
+			cacheRuntime *datav1alpha1.CacheRuntime
+			runtimeClass *datav1alpha1.CacheRuntimeClass
+			executed     bool
+		)
+
+		BeforeEach(func() {
+			executed = false
+			dataset = &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-runtime", Namespace: "default"},
+			}
+			cacheRuntime = newExecutionTestRuntime()
+			runtimeClass = newExecutionTestRuntimeClass(&datav1alpha1.ExecutionEntries{
+				SyncMetadata: &datav1alpha1.ExecutionCommonEntry{Command: []string{"/sync-metadata.sh"}},
+			})
+			patches = mockExecute(func(command []string, timeout time.Duration) (string, error) {
+				executed = true
+				return `{"fileNum": "100", "ufsTotal": "1.00GiB"}`, nil
+			})
+		})
+
+		getDataset := func(engine *CacheEngine) *datav1alpha1.Dataset {
+			updated := &datav1alpha1.Dataset{}
+			Expect(engine.Client.Get(context.TODO(), client.ObjectKeyFromObject(dataset), updated)).To(Succeed())
+			return updated
+		}
+
+		It("should update the ufs total and file num of the dataset", func() {
+			dataset.Status.UfsTotal = MetadataSyncNotDoneMsg
+			engine := newExecutionTestEngine(dataset)
+
+			Expect(engine.SyncMetadata(cacheRuntime, runtimeClass)).To(Succeed())
+			Expect(executed).To(BeTrue())
+			updated := getDataset(engine)
+			Expect(updated.Status.UfsTotal).To(Equal("1.00GiB"))
+			Expect(updated.Status.FileNum).To(Equal("100"))
+		})
+
+		It("should skip syncing if the metadata has been synced", func() {
+			dataset.Status.UfsTotal = "2.00GiB"
+			engine := newExecutionTestEngine(dataset)
+
+			Expect(engine.SyncMetadata(cacheRuntime, runtimeClass)).To(Succeed())
+			Expect(executed).To(BeFalse())
+			Expect(getDataset(engine).Status.UfsTotal).To(Equal("2.00GiB"))
+		})
+
+		It("should skip syncing if the SyncMetadata command is not configured", func() {
+			runtimeClass.Topology.Master.ExecutionEntries = nil
+			engine := newExecutionTestEngine(dataset)
+
+			Expect(engine.SyncMetadata(cacheRuntime, runtimeClass)).To(Succeed())
+			Expect(executed).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,44 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// CheckRuntimeHealthy checks the healthy of the runtime with the HealthCheck execution entry of the runtime class.
+// The runtime is considered healthy if the entry is not configured.
+func (e *CacheEngine) CheckRuntimeHealthy(runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) (err error) {
+	archApi := resolveArchitectureApi(e.name, e.namespace, runtime, runtimeClass)
+	entries := archApi.GetExecutionEntries()
+	if entries == nil || entries.HealthCheck == nil {
+		return nil
+	}
+
+	healthCheckOutput := &datav1alpha1.CacheRuntimeHealthCheckOutput{}
+	if err = e.executeEntry(archApi, "health check", entries.HealthCheck, nil, healthCheckOutput); err != nil {
+		e.Log.Error(err, "Failed to execute health check command")
+		return err
+	}
+
+	if !healthCheckOutput.Healthy {
+		return fmt.Errorf("the runtime %s/%s is not healthy: %s", e.namespace, e.name, healthCheckOutput.Message)
+	}
+	return nil
+}
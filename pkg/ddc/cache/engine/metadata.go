@@ -0,0 +1,82 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"reflect"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// MetadataSyncNotDoneMsg is the ufs total of the dataset before the metadata is synced
+const MetadataSyncNotDoneMsg = "[Calculating]"
+
+// SyncMetadata syncs the metadata of the ufs with the SyncMetadata execution entry of the runtime class, and updates
+// the ufs total and file num of the dataset. It's skipped if the entry is not configured or the metadata is synced.
+func (e *CacheEngine) SyncMetadata(runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) (err error) {
+	archApi := resolveArchitectureApi(e.name, e.namespace, runtime, runtimeClass)
+	entries := archApi.GetExecutionEntries()
+	if entries == nil || entries.SyncMetadata == nil {
+		return nil
+	}
+
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		return err
+	}
+	if !shouldSyncMetadata(dataset) {
+		e.Log.V(1).Info("The metadata of the dataset has been synced, skip syncing", "ufsTotal", dataset.Status.UfsTotal)
+		return nil
+	}
+
+	syncMetadataOutput := &datav1alpha1.CacheRuntimeSyncMetadataOutput{}
+	if err = e.executeEntry(archApi, "sync metadata", entries.SyncMetadata, nil, syncMetadataOutput); err != nil {
+		e.Log.Error(err, "Failed to execute sync metadata command")
+		return err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+		if err != nil {
+			return err
+		}
+		datasetToUpdate := dataset.DeepCopy()
+		datasetToUpdate.Status.UfsTotal = syncMetadataOutput.UfsTotal
+		datasetToUpdate.Status.FileNum = syncMetadataOutput.FileNum
+
+		if !reflect.DeepEqual(dataset.Status, datasetToUpdate.Status) {
+			return e.Client.Status().Update(context.TODO(), datasetToUpdate)
+		}
+		return nil
+	})
+	if err != nil {
+		return utils.LoggingErrorExceptConflict(e.Log, err, "Failed to update the metadata of the dataset",
+			types.NamespacedName{Namespace: e.namespace, Name: e.name})
+	}
+
+	e.Log.Info("Metadata of the dataset synced", "ufsTotal", syncMetadataOutput.UfsTotal, "fileNum", syncMetadataOutput.FileNum)
+	return nil
+}
+
+// shouldSyncMetadata returns true if the ufs total of the dataset is not calculated yet
+func shouldSyncMetadata(dataset *datav1alpha1.Dataset) bool {
+	return dataset.Status.UfsTotal == "" || dataset.Status.UfsTotal == MetadataSyncNotDoneMsg
+}
@@ -0,0 +1,88 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"reflect"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/cache/component"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// SyncReplicas syncs the replicas of the worker component, which may be changed by `kubectl scale` or HPA
+// through the scale subresource of the CacheRuntime. The workload is scaled by the component manager and
+// the scaled condition is recorded in the runtime status.
+func (e *CacheEngine) SyncReplicas(ctx cruntime.ReconcileRequestContext, runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) (err error) {
+	if runtimeClass.Topology.Worker == nil || runtime.Spec.Worker.Disabled {
+		return nil
+	}
+
+	workerIdentity := &common.ComponentIdentity{
+		Name:      common.GetCacheComponentName(e.name, common.ComponentTypeWorker),
+		Namespace: e.namespace,
+	}
+	manager := component.NewComponentHelper(common.ComponentTypeWorker, e.Client)
+	desiredReplicas := runtime.Spec.Worker.Replicas
+	currentReplicas, err := manager.SyncReplicas(ctx.Context, workerIdentity, desiredReplicas)
+	if err != nil {
+		e.Log.Error(err, "failed to sync worker replicas", "component", workerIdentity.Name)
+		return err
+	}
+	if currentReplicas == desiredReplicas {
+		e.Log.V(1).Info("Nothing to do for syncing replicas")
+		return nil
+	}
+
+	var cond datav1alpha1.RuntimeCondition
+	if desiredReplicas < currentReplicas {
+		scalingMsg := fmt.Sprintf("Workers scaled in from %d replicas to %d replicas.", currentReplicas, desiredReplicas)
+		cond = utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkerScaledIn, datav1alpha1.RuntimeWorkersScaledInReason,
+			scalingMsg, corev1.ConditionTrue)
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.Succeed, "Runtime scaled in")
+	} else {
+		scalingMsg := fmt.Sprintf("Workers scaled out from %d replicas to %d replicas.", currentReplicas, desiredReplicas)
+		cond = utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkerScaledOut, datav1alpha1.RuntimeWorkersScaledOutReason,
+			scalingMsg, corev1.ConditionTrue)
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.Succeed, "Runtime scaled out")
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, err := e.getRuntime()
+		if err != nil {
+			return err
+		}
+		runtimeToUpdate := runtime.DeepCopy()
+		runtimeToUpdate.Status.Conditions = utils.UpdateRuntimeCondition(runtimeToUpdate.Status.Conditions, cond)
+		if !reflect.DeepEqual(runtime.Status, runtimeToUpdate.Status) {
+			return e.Client.Status().Update(ctx.Context, runtimeToUpdate)
+		}
+		return nil
+	})
+	if err != nil {
+		return utils.LoggingErrorExceptConflict(e.Log, err, "Failed to sync the replicas",
+			types.NamespacedName{Namespace: e.namespace, Name: e.name})
+	}
+
+	return nil
+}
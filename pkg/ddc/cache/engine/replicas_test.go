@@ -0,0 +1,181 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	workloadv1alpha1 "github.com/fluid-cloudnative/advanced-statefulset/api/workload/v1alpha1"
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	cclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CacheEngine SyncReplicas Tests", Label("pkg.ddc.cache.engine.replicas_test.go"), func() {
+	var (
+		engine       *CacheEngine
+		runtimeObj   *datav1alpha1.CacheRuntime
+		runtimeClass *datav1alpha1.CacheRuntimeClass
+		workerSts    *workloadv1alpha1.AdvancedStatefulSet
+		ctx          cruntime.ReconcileRequestContext
+		fakeClient   cclient.Client
+	)
+
+	BeforeEach(func() {
+		runtimeObj = &datav1alpha1.CacheRuntime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-runtime",
+				Namespace: "default",
+			},
+			Spec: datav1alpha1.CacheRuntimeSpec{
+				RuntimeClassName: "test-class",
+				Worker:           datav1alpha1.CacheRuntimeWorkerSpec{Replicas: 2},
+			},
+		}
+		runtimeClass = &datav1alpha1.CacheRuntimeClass{
+			ObjectMeta:     metav1.ObjectMeta{Name: "test-class"},
+			FileSystemType: "test-fs",
+			Topology: &datav1alpha1.RuntimeTopology{
+				Worker: &datav1alpha1.RuntimeComponentDefinition{},
+			},
+		}
+		workerReplicas := int32(2)
+		workerSts = &workloadv1alpha1.AdvancedStatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-runtime-worker",
+				Namespace: "default",
+			},
+			Spec: workloadv1alpha1.AdvancedStatefulSetSpec{
+				Replicas: &workerReplicas,
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "worker", Image: "test-worker:latest"}},
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(CacheEngineTestScheme).
+			WithObjects(runtimeObj, runtimeClass, workerSts).
+			WithStatusSubresource(runtimeObj).
+			Build()
+
+		engine = &CacheEngine{
+			name:      "test-runtime",
+			namespace: "default",
+			Client:    fakeClient,
+			Log:       ctrl.Log.WithName("test"),
+		}
+
+		ctx = cruntime.ReconcileRequestContext{
+			Client:         fakeClient,
+			Context:        context.Background(),
+			Log:            ctrl.Log.WithName("test"),
+			Recorder:       record.NewFakeRecorder(10),
+			RuntimeType:    "cache",
+			NamespacedName: types.NamespacedName{Name: "test-runtime", Namespace: "default"},
+		}
+	})
+
+	getWorkerReplicas := func() int32 {
+		asts := &workloadv1alpha1.AdvancedStatefulSet{}
+		Expect(fakeClient.Get(context.TODO(), types.NamespacedName{Name: "test-runtime-worker", Namespace: "default"}, asts)).To(Succeed())
+		return *asts.Spec.Replicas
+	}
+
+	getScaleCondition := func() *datav1alpha1.RuntimeCondition {
+		runtime, err := engine.getRuntime()
+		Expect(err).NotTo(HaveOccurred())
+		for i, cond := range runtime.Status.Conditions {
+			if cond.Type == datav1alpha1.RuntimeWorkerScaledIn || cond.Type == datav1alpha1.RuntimeWorkerScaledOut {
+				return &runtime.Status.Conditions[i]
+			}
+		}
+		return nil
+	}
+
+	Context("when the replicas are not changed", func() {
+		It("should do nothing", func() {
+			Expect(engine.SyncReplicas(ctx, runtimeObj, runtimeClass)).To(Succeed())
+			Expect(getWorkerReplicas()).To(Equal(int32(2)))
+			Expect(getScaleCondition()).To(BeNil())
+		})
+	})
+
+	Context("when the replicas are scaled out", func() {
+		BeforeEach(func() {
+			runtimeObj.Spec.Worker.Replicas = 4
+		})
+
+		It("should scale out the workers and record the condition", func() {
+			Expect(engine.SyncReplicas(ctx, runtimeObj, runtimeClass)).To(Succeed())
+			Expect(getWorkerReplicas()).To(Equal(int32(4)))
+			cond := getScaleCondition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Type).To(Equal(datav1alpha1.RuntimeWorkerScaledOut))
+			Expect(cond.Message).To(Equal("Workers scaled out from 2 replicas to 4 replicas."))
+		})
+	})
+
+	Context("when the replicas are scaled in", func() {
+		BeforeEach(func() {
+			runtimeObj.Spec.Worker.Replicas = 1
+		})
+
+		It("should scale in the workers and record the condition", func() {
+			Expect(engine.SyncReplicas(ctx, runtimeObj, runtimeClass)).To(Succeed())
+			Expect(getWorkerReplicas()).To(Equal(int32(1)))
+			cond := getScaleCondition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Type).To(Equal(datav1alpha1.RuntimeWorkerScaledIn))
+		})
+	})
+
+	Context("when the worker is disabled", func() {
+		BeforeEach(func() {
+			runtimeObj.Spec.Worker.Replicas = 3
+			runtimeObj.Spec.Worker.Disabled = true
+		})
+
+		It("should not scale the workers", func() {
+			Expect(engine.SyncReplicas(ctx, runtimeObj, runtimeClass)).To(Succeed())
+			Expect(getWorkerReplicas()).To(Equal(int32(2)))
+		})
+	})
+
+	Context("when the worker workload does not exist", func() {
+		BeforeEach(func() {
+			workerSts.Name = "other-worker"
+		})
+
+		It("should return error", func() {
+			Expect(engine.SyncReplicas(ctx, runtimeObj, runtimeClass)).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,203 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/cache/component"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+)
+
+// syncRuntimeClassGeneration re-renders the master and worker components if the CacheRuntimeClass is changed after
+// the components are rendered, and the runtime opts in with the Auto update policy or is explicitly bumped to the
+// current generation of the class with the annotation. Only the pod templates of the master and worker and the config
+// maps not mounted by the client are pinned to the recorded generation. The client component is not re-rendered to
+// keep the FUSE mounts, while the execution entries and the data operations always follow the current class.
+func (e *CacheEngine) syncRuntimeClassGeneration(ctx cruntime.ReconcileRequestContext, runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) (err error) {
+	renderedGeneration := runtime.Status.RuntimeClassGeneration
+	if renderedGeneration == runtimeClass.Generation {
+		return nil
+	}
+
+	// the runtime is set up before the generation is tracked, take the current generation as the rendered one
+	if renderedGeneration == 0 {
+		return e.updateRuntimeClassGeneration(ctx, runtimeClass.Generation)
+	}
+
+	if !shouldRerenderOnRuntimeClassChange(runtime, runtimeClass) {
+		e.Log.V(1).Info("CacheRuntimeClass is changed, skip re-rendering the components until the runtime is bumped",
+			"runtimeClass", runtimeClass.Name, "renderedGeneration", renderedGeneration, "generation", runtimeClass.Generation)
+		return nil
+	}
+
+	e.Log.Info("CacheRuntimeClass is changed, re-render the components",
+		"runtimeClass", runtimeClass.Name, "renderedGeneration", renderedGeneration, "generation", runtimeClass.Generation)
+	if err = e.rerenderComponents(ctx, runtime, runtimeClass); err != nil {
+		return err
+	}
+	if err = e.updateRuntimeClassGeneration(ctx, runtimeClass.Generation); err != nil {
+		return err
+	}
+
+	e.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.RuntimeClassRerendered,
+		"Components are re-rendered from generation %d of CacheRuntimeClass %s", runtimeClass.Generation, runtimeClass.Name)
+	return nil
+}
+
+// shouldRerenderOnRuntimeClassChange returns true if the runtime uses the Auto update policy, or the annotation
+// bumps the runtime to the current generation of the CacheRuntimeClass.
+func shouldRerenderOnRuntimeClassChange(runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) bool {
+	if runtime.Spec.UpdatePolicy == datav1alpha1.AutoRuntimeClassUpdatePolicy {
+		return true
+	}
+
+	value, found := runtime.GetAnnotations()[common.AnnotationRuntimeClassGeneration]
+	if !found {
+		return false
+	}
+	generation, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false
+	}
+	return generation == runtimeClass.Generation
+}
+
+// rerenderComponents transforms the runtime with the CacheRuntimeClass and syncs the templates of the master and
+// worker components, as well as the config maps defined in the extra resources of the CacheRuntimeClass. The config
+// maps mounted by the client are kept, otherwise the files in the running client pods would be changed.
+func (e *CacheEngine) rerenderComponents(ctx cruntime.ReconcileRequestContext, runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) error {
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		return err
+	}
+
+	runtimeValue, err := e.transform(dataset, runtime, runtimeClass)
+	if err != nil {
+		return err
+	}
+
+	if err = e.syncConfigMapInRuntimeClass(ctx.Context, runtime, &runtimeClass.ExtraResources, getMountedConfigMaps(runtimeValue.Client)); err != nil {
+		return err
+	}
+
+	for _, value := range []*common.CacheRuntimeComponentValue{runtimeValue.Master, runtimeValue.Worker} {
+		if value == nil || !value.Enabled {
+			continue
+		}
+		manager := component.NewComponentHelper(value.ComponentType, e.Client)
+		if err = manager.SyncComponentTemplate(ctx.Context, value); err != nil {
+			e.Log.Error(err, "failed to sync component template", "component", value.Name)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncConfigMapInRuntimeClass creates the config maps defined in the extra resources of the CacheRuntimeClass if not
+// exist, and updates the data of the existing ones except the kept ones.
+func (e *CacheEngine) syncConfigMapInRuntimeClass(ctx context.Context, runtime *datav1alpha1.CacheRuntime, extraResources *datav1alpha1.RuntimeExtraResources, kept sets.Set[string]) error {
+	var True = true
+	owner := []metav1.OwnerReference{
+		{
+			APIVersion:         runtime.APIVersion,
+			Kind:               runtime.Kind,
+			Name:               runtime.Name,
+			UID:                runtime.UID,
+			Controller:         &True,
+			BlockOwnerDeletion: &True,
+		},
+	}
+	if err := e.createConfigMapInRuntimeClass(ctx, extraResources, owner); err != nil {
+		return err
+	}
+
+	for _, configMap := range extraResources.ConfigMaps {
+		if kept.Has(configMap.Name) {
+			e.Log.V(1).Info("Skip updating the ConfigMap mounted by the client", "name", configMap.Name)
+			continue
+		}
+		cm, err := kubeclient.GetConfigmapByNameWithContext(ctx, e.Client, configMap.Name, e.namespace)
+		if err != nil {
+			return err
+		}
+		if cm == nil || reflect.DeepEqual(cm.Data, configMap.Data) {
+			continue
+		}
+		cmToUpdate := cm.DeepCopy()
+		cmToUpdate.Data = configMap.Data
+		if err = kubeclient.UpdateConfigMapWithContext(ctx, e.Client, cmToUpdate); err != nil {
+			return err
+		}
+		e.Log.Info("Update ConfigMap succeed", "name", configMap.Name, "namespace", e.namespace)
+	}
+	return nil
+}
+
+// getMountedConfigMaps returns the names of the config maps mounted by the component
+func getMountedConfigMaps(value *common.CacheRuntimeComponentValue) sets.Set[string] {
+	names := sets.New[string]()
+	if value == nil || !value.Enabled {
+		return names
+	}
+	for _, volume := range value.PodTemplateSpec.Spec.Volumes {
+		if volume.ConfigMap != nil {
+			names.Insert(volume.ConfigMap.Name)
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					names.Insert(source.ConfigMap.Name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// updateRuntimeClassGeneration records the generation of the CacheRuntimeClass which the components are rendered from
+func (e *CacheEngine) updateRuntimeClassGeneration(ctx context.Context, generation int64) error {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, err := e.getRuntime()
+		if err != nil {
+			return err
+		}
+		if runtime.Status.RuntimeClassGeneration == generation {
+			return nil
+		}
+		runtimeToUpdate := runtime.DeepCopy()
+		runtimeToUpdate.Status.RuntimeClassGeneration = generation
+		return e.Client.Status().Update(ctx, runtimeToUpdate)
+	})
+	if err != nil {
+		return utils.LoggingErrorExceptConflict(e.Log, err, "Failed to update the runtime class generation",
+			types.NamespacedName{Namespace: e.namespace, Name: e.name})
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+
+	workloadv1alpha1 "github.com/fluid-cloudnative/advanced-statefulset/api/workload/v1alpha1"
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CacheEngine runtime class generation", Label("pkg.ddc.cache.engine.runtime_class_test.go"), func() {
+	var (
+		engine       *CacheEngine
+		runtimeObj   *datav1alpha1.CacheRuntime
+		runtimeClass *datav1alpha1.CacheRuntimeClass
+		extraConfig  *corev1.ConfigMap
+		masterSts    *workloadv1alpha1.AdvancedStatefulSet
+		ctx          cruntime.ReconcileRequestContext
+	)
+
+	BeforeEach(func() {
+		runtimeObj = &datav1alpha1.CacheRuntime{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "data.fluid.io/v1alpha1",
+				Kind:       "CacheRuntime",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-runtime",
+				Namespace: "default",
+				UID:       "test-runtime-uid",
+			},
+			Spec: datav1alpha1.CacheRuntimeSpec{
+				RuntimeClassName: "test-class",
+				Master:           datav1alpha1.CacheRuntimeMasterSpec{Replicas: 1},
+			},
+		}
+		runtimeObj.Spec.Worker.Disabled = true
+		runtimeObj.Spec.Client.Disabled = true
+		runtimeObj.Status.RuntimeClassGeneration = 1
+
+		runtimeClass = &datav1alpha1.CacheRuntimeClass{
+			ObjectMeta:     metav1.ObjectMeta{Name: "test-class", Generation: 2},
+			FileSystemType: "test-fs",
+			Topology: &datav1alpha1.RuntimeTopology{
+				Master: &datav1alpha1.RuntimeComponentDefinition{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "master", Image: "test-master:v2"}},
+						},
+					},
+				},
+			},
+			ExtraResources: datav1alpha1.RuntimeExtraResources{
+				ConfigMaps: []datav1alpha1.ConfigMapRuntimeExtraResource{
+					{Name: "extra-config", Data: map[string]string{"key": "new"}},
+				},
+			},
+		}
+
+		extraConfig = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "extra-config", Namespace: "default"},
+			Data:       map[string]string{"key": "old"},
+		}
+
+		masterReplicas := int32(1)
+		masterSts = &workloadv1alpha1.AdvancedStatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-runtime-master", Namespace: "default"},
+			Spec: workloadv1alpha1.AdvancedStatefulSetSpec{
+				Replicas: &masterReplicas,
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "master", Image: "test-master:v1"}},
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		dataset := &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-runtime", Namespace: "default"},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(CacheEngineTestScheme).
+			WithObjects(dataset, runtimeObj, runtimeClass, extraConfig, masterSts).
+			WithStatusSubresource(runtimeObj).
+			Build()
+		engine = &CacheEngine{
+			name:      "test-runtime",
+			namespace: "default",
+			Client:    fakeClient,
+			Log:       GinkgoLogr,
+			Recorder:  record.NewFakeRecorder(10),
+		}
+		ctx = cruntime.ReconcileRequestContext{Context: context.TODO()}
+	})
+
+	getRenderedGeneration := func() int64 {
+		runtime, err := engine.getRuntime()
+		Expect(err).NotTo(HaveOccurred())
+		return runtime.Status.RuntimeClassGeneration
+	}
+
+	getMasterImage := func() string {
+		asts := &workloadv1alpha1.AdvancedStatefulSet{}
+		err := engine.Client.Get(context.TODO(), types.NamespacedName{Name: masterSts.Name, Namespace: masterSts.Namespace}, asts)
+		Expect(err).NotTo(HaveOccurred())
+		return asts.Spec.Template.Spec.Containers[0].Image
+	}
+
+	getExtraConfigData := func() map[string]string {
+		cm := &corev1.ConfigMap{}
+		err := engine.Client.Get(context.TODO(), types.NamespacedName{Name: extraConfig.Name, Namespace: extraConfig.Namespace}, cm)
+		Expect(err).NotTo(HaveOccurred())
+		return cm.Data
+	}
+
+	It("should not re-render the components with the Manual update policy", func() {
+		Expect(engine.syncRuntimeClassGeneration(ctx, runtimeObj, runtimeClass)).To(Succeed())
+		Expect(getRenderedGeneration()).To(Equal(int64(1)))
+		Expect(getMasterImage()).To(Equal("test-master:v1"))
+		Expect(getExtraConfigData()).To(HaveKeyWithValue("key", "old"))
+	})
+
+	When("the runtime uses the Auto update policy", func() {
+		BeforeEach(func() {
+			runtimeObj.Spec.UpdatePolicy = datav1alpha1.AutoRuntimeClassUpdatePolicy
+		})
+
+		It("should re-render the components and record the generation", func() {
+			Expect(engine.syncRuntimeClassGeneration(ctx, runtimeObj, runtimeClass)).To(Succeed())
+			Expect(getRenderedGeneration()).To(Equal(int64(2)))
+			Expect(getMasterImage()).To(Equal("test-master:v2"))
+			Expect(getExtraConfigData()).To(HaveKeyWithValue("key", "new"))
+		})
+
+		When("the client mounts the ConfigMap", func() {
+			BeforeEach(func() {
+				runtimeObj.Spec.Client.Disabled = false
+				runtimeClass.Topology.Client = &datav1alpha1.RuntimeComponentDefinition{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "client", Image: "test-client:v2"}},
+							Volumes: []corev1.Volume{{
+								Name: "extra-config",
+								VolumeSource: corev1.VolumeSource{
+									ConfigMap: &corev1.ConfigMapVolumeSource{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "extra-config"},
+									},
+								},
+							}},
+						},
+					},
+				}
+			})
+
+			It("should keep the ConfigMap", func() {
+				Expect(engine.syncRuntimeClassGeneration(ctx, runtimeObj, runtimeClass)).To(Succeed())
+				Expect(getRenderedGeneration()).To(Equal(int64(2)))
+				Expect(getMasterImage()).To(Equal("test-master:v2"))
+				Expect(getExtraConfigData()).To(HaveKeyWithValue("key", "old"))
+			})
+		})
+	})
+
+	When("the runtime is bumped to the current generation", func() {
+		BeforeEach(func() {
+			runtimeObj.Annotations = map[string]string{common.AnnotationRuntimeClassGeneration: "2"}
+		})
+
+		It("should re-render the components and record the generation", func() {
+			Expect(engine.syncRuntimeClassGeneration(ctx, runtimeObj, runtimeClass)).To(Succeed())
+			Expect(getRenderedGeneration()).To(Equal(int64(2)))
+			Expect(getMasterImage()).To(Equal("test-master:v2"))
+		})
+	})
+
+	When("the runtime is bumped to a stale generation", func() {
+		BeforeEach(func() {
+			runtimeObj.Annotations = map[string]string{common.AnnotationRuntimeClassGeneration: "1"}
+		})
+
+		It("should not re-render the components", func() {
+			Expect(engine.syncRuntimeClassGeneration(ctx, runtimeObj, runtimeClass)).To(Succeed())
+			Expect(getRenderedGeneration()).To(Equal(int64(1)))
+			Expect(getMasterImage()).To(Equal("test-master:v1"))
+		})
+	})
+
+	When("the rendered generation is not recorded", func() {
+		BeforeEach(func() {
+			runtimeObj.Status.RuntimeClassGeneration = 0
+		})
+
+		It("should record the current generation without re-rendering", func() {
+			Expect(engine.syncRuntimeClassGeneration(ctx, runtimeObj, runtimeClass)).To(Succeed())
+			Expect(getRenderedGeneration()).To(Equal(int64(2)))
+			Expect(getMasterImage()).To(Equal("test-master:v1"))
+		})
+	})
+})
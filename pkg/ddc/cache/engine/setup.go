@@ -78,6 +78,13 @@ func (e *CacheEngine) Setup(ctx cruntime.ReconcileRequestContext) (ready bool, e
 		}
 	}
 
+	// record the generation of the runtime class which the components are rendered from
+	if runtime.Status.RuntimeClassGeneration == 0 {
+		if err = e.updateRuntimeClassGeneration(ctx.Context, runtimeClass.Generation); err != nil {
+			return false, err
+		}
+	}
+
 	// CheckAndUpdateRuntimeStatus after components are setup
 	// Use lightweight getRuntimeStatusValue instead of full transform for status update
 	statusValue, err := e.getRuntimeStatusValue(runtime, runtimeClass)
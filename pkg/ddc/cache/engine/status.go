@@ -124,6 +124,8 @@ func (e *CacheEngine) CheckAndUpdateRuntimeStatus(value *common.CacheRuntimeStat
 			if err != nil {
 				return err
 			}
+			// the selector of the workers is used by the scale subresource
+			runtimeToUpdate.Status.Selector = e.getWorkerSelectors()
 		}
 
 		if value.Client.Enabled {
@@ -149,7 +151,6 @@ func (e *CacheEngine) CheckAndUpdateRuntimeStatus(value *common.CacheRuntimeStat
 			runtimeToUpdate.Status.SetupDuration = utils.CalculateDuration(runtimeToUpdate.CreationTimestamp.Time, time.Now())
 		}
 
-		// TODO(cache runtime): set the CacheRuntime Status left fields:  Selector
 		runtimeToUpdate.Status.ValueFile = common.GetCacheRuntimeConfigConfigMapName(e.name)
 
 		if !reflect.DeepEqual(runtime.Status, runtimeToUpdate.Status) {
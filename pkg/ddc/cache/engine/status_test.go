@@ -79,6 +79,8 @@ var _ = Describe("CheckAndUpdateRuntimeStatus", func() {
 				updatedRuntime := getUpdatedRuntime(client)
 				Expect(updatedRuntime.Status.Client.Phase).To(Equal(datav1alpha1.RuntimePhaseNotReady))
 				Expect(updatedRuntime.Status.SetupDuration).NotTo(BeEmpty(), "expected setup duration to be recorded once runtime is ready")
+				Expect(updatedRuntime.Status.Selector).To(Equal(engine.getWorkerSelectors()))
+				Expect(updatedRuntime.Status.Selector).To(ContainSubstring(common.LabelCacheRuntimeComponentName + "=" + testStatusWorker))
 			})
 		})
 
@@ -64,7 +64,11 @@ func (e *CacheEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error) {
 		return err
 	}
 
-	// TODO: implement other logic like inplace update and replica scaling
+	// sync worker replicas changed by the scale subresource
+	err = e.SyncReplicas(ctx, runtime, runtimeClass)
+	if err != nil {
+		return err
+	}
 
 	// Use lightweight getRuntimeStatusValue instead of full transform for status update
 	statusValue, err := e.getRuntimeStatusValue(runtime, runtimeClass)
@@ -72,6 +76,12 @@ func (e *CacheEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error) {
 		return err
 	}
 
+	// re-render Master/Worker components if the runtime class is changed and the runtime opts in
+	err = e.syncRuntimeClassGeneration(ctx, runtime, runtimeClass)
+	if err != nil {
+		return err
+	}
+
 	// sync runtime spec changes to Master/Worker components
 	err = e.syncRuntimeSpec(ctx, runtime, runtimeClass)
 	if err != nil {
@@ -91,6 +101,25 @@ func (e *CacheEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error) {
 			return err
 		}
 	} else if permitSyncEngineStatus {
+		err = e.CheckRuntimeHealthy(runtime, runtimeClass)
+		if err != nil {
+			e.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.RuntimeUnhealthy, "Runtime is unhealthy: %v", err)
+			if updateErr := e.UpdateDatasetStatus(datav1alpha1.FailedDatasetPhase, runtime, runtimeClass); updateErr != nil {
+				e.Log.Error(updateErr, "failed to update dataset status to \"Failed\"")
+			}
+			return err
+		}
+
+		// the dataset failed by a transient unhealthy check is bound again
+		if err = e.recoverFailedDataset(runtime, runtimeClass); err != nil {
+			return err
+		}
+
+		// the metadata is synced only once, failures are retried in the next sync
+		if err = e.SyncMetadata(runtime, runtimeClass); err != nil {
+			e.Log.Error(err, "Failed to sync metadata")
+		}
+
 		// sync dataset cache states when runtime is ready and sync permitted
 		e.Log.Info("sync dataset cache states")
 		err = e.syncDatasetCacheStates(ctx, runtime, runtimeClass)
@@ -223,10 +252,10 @@ func (e *CacheEngine) syncRuntimeSpec(ctx cruntime.ReconcileRequestContext, runt
 		if runtime.Spec.Worker.Resources.Requests != nil || runtime.Spec.Worker.Resources.Limits != nil {
 			workerResources = runtime.Spec.Worker.Resources
 		}
+		// Worker replicas are synced by SyncReplicas
 		workerSpec := component.ComponentSpec{
 			Version:   runtime.Spec.Worker.RuntimeVersion,
 			Resources: workerResources,
-			Replicas:  &runtime.Spec.Worker.Replicas,
 		}
 		if err := manager.SyncComponentSpec(ctx.Context, workerIdentity, workerSpec); err != nil {
 			e.Log.Error(err, "failed to sync worker component spec", "component", workerIdentity.Name)
@@ -247,6 +276,15 @@ func (e *CacheEngine) syncDatasetCacheStates(ctx cruntime.ReconcileRequestContex
 		// not blocking the sync flow when failed to get cache states
 		return nil
 	}
+	if cacheStates[common.CacheCapacity] == "" {
+		// fall back to the ReportCapacity command if the cache capacity is not reported in the summary
+		capacity, err := e.getCacheCapacity(runtime, runtimeClass)
+		if err != nil {
+			e.Log.V(1).Info("Failed to get cache capacity", "error", err.Error())
+		} else {
+			cacheStates[common.CacheCapacity] = utils.BytesSize(float64(capacity.CapacityBytes))
+		}
+	}
 
 	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
@@ -256,8 +294,13 @@ func (e *CacheEngine) syncDatasetCacheStates(ctx cruntime.ReconcileRequestContex
 		datasetToUpdate := dataset.DeepCopy()
 
 		datasetToUpdate.Status.CacheStates = cacheStates
-		datasetToUpdate.Status.FileNum = cacheStates[common.FileNum]
-		datasetToUpdate.Status.UfsTotal = cacheStates[common.UfsTotal]
+		// keep the file num and ufs total synced by SyncMetadata if not reported
+		if fileNum := cacheStates[common.FileNum]; fileNum != "" {
+			datasetToUpdate.Status.FileNum = fileNum
+		}
+		if ufsTotal := cacheStates[common.UfsTotal]; ufsTotal != "" {
+			datasetToUpdate.Status.UfsTotal = ufsTotal
+		}
 
 		if !reflect.DeepEqual(dataset.Status, datasetToUpdate.Status) {
 			e.Log.Info("the dataset status", "status", datasetToUpdate.Status)
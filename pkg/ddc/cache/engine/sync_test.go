@@ -18,6 +18,7 @@ package engine
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	cclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -381,6 +383,62 @@ var _ = Describe("CacheEngine Sync Tests", Label("pkg.ddc.cache.engine.sync_test
 			})
 		})
 
+		Context("when runtime is ready with HealthCheck configured", func() {
+			var patches *gomonkey.Patches
+
+			BeforeEach(func() {
+				runtimeClass.Topology.Master.ExecutionEntries.HealthCheck = &datav1alpha1.ExecutionCommonEntry{
+					Command: []string{"health"},
+				}
+				Expect(fakeClient.Update(context.Background(), runtimeClass)).To(Succeed())
+				engine.Recorder = record.NewFakeRecorder(10)
+			})
+
+			AfterEach(func() {
+				if patches != nil {
+					patches.Reset()
+				}
+			})
+
+			It("should set the dataset phase to Failed if the runtime is unhealthy", func() {
+				patches = gomonkey.ApplyFunc(NewCacheFileUtil, func(podName, containerName, namespace string, log logr.Logger) CacheFileUtil {
+					return &MockExecutions{MockExecute: func(command []string, timeout time.Duration) (stdout string, err error) {
+						return `{"healthy":false,"message":"master is not serving"}`, nil
+					}}
+				})
+
+				err := engine.Sync(ctx)
+				Expect(err).To(MatchError(ContainSubstring("master is not serving")))
+
+				updateDataset := datav1alpha1.Dataset{}
+				Expect(engine.Client.Get(context.Background(), types.NamespacedName{
+					Name:      "test-runtime",
+					Namespace: "default",
+				}, &updateDataset)).To(Succeed())
+				Expect(updateDataset.Status.Phase).To(Equal(datav1alpha1.FailedDatasetPhase))
+			})
+
+			It("should set the dataset phase back to Bound once the runtime is healthy again", func() {
+				healthy := false
+				patches = gomonkey.ApplyFunc(NewCacheFileUtil, func(podName, containerName, namespace string, log logr.Logger) CacheFileUtil {
+					return &MockExecutions{MockExecute: func(command []string, timeout time.Duration) (stdout string, err error) {
+						return fmt.Sprintf(`{"healthy":%t,"message":"master is not serving"}`, healthy), nil
+					}}
+				})
+				Expect(engine.Sync(ctx)).NotTo(Succeed())
+
+				healthy = true
+				Expect(engine.Sync(ctx)).To(Succeed())
+
+				updateDataset := datav1alpha1.Dataset{}
+				Expect(engine.Client.Get(context.Background(), types.NamespacedName{
+					Name:      "test-runtime",
+					Namespace: "default",
+				}, &updateDataset)).To(Succeed())
+				Expect(updateDataset.Status.Phase).To(Equal(datav1alpha1.BoundDatasetPhase))
+			})
+		})
+
 		Context("when runtime is not ready (master not ready)", func() {
 			BeforeEach(func() {
 				masterReplicas := int32(1)
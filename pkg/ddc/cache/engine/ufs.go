@@ -19,6 +19,7 @@ package engine
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -67,6 +68,68 @@ func (e *CacheEngine) PrepareUFS(archApi ArchitectureApi) (mountOutput *datav1al
 	return mountOutput, nil
 }
 
+// UnmountUFS unmounts the given ufs paths with the UnmountUFS execution entry, and returns the unmounted paths.
+// Nil is returned if the entry is not configured in the runtime class.
+func (e *CacheEngine) UnmountUFS(archApi ArchitectureApi, paths []string) (unmountOutput *datav1alpha1.CacheRuntimeUnmountUfsOutput, err error) {
+	entries := archApi.GetExecutionEntries()
+	if entries == nil || entries.UnmountUFS == nil {
+		e.Log.Info("No unmount ufs command found in runtime class")
+		return nil, nil
+	}
+
+	unmountOutput = &datav1alpha1.CacheRuntimeUnmountUfsOutput{}
+	if err = e.executeEntry(archApi, "unmount ufs", entries.UnmountUFS, paths, unmountOutput); err != nil {
+		return nil, err
+	}
+	return unmountOutput, nil
+}
+
+// UsedStorageBytes returns used storage size of the cache runtime in bytes
+func (e *CacheEngine) UsedStorageBytes() (value int64, err error) {
+	capacity, err := e.reportCapacity()
+	if err != nil {
+		return
+	}
+	return capacity.UsedBytes, nil
+}
+
+// FreeStorageBytes returns free storage size of the cache runtime in bytes
+func (e *CacheEngine) FreeStorageBytes() (value int64, err error) {
+	capacity, err := e.reportCapacity()
+	if err != nil {
+		return
+	}
+	return max(capacity.CapacityBytes-capacity.UsedBytes, 0), nil
+}
+
+// reportCapacity gets the cache capacity of the runtime with the ReportCapacity execution entry of the runtime class
+func (e *CacheEngine) reportCapacity() (capacity *datav1alpha1.CacheRuntimeReportCapacityOutput, err error) {
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return nil, err
+	}
+	runtimeClass, err := e.getRuntimeClass(runtime.Spec.RuntimeClassName)
+	if err != nil {
+		return nil, err
+	}
+	return e.getCacheCapacity(runtime, runtimeClass)
+}
+
+// getCacheCapacity executes the ReportCapacity execution entry, an error is returned if the entry is not configured.
+func (e *CacheEngine) getCacheCapacity(runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) (capacity *datav1alpha1.CacheRuntimeReportCapacityOutput, err error) {
+	archApi := resolveArchitectureApi(e.name, e.namespace, runtime, runtimeClass)
+	entries := archApi.GetExecutionEntries()
+	if entries == nil || entries.ReportCapacity == nil {
+		return nil, errors.New("ReportCapacity command is empty or not configured for this cache runtime")
+	}
+
+	capacity = &datav1alpha1.CacheRuntimeReportCapacityOutput{}
+	if err = e.executeEntry(archApi, "report capacity", entries.ReportCapacity, nil, capacity); err != nil {
+		return nil, err
+	}
+	return capacity, nil
+}
+
 // shouldUpdateUFS determines whether the UFS configuration needs to be updated.
 // It analyzes path differences between the provided dataset spec and status to identify
 // which UFS entries require updates. It also checks if the master pod has restarted
@@ -132,7 +195,7 @@ func (e *CacheEngine) UpdateOnUFSChange(runtime *datav1alpha1.CacheRuntime) (err
 	}
 
 	// 5. sync dataset mounts, to prevent the runtime config not updated in pods in time.
-	err = e.syncDatasetMounts(dataset, mountOutput)
+	err = e.syncDatasetMounts(dataset, archApi, mountOutput)
 	if err != nil {
 		e.Log.Error(err, "Failed to sync dataset mounts")
 		return err
@@ -149,7 +212,8 @@ func (e *CacheEngine) UpdateOnUFSChange(runtime *datav1alpha1.CacheRuntime) (err
 
 // syncDatasetMounts synchronizes the dataset mount points with the current runtime state.
 // This ensures that any changes to dataset.spec.mounts are reflected in the running system.
-func (e *CacheEngine) syncDatasetMounts(dataset *datav1alpha1.Dataset, mountOutput *datav1alpha1.CacheRuntimeMountUfsOutput) (err error) {
+// The mounted paths removed from the dataset are unmounted if the runtime class supports unmounting UFS.
+func (e *CacheEngine) syncDatasetMounts(dataset *datav1alpha1.Dataset, archApi ArchitectureApi, mountOutput *datav1alpha1.CacheRuntimeMountUfsOutput) (err error) {
 
 	// Update MountPoints based on current dataset mounts
 	var mountedPaths = map[string]bool{}
@@ -170,8 +234,13 @@ func (e *CacheEngine) syncDatasetMounts(dataset *datav1alpha1.Dataset, mountOutp
 		delete(mountedPaths, datasetMountPath)
 	}
 	if len(mountedPaths) != 0 {
-		e.Log.Info("Waiting for mount point to be unmounted", "Mount points", mountedPaths)
-		return fmt.Errorf("unexpected mounted paths remain: %v", mountedPaths)
+		if err = e.unmountRemovedPaths(archApi, mountedPaths); err != nil {
+			return err
+		}
+		if len(mountedPaths) != 0 {
+			e.Log.Info("Waiting for mount point to be unmounted", "Mount points", mountedPaths)
+			return fmt.Errorf("unexpected mounted paths remain: %v", mountedPaths)
+		}
 	}
 
 	// update dataset status mount and phase status with retry
@@ -183,6 +252,29 @@ func (e *CacheEngine) syncDatasetMounts(dataset *datav1alpha1.Dataset, mountOutp
 	return nil
 }
 
+// unmountRemovedPaths unmounts the mounted paths which are removed from the dataset, and deletes the unmounted ones
+// from mountedPaths.
+func (e *CacheEngine) unmountRemovedPaths(archApi ArchitectureApi, mountedPaths map[string]bool) error {
+	paths := make([]string, 0, len(mountedPaths))
+	for path := range mountedPaths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	unmountOutput, err := e.UnmountUFS(archApi, paths)
+	if err != nil {
+		return errors.Wrapf(err, "failed to execute unmount ufs command")
+	}
+	if unmountOutput == nil {
+		return nil
+	}
+	for _, path := range unmountOutput.Unmounted {
+		delete(mountedPaths, path)
+	}
+	e.Log.Info("Unmounted the paths removed from the dataset", "paths", unmountOutput.Unmounted)
+	return nil
+}
+
 func (e *CacheEngine) checkIfRemountRequired(archApi ArchitectureApi, runtime *datav1alpha1.CacheRuntime) bool {
 	masterPodName, masterContainerName, err := archApi.GetExecutionPodInfo()
 	if err != nil {
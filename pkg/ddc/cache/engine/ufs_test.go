@@ -637,6 +637,55 @@ var _ = Describe("CacheEngine UpdateOnUFSChange Tests", Label("pkg.ddc.cache.eng
 			})
 		})
 
+		Context("when the runtime class supports unmounting UFS", func() {
+			var unmountCommand []string
+
+			setupUnmountTest := func(unmountOutput string) *datav1alpha1.CacheRuntime {
+				unmountCommand = nil
+				rc, _, rt := setupUpdateOnUFSTest(func(command []string, timeout time.Duration) (stdout string, err error) {
+					if command[0] == "/unmount.sh" {
+						unmountCommand = command
+						return unmountOutput, nil
+					}
+					return `{"mounted": ["/mount1", "/mount2", "/removed-b", "/removed-a"]}`, nil
+				})
+				rc.Topology.Master.ExecutionEntries.UnmountUFS = &datav1alpha1.ExecutionCommonEntry{
+					Command: []string{"/unmount.sh"},
+				}
+				Expect(fakeClient.Update(context.Background(), rc)).NotTo(HaveOccurred())
+				return rt
+			}
+
+			It("should unmount the paths removed from the dataset and set status to Bound", func() {
+				rt := setupUnmountTest(`{"unmounted": ["/removed-a", "/removed-b"]}`)
+
+				err := engine.UpdateOnUFSChange(rt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(unmountCommand).To(Equal([]string{"/unmount.sh", "/removed-a", "/removed-b"}))
+
+				updatedDataset := &datav1alpha1.Dataset{}
+				Expect(fakeClient.Get(context.Background(), types.NamespacedName{Name: "test-runtime", Namespace: "default"}, updatedDataset)).NotTo(HaveOccurred())
+				Expect(updatedDataset.Status.Phase).To(Equal(datav1alpha1.BoundDatasetPhase))
+			})
+
+			It("should return unexpected mounted paths error if some paths are not unmounted", func() {
+				rt := setupUnmountTest(`{"unmounted": ["/removed-a"]}`)
+
+				err := engine.UpdateOnUFSChange(rt)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("unexpected mounted paths remain"))
+				Expect(err.Error()).To(ContainSubstring("/removed-b"))
+			})
+
+			It("should return error if the unmount output is invalid", func() {
+				rt := setupUnmountTest("invalid json")
+
+				err := engine.UpdateOnUFSChange(rt)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to parse unmount ufs output"))
+			})
+		})
+
 		Context("when all mount points are correctly mounted", func() {
 			It("should successfully update mounts and set status to Bound", func() {
 				_, _, rt := setupUpdateOnUFSTest(func(command []string, timeout time.Duration) (stdout string, err error) {
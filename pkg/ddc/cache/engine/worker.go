@@ -25,6 +25,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/cache/component"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
 )
 
@@ -105,3 +106,23 @@ func (e *CacheEngine) SetupWorkerInternal(workerValue *common.CacheRuntimeCompon
 
 	return nil
 }
+
+// getWorkerSelectors gets the selector of the worker, which matches the labels of the worker pods
+func (e *CacheEngine) getWorkerSelectors() string {
+	labels := map[string]string{
+		common.LabelCacheRuntimeName:          e.name,
+		common.LabelCacheRuntimeComponentName: common.GetCacheComponentName(e.name, common.ComponentTypeWorker),
+	}
+	labelSelector := &metav1.LabelSelector{
+		MatchLabels: labels,
+	}
+
+	selectorValue := ""
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		e.Log.Error(err, "Failed to parse the labelSelector of the runtime", "labels", labels)
+	} else {
+		selectorValue = selector.String()
+	}
+	return selectorValue
+}
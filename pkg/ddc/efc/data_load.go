@@ -17,8 +17,32 @@
 package efc
 
 import (
-	"github.com/fluid-cloudnative/fluid/pkg/ddc/efc/operations"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/efc/operations"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+const (
+	// the dataset is mounted into the DataLoad job with this volume at this path, reading the files
+	// through the EFC fuse warms them up in the cache workers.
+	dataLoadDatasetVolumeName = "fluid-dataset-vol"
+	dataLoadDatasetMountPath  = "/data"
+
+	envDataLoadMountPath = "FLUID_DATALOAD_MOUNT_PATH"
 )
 
 // CheckRuntimeReady checks if runtime is ready or not
@@ -47,3 +71,143 @@ func (e *EFCEngine) CheckRuntimeReady() (ready bool) {
 	}
 	return readyCount > 0
 }
+
+// generateDataLoadValueFile builds a DataLoadValue by extracted specifications from the given DataLoad, and
+// marshals the DataLoadValue to a temporary yaml file where stores values that'll be used by fluid dataloader helm chart
+func (e *EFCEngine) generateDataLoadValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataLoad, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataLoad", object)
+		return "", err
+	}
+
+	if cdataload.HasTargetFilters(dataLoad) {
+		err = cdataload.NewTargetFiltersNotSupported(common.EFCRuntime)
+		return "", err
+	}
+
+	targetDataset, err := utils.GetDataset(e.Client, dataLoad.Spec.Dataset.Name, dataLoad.Spec.Dataset.Namespace)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get dataset")
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get efcruntime")
+	}
+
+	dataLoadValue, err := e.genDataLoadValue(targetDataset, runtime, dataLoad)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataLoadValue)
+	if err != nil {
+		return "", err
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-loader-values.yaml", dataLoad.Namespace, dataLoad.Name))
+	if err != nil {
+		return "", err
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return "", err
+	}
+	return valueFile.Name(), nil
+}
+
+func (e *EFCEngine) genDataLoadValue(targetDataset *datav1alpha1.Dataset, runtime *datav1alpha1.EFCRuntime,
+	dataLoad *datav1alpha1.DataLoad) (value *cdataload.DataLoadValue, err error) {
+	// the files are read by the EFC fuse image, which has the shell tools the loader script needs
+	image, tag, _, imagePullSecrets := e.parseFuseImage(runtime.Spec.Fuse.Version.Image, runtime.Spec.Fuse.Version.ImageTag,
+		runtime.Spec.Fuse.Version.ImagePullPolicy, []corev1.LocalObjectReference{})
+
+	dataLoadInfo := cdataload.DataLoadInfo{
+		BackoffLimit:     3,
+		TargetDataset:    dataLoad.Spec.Dataset.Name,
+		LoadMetadata:     dataLoad.Spec.LoadMetadata,
+		Image:            fmt.Sprintf("%s:%s", image, tag),
+		ImagePullSecrets: imagePullSecrets,
+		Labels:           dataLoad.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataLoad.Annotations, dataLoad.Spec.PodMetadata.Annotations),
+		Policy:           string(dataLoad.Spec.Policy),
+		Schedule:         dataLoad.Spec.Schedule,
+		CronJobPolicy:    cdataload.GenCronJobPolicy(dataLoad),
+		Resources:        dataLoad.Spec.Resources,
+	}
+
+	if dataLoad.Spec.Affinity != nil {
+		dataLoadInfo.Affinity = dataLoad.Spec.Affinity
+	}
+
+	// inject the node affinity by previous operation pod.
+	dataLoadInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(e.Client, dataLoad.Spec.RunAfter, dataLoad.Namespace, dataLoadInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	if dataLoad.Spec.NodeSelector != nil {
+		dataLoadInfo.NodeSelector = dataLoad.Spec.NodeSelector
+	}
+
+	if len(dataLoad.Spec.Tolerations) > 0 {
+		dataLoadInfo.Tolerations = dataLoad.Spec.Tolerations
+	}
+
+	if len(dataLoad.Spec.SchedulerName) > 0 {
+		dataLoadInfo.SchedulerName = dataLoad.Spec.SchedulerName
+	}
+
+	targetPaths := []cdataload.TargetPath{}
+	for _, target := range dataLoad.Spec.Target {
+		targetPaths = append(targetPaths, cdataload.TargetPath{
+			Path:     target.Path,
+			Replicas: target.Replicas,
+		})
+	}
+	// load the whole dataset if no target is specified
+	if len(targetPaths) == 0 {
+		targetPaths = append(targetPaths, cdataload.TargetPath{
+			Path:     "/",
+			Replicas: 1,
+		})
+	}
+	dataLoadInfo.TargetPaths = targetPaths
+
+	dataLoadInfo.Envs = []cdataload.Env{
+		{
+			Name:  envDataLoadMountPath,
+			Value: dataLoadDatasetMountPath,
+		},
+	}
+
+	// the NAS is accessed by the dataset pvc, so that the files are read through the cache workers.
+	dataLoadInfo.Volumes = []corev1.Volume{
+		{
+			Name: dataLoadDatasetVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: targetDataset.Name,
+					ReadOnly:  true,
+				},
+			},
+		},
+	}
+	dataLoadInfo.VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      dataLoadDatasetVolumeName,
+			MountPath: dataLoadDatasetMountPath,
+			ReadOnly:  true,
+		},
+	}
+
+	value = &cdataload.DataLoadValue{
+		Name:           dataLoad.Name,
+		OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+		DataLoadInfo:   dataLoadInfo,
+		Owner:          transformer.GenerateOwnerReferenceFromObject(dataLoad),
+	}
+
+	return value, nil
+}
@@ -17,16 +17,22 @@
 package efc
 
 import (
+	"os"
+	"reflect"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/efc/operations"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 )
 
 func TestEFCEngine_CheckRuntimeReady(t *testing.T) {
@@ -201,3 +207,103 @@ func TestEFCEngine_CheckRuntimeReady(t *testing.T) {
 		})
 	}
 }
+
+func TestEFCEngine_generateDataLoadValueFile(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "efcdemo", Namespace: "fluid"},
+	}
+	efcRuntime := &datav1alpha1.EFCRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "efcdemo", Namespace: "fluid"},
+		Spec: datav1alpha1.EFCRuntimeSpec{
+			Fuse: datav1alpha1.EFCFuseSpec{
+				Version: datav1alpha1.VersionSpec{Image: "efc-fuse", ImageTag: "v1"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		spec            datav1alpha1.DataLoadSpec
+		wantErr         bool
+		wantTargetPaths []cdataload.TargetPath
+	}{
+		{
+			name: "load the whole dataset by default",
+			spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "efcdemo", Namespace: "fluid"},
+			},
+			wantTargetPaths: []cdataload.TargetPath{{Path: "/", Replicas: 1}},
+		},
+		{
+			name: "load the target paths",
+			spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "efcdemo", Namespace: "fluid"},
+				Target:  []datav1alpha1.TargetPath{{Path: "/a"}, {Path: "/b", Replicas: 2}},
+			},
+			wantTargetPaths: []cdataload.TargetPath{{Path: "/a"}, {Path: "/b", Replicas: 2}},
+		},
+		{
+			name: "target filters are not supported",
+			spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "efcdemo", Namespace: "fluid"},
+				Include: []string{"*.csv"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dataset not found",
+			spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "notfound", Namespace: "fluid"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewFakeClientWithScheme(testScheme, dataset.DeepCopy(), efcRuntime.DeepCopy())
+			e := &EFCEngine{
+				name:      "efcdemo",
+				namespace: "fluid",
+				Client:    client,
+				Log:       fake.NullLogger(),
+			}
+			dataLoad := &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+				Spec:       tt.spec,
+			}
+
+			valueFileName, err := e.generateDataLoadValueFile(cruntime.ReconcileRequestContext{}, dataLoad)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("generateDataLoadValueFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			defer os.Remove(valueFileName)
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value cdataload.DataLoadValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+			info := value.DataLoadInfo
+			if info.Image != "efc-fuse:v1" {
+				t.Errorf("image = %q, want %q", info.Image, "efc-fuse:v1")
+			}
+			if !reflect.DeepEqual(info.TargetPaths, tt.wantTargetPaths) {
+				t.Errorf("targetPaths = %v, want %v", info.TargetPaths, tt.wantTargetPaths)
+			}
+			if len(info.Volumes) != 1 || info.Volumes[0].PersistentVolumeClaim == nil ||
+				info.Volumes[0].PersistentVolumeClaim.ClaimName != "efcdemo" {
+				t.Errorf("unexpected volumes %v", info.Volumes)
+			}
+			if len(info.VolumeMounts) != 1 || info.VolumeMounts[0].MountPath != dataLoadDatasetMountPath {
+				t.Errorf("unexpected volume mounts %v", info.VolumeMounts)
+			}
+		})
+	}
+}
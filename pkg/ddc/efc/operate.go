@@ -28,6 +28,9 @@ func (e *EFCEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestConte
 	object := operation.GetOperationObject()
 
 	switch operationType {
+	case dataoperation.DataLoadType:
+		valueFileName, err = e.generateDataLoadValueFile(ctx, object)
+		return valueFileName, err
 	case dataoperation.DataProcessType:
 		valueFileName, err = e.generateDataProcessValueFile(ctx, object)
 		return valueFileName, err
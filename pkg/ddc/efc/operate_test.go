@@ -74,9 +74,17 @@ func (m *mockOperation) GetTTL() (*int32, error) { return nil, nil }
 
 func (m *mockOperation) GetParallelTaskNumber() int32 { return 1 }
 
+func (m *mockOperation) GetRetryPolicy() dataoperation.RetryPolicy {
+	return dataoperation.RetryPolicy{}
+}
+
+func (m *mockOperation) IsSuspended() bool {
+	return false
+}
+
 var _ = Describe("EFCEngine operate", func() {
 	Describe("GetDataOperationValueFile", func() {
-		It("returns not supported for non-dataprocess operations", func() {
+		It("returns not supported for other operations", func() {
 			engine := &EFCEngine{Log: fake.NullLogger()}
 			operation := &mockOperation{
 				operationType: dataoperation.DataBackupType,
@@ -95,6 +103,51 @@ var _ = Describe("EFCEngine operate", func() {
 			Expect(valueFileName).To(BeEmpty())
 		})
 
+		It("delegates dataload operations to the data load value generator", func() {
+			dataset := &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "demo-dataset",
+					Namespace: "default",
+				},
+			}
+			runtimeObj := &datav1alpha1.EFCRuntime{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "demo-dataset",
+					Namespace: "default",
+				},
+			}
+			dataLoad := &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "demo-dataload",
+					Namespace: "default",
+				},
+				Spec: datav1alpha1.DataLoadSpec{
+					Dataset: datav1alpha1.TargetDataset{
+						Name:      "demo-dataset",
+						Namespace: "default",
+					},
+				},
+			}
+			engine := &EFCEngine{
+				name:      "demo-dataset",
+				namespace: "default",
+				Client:    fake.NewFakeClientWithScheme(testScheme, dataset.DeepCopy(), runtimeObj.DeepCopy()),
+				Log:       fake.NullLogger(),
+			}
+			operation := &mockOperation{
+				operationType: dataoperation.DataLoadType,
+				object:        dataLoad,
+			}
+
+			valueFileName, err := engine.GetDataOperationValueFile(cruntime.ReconcileRequestContext{}, operation)
+			if valueFileName != "" {
+				DeferCleanup(os.Remove, valueFileName)
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(valueFileName).NotTo(BeEmpty())
+		})
+
 		It("delegates dataprocess operations to the data process value generator", func() {
 			dataset := &datav1alpha1.Dataset{
 				ObjectMeta: metav1.ObjectMeta{
@@ -47,6 +47,11 @@ func (e *JindoEngine) generateDataLoadValueFile(r cruntime.ReconcileRequestConte
 		return "", err
 	}
 
+	if cdataload.HasTargetFilters(dataload) {
+		err = cdataload.NewTargetFiltersNotSupported(common.JindoRuntime)
+		return "", err
+	}
+
 	targetDataset, err := utils.GetDataset(r.Client, dataload.Spec.Dataset.Name, dataload.Spec.Dataset.Namespace)
 	if err != nil {
 		return "", err
@@ -124,6 +129,7 @@ func (e *JindoEngine) genDataLoadValue(image string, runtime *datav1alpha1.Jindo
 		ImagePullSecrets: imagePullSecrets,
 		Policy:           string(dataload.Spec.Policy),
 		Schedule:         dataload.Spec.Schedule,
+		CronJobPolicy:    cdataload.GenCronJobPolicy(dataload),
 	}
 
 	// pod affinity
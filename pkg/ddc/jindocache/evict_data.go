@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindocache
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdataevict "github.com/fluid-cloudnative/fluid/pkg/dataevict"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// evictData formats the cache of the runtime in the master pod, and returns the used cache capacity
+// before formatting as the evicted bytes. Jindo doesn't support formatting the cache of a path,
+// so only the cache of the whole dataset can be evicted.
+func (e *JindoCacheEngine) evictData(ctx cruntime.ReconcileRequestContext, object client.Object) (infos map[string]string, err error) {
+	dataEvict, ok := object.(*datav1alpha1.DataEvict)
+	if !ok {
+		return nil, fmt.Errorf("object %v is not of type DataEvict", object)
+	}
+
+	if !cdataevict.IsWholeDatasetEvicted(dataEvict) {
+		return nil, fmt.Errorf("JindoRuntime only supports evicting the cache of the whole dataset, target path must be \"/\" or empty")
+	}
+
+	// invokeCleanCache skips the master which is missing or not ready for the shutdown, so check it here to avoid
+	// reporting the cache as evicted without cleaning it
+	if err = e.checkMasterReadyForEviction(); err != nil {
+		return nil, err
+	}
+
+	states, err := e.queryCacheStatus()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query the cache status")
+	}
+	var evictedBytes int64
+	if len(states.cached) != 0 {
+		evictedBytes, err = utils.FromHumanSize(states.cached)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the cached size %s", states.cached)
+		}
+	}
+
+	ctx.Log.Info("evict the cache of the whole dataset", "cached", states.cached)
+	if err = e.invokeCleanCache(); err != nil {
+		return nil, errors.Wrap(err, "failed to evict the cache")
+	}
+
+	return map[string]string{
+		cdataevict.EvictedBytes: strconv.FormatInt(evictedBytes, 10),
+	}, nil
+}
+
+// checkMasterReadyForEviction returns an error if the master is missing or not ready, e.g. the master is disabled.
+func (e *JindoCacheEngine) checkMasterReadyForEviction() error {
+	masterName := e.getMasterName()
+	master, err := kubeclient.GetStatefulSet(e.Client, masterName, e.namespace)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the master %s to evict the cache", masterName)
+	}
+	if master.Status.ReadyReplicas == 0 {
+		return fmt.Errorf("the master %s is not ready to evict the cache", masterName)
+	}
+	return nil
+}
@@ -46,6 +46,11 @@ func (e *JindoCacheEngine) generateDataLoadValueFile(r cruntime.ReconcileRequest
 		return "", err
 	}
 
+	if cdataload.HasTargetFilters(dataload) {
+		err = cdataload.NewTargetFiltersNotSupported(common.JindoRuntime)
+		return "", err
+	}
+
 	targetDataset, err := utils.GetDataset(r.Client, dataload.Spec.Dataset.Name, dataload.Spec.Dataset.Namespace)
 	if err != nil {
 		return "", err
@@ -122,6 +127,7 @@ func (e *JindoCacheEngine) genDataLoadValue(image string, runtime *datav1alpha1.
 		ImagePullSecrets: imagePullSecrets,
 		Policy:           string(dataload.Spec.Policy),
 		Schedule:         dataload.Spec.Schedule,
+		CronJobPolicy:    cdataload.GenCronJobPolicy(dataload),
 		Resources:        dataload.Spec.Resources,
 	}
 
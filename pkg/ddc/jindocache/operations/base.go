@@ -205,3 +205,49 @@ func (a JindoFileUtils) RefreshCacheSet() (err error) {
 	}
 	return
 }
+
+// DecommissionWorker decommissions the worker by running `jindocache -decommission` command, the master stops
+// serving the cached blocks on the decommissioned worker.
+func (a JindoFileUtils) DecommissionWorker(host string) (err error) {
+	var (
+		command = []string{"jindocache", "-decommission", host}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, true)
+	if err != nil {
+		a.log.Error(err, "JindoFileUtils.DecommissionWorker() failed", "stdout", stdout, "stderr", stderr)
+	}
+	return
+}
+
+// RecommissionWorker recommissions the decommissioned worker by running `jindocache -recommission` command
+func (a JindoFileUtils) RecommissionWorker(host string) (err error) {
+	var (
+		command = []string{"jindocache", "-recommission", host}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, true)
+	if err != nil {
+		a.log.Error(err, "JindoFileUtils.RecommissionWorker() failed", "stdout", stdout, "stderr", stderr)
+	}
+	return
+}
+
+// ReportNodes reports the details of the nodes registered in the master by running `jindocache -report -detail` command
+func (a JindoFileUtils) ReportNodes() (report string, err error) {
+	var (
+		command = []string{"jindocache", "-report", "-detail"}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		a.log.Error(err, "JindoFileUtils.ReportNodes() failed", "stdout", stdout, "stderr", stderr)
+	}
+	return stdout, err
+}
@@ -435,4 +435,54 @@ var _ = Describe("JindoFileUtils", func() {
 			})
 		})
 	})
+
+	Describe("DecommissionWorker and RecommissionWorker", func() {
+		var (
+			a       *JindoFileUtils
+			patches *gomonkey.Patches
+			command []string
+		)
+
+		BeforeEach(func() {
+			a = &JindoFileUtils{log: fake.NullLogger()}
+			command = nil
+		})
+
+		AfterEach(func() {
+			if patches != nil {
+				patches.Reset()
+			}
+		})
+
+		Context("when exec fails", func() {
+			It("should return an error", func() {
+				ExecErr := func(a JindoFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+					return "", "", errors.New("fail to run the command")
+				}
+				patches = gomonkey.ApplyPrivateMethod(JindoFileUtils{}, "exec", ExecErr)
+
+				Expect(a.DecommissionWorker("192.168.0.1")).To(HaveOccurred())
+				Expect(a.RecommissionWorker("192.168.0.1")).To(HaveOccurred())
+			})
+		})
+
+		Context("when exec succeeds", func() {
+			It("should run the commands with the worker host", func() {
+				ExecCommon := func(a JindoFileUtils, cmd []string, verbose bool) (stdout string, stderr string, err error) {
+					command = cmd
+					return "", "", nil
+				}
+				patches = gomonkey.ApplyPrivateMethod(JindoFileUtils{}, "exec", ExecCommon)
+
+				Expect(a.DecommissionWorker("192.168.0.1")).To(Succeed())
+				Expect(command).To(Equal([]string{"jindocache", "-decommission", "192.168.0.1"}))
+				Expect(a.RecommissionWorker("192.168.0.1")).To(Succeed())
+				Expect(command).To(Equal([]string{"jindocache", "-recommission", "192.168.0.1"}))
+
+				_, err := a.ReportNodes()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(command).To(Equal([]string{"jindocache", "-report", "-detail"}))
+			})
+		})
+	})
 })
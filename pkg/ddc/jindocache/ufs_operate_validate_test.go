@@ -45,6 +45,8 @@ func (f fakeOperation) RemoveTargetDatasetStatusInProgress(*datav1alpha1.Dataset
 func (f fakeOperation) GetStatusHandler() dataoperation.StatusHandler             { return nil }
 func (f fakeOperation) GetTTL() (*int32, error)                                   { return nil, nil }
 func (f fakeOperation) GetParallelTaskNumber() int32                              { return 1 }
+func (f fakeOperation) GetRetryPolicy() dataoperation.RetryPolicy                 { return dataoperation.RetryPolicy{} }
+func (f fakeOperation) IsSuspended() bool                                         { return false }
 
 var _ = Describe("JindoCacheEngine UFS, operation and validate helpers", func() {
 	newEngine := func(name string, objects ...runtime.Object) *JindoCacheEngine {
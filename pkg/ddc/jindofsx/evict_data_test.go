@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindofsx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	. "github.com/agiledragon/gomonkey/v2"
+	. "github.com/smartystreets/goconvey/convey"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdataevict "github.com/fluid-cloudnative/fluid/pkg/dataevict"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestEvictData(t *testing.T) {
+	newDataEvict := func(paths ...string) *datav1alpha1.DataEvict {
+		dataEvict := &datav1alpha1.DataEvict{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-evict", Namespace: "fluid"},
+			Spec: datav1alpha1.DataEvictSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "hbase", Namespace: "fluid"},
+			},
+		}
+		for _, path := range paths {
+			dataEvict.Spec.Target = append(dataEvict.Spec.Target, datav1alpha1.EvictTargetPath{Path: path})
+		}
+		return dataEvict
+	}
+	ctx := cruntime.ReconcileRequestContext{Log: fake.NullLogger()}
+
+	Convey("test evictData ", t, func() {
+		var engine *JindoFSxEngine
+		master := &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-jindofs-master", Namespace: "fluid"},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1},
+		}
+		e := &JindoFSxEngine{name: "hbase", namespace: "fluid", Log: fake.NullLogger(), Client: fake.NewFakeClientWithScheme(testScheme, master)}
+
+		Convey("evict the whole dataset", func() {
+			cleaned := false
+			patch1 := ApplyPrivateMethod(reflect.TypeOf(engine), "queryCacheStatus",
+				func(_ *JindoFSxEngine) (cacheStates, error) {
+					return cacheStates{cached: "2.00KiB"}, nil
+				})
+			defer patch1.Reset()
+			patch2 := ApplyPrivateMethod(reflect.TypeOf(engine), "invokeCleanCache",
+				func(_ *JindoFSxEngine) error {
+					cleaned = true
+					return nil
+				})
+			defer patch2.Reset()
+
+			infos, err := e.evictData(ctx, newDataEvict("/"))
+			So(err, ShouldBeNil)
+			So(cleaned, ShouldBeTrue)
+			So(infos[cdataevict.EvictedBytes], ShouldEqual, "2048")
+		})
+
+		Convey("evict a sub path", func() {
+			infos, err := e.evictData(ctx, newDataEvict("/sub"))
+			So(err, ShouldNotBeNil)
+			So(infos, ShouldBeNil)
+		})
+
+		Convey("the master is not ready", func() {
+			master.Status.ReadyReplicas = 0
+			e.Client = fake.NewFakeClientWithScheme(testScheme, master)
+
+			infos, err := e.evictData(ctx, newDataEvict())
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "not ready")
+			So(infos, ShouldBeNil)
+		})
+
+		Convey("the master is missing", func() {
+			e.Client = fake.NewFakeClientWithScheme(testScheme)
+
+			infos, err := e.evictData(ctx, newDataEvict())
+			So(err, ShouldNotBeNil)
+			So(infos, ShouldBeNil)
+		})
+
+		Convey("failed to clean cache", func() {
+			patch1 := ApplyPrivateMethod(reflect.TypeOf(engine), "queryCacheStatus",
+				func(_ *JindoFSxEngine) (cacheStates, error) {
+					return cacheStates{}, nil
+				})
+			defer patch1.Reset()
+			patch2 := ApplyPrivateMethod(reflect.TypeOf(engine), "invokeCleanCache",
+				func(_ *JindoFSxEngine) error {
+					return errors.New("exec error")
+				})
+			defer patch2.Reset()
+
+			infos, err := e.evictData(ctx, newDataEvict())
+			So(err, ShouldNotBeNil)
+			So(infos, ShouldBeNil)
+		})
+	})
+}
@@ -46,6 +46,11 @@ func (e *JindoFSxEngine) generateDataLoadValueFile(r cruntime.ReconcileRequestCo
 		return "", err
 	}
 
+	if cdataload.HasTargetFilters(dataload) {
+		err = cdataload.NewTargetFiltersNotSupported(common.JindoRuntime)
+		return "", err
+	}
+
 	targetDataset, err := utils.GetDataset(r.Client, dataload.Spec.Dataset.Name, dataload.Spec.Dataset.Namespace)
 	if err != nil {
 		return "", err
@@ -122,6 +127,7 @@ func (e *JindoFSxEngine) genDataLoadValue(image string, runtime *datav1alpha1.Ji
 		ImagePullSecrets: imagePullSecrets,
 		Policy:           string(dataload.Spec.Policy),
 		Schedule:         dataload.Spec.Schedule,
+		CronJobPolicy:    cdataload.GenCronJobPolicy(dataload),
 		Resources:        dataload.Spec.Resources,
 	}
 
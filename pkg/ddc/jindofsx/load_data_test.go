@@ -131,6 +131,27 @@ func TestGenerateDataLoadValueFile(t *testing.T) {
 	}
 }
 
+func TestGenerateDataLoadValueFileWithTargetFilters(t *testing.T) {
+	dataLoad := &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dataload",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.DataLoadSpec{
+			Dataset: datav1alpha1.TargetDataset{
+				Name:      "test-dataset",
+				Namespace: "fluid",
+			},
+			Include: []string{"*.tar"},
+		},
+	}
+
+	engine := JindoFSxEngine{}
+	if _, err := engine.generateDataLoadValueFile(cruntime.ReconcileRequestContext{}, dataLoad); err == nil {
+		t.Errorf("expect error when the DataLoad has file patterns, but got nil")
+	}
+}
+
 func Test_genDataLoadValue(t *testing.T) {
 	testCases := map[string]struct {
 		image         string
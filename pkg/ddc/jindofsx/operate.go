@@ -34,6 +34,9 @@ func (e *JindoFSxEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequest
 	case dataoperation.DataProcessType:
 		valueFileName, err = e.generateDataProcessValueFile(ctx, object)
 		return valueFileName, err
+	case dataoperation.DataMigrateType:
+		valueFileName, err = e.generateDataMigrateValueFile(ctx, object)
+		return valueFileName, err
 	default:
 		return "", errors.NewNotSupported(
 			schema.GroupResource{
@@ -42,3 +45,20 @@ func (e *JindoFSxEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequest
 			}, "JindoRuntime")
 	}
 }
+
+// ExecuteDataOperation executes the data operations like DataEvict in the jindo master pod directly.
+func (e *JindoFSxEngine) ExecuteDataOperation(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface) (infos map[string]string, err error) {
+	operationType := operation.GetOperationType()
+	object := operation.GetOperationObject()
+
+	switch operationType {
+	case dataoperation.DataEvictType:
+		return e.evictData(ctx, object)
+	default:
+		return nil, errors.NewNotSupported(
+			schema.GroupResource{
+				Group:    object.GetObjectKind().GroupVersionKind().Group,
+				Resource: object.GetObjectKind().GroupVersionKind().Kind,
+			}, "JindoRuntime")
+	}
+}
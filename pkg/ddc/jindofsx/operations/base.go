@@ -180,3 +180,49 @@ func (a JindoFileUtils) Ready() (ready bool) {
 
 	return ready
 }
+
+// DecommissionWorker decommissions the worker by running `jindo admin -decommission` command, the master stops
+// serving the cached blocks on the decommissioned worker.
+func (a JindoFileUtils) DecommissionWorker(host string) (err error) {
+	var (
+		command = []string{"jindo", "admin", "-decommission", host}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, true)
+	if err != nil {
+		a.log.Error(err, "JindoFileUtils.DecommissionWorker() failed", "stdout", stdout, "stderr", stderr)
+	}
+	return
+}
+
+// RecommissionWorker recommissions the decommissioned worker by running `jindo admin -recommission` command
+func (a JindoFileUtils) RecommissionWorker(host string) (err error) {
+	var (
+		command = []string{"jindo", "admin", "-recommission", host}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, true)
+	if err != nil {
+		a.log.Error(err, "JindoFileUtils.RecommissionWorker() failed", "stdout", stdout, "stderr", stderr)
+	}
+	return
+}
+
+// ReportNodes reports the details of the nodes registered in the master by running `jindo admin -report -detail` command
+func (a JindoFileUtils) ReportNodes() (report string, err error) {
+	var (
+		command = []string{"jindo", "admin", "-report", "-detail"}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		a.log.Error(err, "JindoFileUtils.ReportNodes() failed", "stdout", stdout, "stderr", stderr)
+	}
+	return stdout, err
+}
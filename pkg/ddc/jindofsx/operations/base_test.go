@@ -131,3 +131,45 @@ func TestJindoFileUtils_Ready(t *testing.T) {
 		t.Errorf("check failure, want true, got %t", ready)
 	}
 }
+
+func TestJindoFileUtils_DecommissionWorker(t *testing.T) {
+	var command []string
+	ExecCommon := func(a JindoFileUtils, cmd []string, verbose bool) (stdout string, stderr string, err error) {
+		command = cmd
+		return "", "", nil
+	}
+	ExecErr := func(a JindoFileUtils, cmd []string, verbose bool) (stdout string, stderr string, err error) {
+		return "", "", errors.New("fail to run the command")
+	}
+
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(JindoFileUtils{}), "exec", ExecErr)
+	defer patches.Reset()
+
+	a := JindoFileUtils{log: fake.NullLogger()}
+	if err := a.DecommissionWorker("192.168.0.1"); err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+	if err := a.RecommissionWorker("192.168.0.1"); err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyPrivateMethod(reflect.TypeOf(JindoFileUtils{}), "exec", ExecCommon)
+	if err := a.DecommissionWorker("192.168.0.1"); err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if !reflect.DeepEqual(command, []string{"jindo", "admin", "-decommission", "192.168.0.1"}) {
+		t.Errorf("check failure, unexpected command %v", command)
+	}
+	if err := a.RecommissionWorker("192.168.0.1"); err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if !reflect.DeepEqual(command, []string{"jindo", "admin", "-recommission", "192.168.0.1"}) {
+		t.Errorf("check failure, unexpected command %v", command)
+	}
+	if _, err := a.ReportNodes(); err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if !reflect.DeepEqual(command, []string{"jindo", "admin", "-report", "-detail"}) {
+		t.Errorf("check failure, unexpected command %v", command)
+	}
+}
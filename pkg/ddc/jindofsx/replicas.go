@@ -43,7 +43,7 @@ func (e JindoFSxEngine) SyncReplicas(ctx cruntime.ReconcileRequestContext) (err
 
 		runtimeToUpdate := runtime.DeepCopy()
 		// err = e.Helper.SetupWorkers(runtimeToUpdate, runtimeToUpdate.Status, workers)
-		err = e.Helper.SyncReplicas(ctx, runtimeToUpdate, runtimeToUpdate.Status, workers)
+		err = e.Helper.SyncReplicasGracefully(ctx, runtimeToUpdate, runtimeToUpdate.Status, workers, runtimeToUpdate.Spec.ScaleInPolicy, &e)
 		if err != nil {
 			e.Log.Error(err, "Failed to sync the replicas")
 		}
@@ -0,0 +1,36 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindofsx
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindofsx/operations"
+	jindoutils "github.com/fluid-cloudnative/fluid/pkg/utils/jindo"
+)
+
+// DrainWorkers decommissions the workers so that the master stops serving the cached blocks on them
+func (e *JindoFSxEngine) DrainWorkers(workers []corev1.Pod) (drained int, err error) {
+	podName, containerName := e.getMasterPodInfo()
+	return jindoutils.DrainWorkers(operations.NewJindoFileUtils(podName, containerName, e.namespace, e.Log), workers)
+}
+
+// RestoreWorkers recommissions the decommissioned workers so that the workers on the same nodes can serve again
+func (e *JindoFSxEngine) RestoreWorkers(workers []corev1.Pod) error {
+	podName, containerName := e.getMasterPodInfo()
+	return jindoutils.RestoreWorkers(operations.NewJindoFileUtils(podName, containerName, e.namespace, e.Log), workers)
+}
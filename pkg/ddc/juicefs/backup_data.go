@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/security"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+// generateDataBackupValueFile builds the value file of the JuiceFS DataBackup helm chart, which dumps
+// the metadata of the JuiceFS volume from the metadata engine into the backup path.
+func (j *JuiceFSEngine) generateDataBackupValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	databackup, ok := object.(*datav1alpha1.DataBackup)
+	if !ok {
+		return "", fmt.Errorf("object %v is not a DataBackup", object)
+	}
+
+	targetDataset, err := utils.GetDataset(j.Client, databackup.Spec.Dataset, databackup.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	// the runtime value contains the edition, image and secrets used to access the metadata engine
+	runtimeValue, err := j.GetValueFromConfigmap()
+	if err != nil {
+		return "", err
+	}
+
+	dataBackupValue, err := j.genDataBackupValue(targetDataset, runtimeValue, databackup)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataBackupValue)
+	if err != nil {
+		return "", err
+	}
+	j.Log.V(1).Info("dataBackup value", "value", string(data))
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-%s-backuper-values.yaml", databackup.Namespace, databackup.Name, common.JuiceFSRuntime))
+	if err != nil {
+		return "", err
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0400)
+	if err != nil {
+		return "", err
+	}
+	return valueFile.Name(), nil
+}
+
+func (j *JuiceFSEngine) genDataBackupValue(targetDataset *datav1alpha1.Dataset, runtimeValue *JuiceFS, databackup *datav1alpha1.DataBackup) (value *DataBackupValue, err error) {
+	backup := DataBackup{
+		Namespace:        databackup.Namespace,
+		Dataset:          databackup.Spec.Dataset,
+		Image:            fmt.Sprintf("%s:%s", runtimeValue.Image, runtimeValue.ImageTag),
+		ImagePullPolicy:  runtimeValue.ImagePullPolicy,
+		ImagePullSecrets: runtimeValue.ImagePullSecrets,
+	}
+
+	backup.PVCName, backup.Path, err = utils.ParseBackupRestorePath(databackup.Spec.BackupPath)
+	if err != nil {
+		return nil, err
+	}
+
+	backup.DumpCmd, err = j.genDumpCmd(runtimeValue, backup.PVCName, backup.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// databackup.Spec.RunAs > the image default user
+	if databackup.Spec.RunAs != nil {
+		backup.RunAsUser = databackup.Spec.RunAs.UID
+		backup.RunAsGroup = databackup.Spec.RunAs.GID
+	}
+
+	// inject the node affinity by previous operation pod.
+	backup.Affinity, err = dataflow.InjectAffinityByRunAfterOp(j.Client, databackup.Spec.RunAfter, databackup.Namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	value = &DataBackupValue{
+		Name:           databackup.Name,
+		OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+		Owner:          transformer.GenerateOwnerReferenceFromObject(databackup),
+		Edition:        runtimeValue.Edition,
+		DataBackup:     backup,
+	}
+
+	// only the secrets and the auth command are needed to access the metadata engine
+	value.Configs = Configs{
+		Name:               runtimeValue.Configs.Name,
+		MetaUrlSecret:      runtimeValue.Configs.MetaUrlSecret,
+		MetaUrlSecretKey:   runtimeValue.Configs.MetaUrlSecretKey,
+		TokenSecret:        runtimeValue.Configs.TokenSecret,
+		TokenSecretKey:     runtimeValue.Configs.TokenSecretKey,
+		AccessKeySecret:    runtimeValue.Configs.AccessKeySecret,
+		AccessKeySecretKey: runtimeValue.Configs.AccessKeySecretKey,
+		SecretKeySecret:    runtimeValue.Configs.SecretKeySecret,
+		SecretKeySecretKey: runtimeValue.Configs.SecretKeySecretKey,
+		EncryptEnvOptions:  runtimeValue.Configs.EncryptEnvOptions,
+	}
+	if runtimeValue.Edition == EnterpriseEdition {
+		value.Configs.FormatCmd = runtimeValue.Configs.FormatCmd
+	}
+
+	return value, nil
+}
+
+// genDumpCmd generates the command to dump the metadata into the backup file,
+// e.g. `/usr/local/bin/juicefs dump ${METAURL} /pvc/backup/metadata-backup-jfsdemo-default.json.gz`
+func (j *JuiceFSEngine) genDumpCmd(runtimeValue *JuiceFS, pvcName, path string) (string, error) {
+	metadataFile := j.getMetadataBackupFile(pvcName, path)
+	switch runtimeValue.Edition {
+	case CommunityEdition:
+		if runtimeValue.Configs.MetaUrlSecret == "" {
+			return "", fmt.Errorf("metaurl secret of JuiceFS %s/%s is not found, cannot dump the metadata", j.namespace, j.name)
+		}
+		return strings.Join([]string{common.JuiceCeCliPath, "dump", "${METAURL}", metadataFile}, " "), nil
+	case EnterpriseEdition:
+		return strings.Join([]string{common.JuiceCliPath, "dump", security.EscapeBashStr(runtimeValue.Configs.Name), metadataFile}, " "), nil
+	default:
+		return "", fmt.Errorf("unknown JuiceFS edition %q of %s/%s", runtimeValue.Edition, j.namespace, j.name)
+	}
+}
+
+// getMetadataBackupFile returns the path of the metadata backup file in the DataBackup pod and the workers,
+// where the backup pvc is mounted at /pvc and the host path is mounted at /host.
+func (j *JuiceFSEngine) getMetadataBackupFile(pvcName, path string) string {
+	if pvcName != "" {
+		return filepath.Join(BackupPVCMountPath, path, j.GetMetadataFileName())
+	}
+	return filepath.Join(BackupHostMountPath, j.GetMetadataFileName())
+}
+
+// GetMetadataFileName returns the name of the metadata backup file, JuiceFS compresses the dumped json
+// with the .gz suffix.
+func (j *JuiceFSEngine) GetMetadataFileName() string {
+	return "metadata-backup-" + j.name + "-" + j.namespace + ".json.gz"
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"os"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestJuiceFSEngine_generateDataBackupValueFile(t *testing.T) {
+	communityValue := `edition: community
+image: juicedata/juicefs-fuse
+imageTag: ce-v1.1.0
+imagePullPolicy: IfNotPresent
+configs:
+  name: jfsdemo
+  metaurlSecret: jfs-secret
+  metaurlSecretKey: metaurl
+  formatCmd: /usr/local/bin/juicefs format --no-update ${METAURL} jfsdemo
+`
+	enterpriseValue := `edition: enterprise
+image: juicedata/juicefs-fuse
+imageTag: ee-4.9.2
+configs:
+  name: jfsdemo
+  tokenSecret: jfs-secret
+  tokenSecretKey: token
+  formatCmd: /usr/bin/juicefs auth --token=${TOKEN} jfsdemo
+`
+	tests := []struct {
+		name        string
+		value       string
+		backupPath  string
+		wantErr     bool
+		wantDumpCmd string
+		wantFormat  string
+		wantPVCName string
+		wantImage   string
+	}{
+		{
+			name:        "community edition backup to pvc",
+			value:       communityValue,
+			backupPath:  "pvc://backup-pvc/jfs/",
+			wantDumpCmd: "/usr/local/bin/juicefs dump ${METAURL} /pvc/jfs/metadata-backup-jfsdemo-fluid.json.gz",
+			wantPVCName: "backup-pvc",
+			wantImage:   "juicedata/juicefs-fuse:ce-v1.1.0",
+		},
+		{
+			name:        "enterprise edition backup to local path",
+			value:       enterpriseValue,
+			backupPath:  "local:///tmp/backup/",
+			wantDumpCmd: "/usr/bin/juicefs dump jfsdemo /host/metadata-backup-jfsdemo-fluid.json.gz",
+			wantFormat:  "/usr/bin/juicefs auth --token=${TOKEN} jfsdemo",
+			wantImage:   "juicedata/juicefs-fuse:ee-4.9.2",
+		},
+		{
+			name:       "community edition without metaurl secret",
+			value:      "edition: community\nconfigs:\n  name: jfsdemo\n",
+			backupPath: "pvc://backup-pvc/jfs/",
+			wantErr:    true,
+		},
+		{
+			name:       "unsupported backup path",
+			value:      communityValue,
+			backupPath: "",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo", Namespace: "fluid"},
+			}
+			valuesConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo-juicefs-values", Namespace: "fluid"},
+				Data:       map[string]string{"data": tt.value},
+			}
+			client := fake.NewFakeClientWithScheme(testScheme, []runtime.Object{dataset, valuesConfigMap}...)
+			e := &JuiceFSEngine{
+				name:        "jfsdemo",
+				namespace:   "fluid",
+				Client:      client,
+				runtimeType: common.JuiceFSRuntime,
+				engineImpl:  common.JuiceFSEngineImpl,
+				Log:         fake.NullLogger(),
+			}
+			dataBackup := &datav1alpha1.DataBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "fluid"},
+				Spec: datav1alpha1.DataBackupSpec{
+					Dataset:    "jfsdemo",
+					BackupPath: tt.backupPath,
+				},
+			}
+
+			valueFileName, err := e.generateDataBackupValueFile(cruntime.ReconcileRequestContext{Log: fake.NullLogger()}, dataBackup)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("generateDataBackupValueFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value DataBackupValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+			if value.DataBackup.DumpCmd != tt.wantDumpCmd {
+				t.Errorf("dumpCmd = %q, want %q", value.DataBackup.DumpCmd, tt.wantDumpCmd)
+			}
+			if value.Configs.FormatCmd != tt.wantFormat {
+				t.Errorf("formatCmd = %q, want %q", value.Configs.FormatCmd, tt.wantFormat)
+			}
+			if value.DataBackup.PVCName != tt.wantPVCName {
+				t.Errorf("pvcName = %q, want %q", value.DataBackup.PVCName, tt.wantPVCName)
+			}
+			if value.DataBackup.Image != tt.wantImage {
+				t.Errorf("image = %q, want %q", value.DataBackup.Image, tt.wantImage)
+			}
+		})
+	}
+}
@@ -65,9 +65,20 @@ const (
 	DefaultDataMigrateTimeout = "30m"
 
 	NativeVolumeMigratePath = "/mnt/fluid-native/"
+
+	// the directories where the backup pvc or host path is mounted in the DataBackup pod and workers
+	BackupPVCMountPath  = "/pvc"
+	BackupHostMountPath = "/host"
+	restoreVolumeName   = "fluid-metadata-restore"
 )
 
 const (
 	JuiceFSWorkerContainerName = "juicefs-worker"
 	JuiceFSFuseContainerName   = "juicefs-fuse"
 )
+
+const (
+	// JuiceFSSuperCommEnv is the unix socket through which the juicefs mount process hands off the fuse session
+	// in smooth upgrade
+	JuiceFSSuperCommEnv = "JFS_SUPER_COMM"
+)
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataevict "github.com/fluid-cloudnative/fluid/pkg/dataevict"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+)
+
+var getJuiceFSCacheDirsSize = operations.JuiceFileUtils.GetCacheDirsSize
+
+// evictData removes the chunk cache dirs in all the running worker pods, and returns the size of the removed
+// cache dirs as the evicted bytes. JuiceFS caches data blocks by chunk ids which can't be mapped back to paths,
+// so only the cache of the whole dataset can be evicted.
+func (j *JuiceFSEngine) evictData(ctx cruntime.ReconcileRequestContext, object client.Object) (infos map[string]string, err error) {
+	dataEvict, ok := object.(*datav1alpha1.DataEvict)
+	if !ok {
+		return nil, fmt.Errorf("object %v is not of type DataEvict", object)
+	}
+
+	if !cdataevict.IsWholeDatasetEvicted(dataEvict) {
+		return nil, fmt.Errorf("JuiceFSRuntime only supports evicting the cache of the whole dataset, target path must be \"/\" or empty")
+	}
+
+	runtime, err := j.getRuntime()
+	if err != nil {
+		return nil, err
+	}
+	cacheDirs := j.getCacheDirs(runtime)
+
+	pods, err := getRunningJuiceFSWorkerPods(j, j.getWorkerName(), j.namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get running worker pods")
+	}
+
+	var evictedBytes int64
+	if len(pods) != 0 {
+		uuid, err := j.getUUID(pods[0], common.JuiceFSWorkerContainer)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the uuid of the JuiceFS volume")
+		}
+
+		cacheDirsToBeDeleted := []string{}
+		for _, cacheDir := range cacheDirs {
+			cacheDirsToBeDeleted = append(cacheDirsToBeDeleted, filepath.Join(cacheDir, uuid, "raw/chunks"))
+		}
+
+		for _, pod := range pods {
+			fileUtils := operations.NewJuiceFileUtils(pod.Name, common.JuiceFSWorkerContainer, j.namespace, ctx.Log)
+
+			size, err := getJuiceFSCacheDirsSize(fileUtils, cacheDirsToBeDeleted)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get the size of cache dirs in worker pod %s", pod.Name)
+			}
+
+			ctx.Log.Info("Remove cache in worker pod", "pod", pod.Name, "cache", cacheDirsToBeDeleted, "size", size)
+			if err = deleteJuiceFSCacheDirs(fileUtils, cacheDirsToBeDeleted); err != nil {
+				return nil, errors.Wrapf(err, "failed to remove cache dirs in worker pod %s", pod.Name)
+			}
+			evictedBytes += size
+		}
+	}
+
+	return map[string]string{
+		cdataevict.EvictedBytes: strconv.FormatInt(evictedBytes, 10),
+	}, nil
+}
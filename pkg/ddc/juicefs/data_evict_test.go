@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataevict "github.com/fluid-cloudnative/fluid/pkg/dataevict"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestJuiceFSEngine_evictData(t *testing.T) {
+	testRuntime := &datav1alpha1.JuiceFSRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.JuiceFSRuntimeSpec{
+			Replicas: 2,
+		},
+	}
+	testConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-juicefs-values", Namespace: "fluid"},
+		Data:       map[string]string{"data": "{\"edition\": \"enterprise\", \"source\": \"test\"}"},
+	}
+	testObjs := []runtime.Object{testRuntime.DeepCopy(), testConfigMap.DeepCopy()}
+	client := fake.NewFakeClientWithScheme(testScheme, testObjs...)
+
+	originalGetRunningJuiceFSWorkerPods := getRunningJuiceFSWorkerPods
+	originalDeleteJuiceFSCacheDirs := deleteJuiceFSCacheDirs
+	originalGetJuiceFSCacheDirsSize := getJuiceFSCacheDirsSize
+	defer func() {
+		getRunningJuiceFSWorkerPods = originalGetRunningJuiceFSWorkerPods
+		deleteJuiceFSCacheDirs = originalDeleteJuiceFSCacheDirs
+		getJuiceFSCacheDirsSize = originalGetJuiceFSCacheDirsSize
+	}()
+
+	twoWorkerPods := func(_ *JuiceFSEngine, _ string, _ string) ([]corev1.Pod, error) {
+		return []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-0", Namespace: "fluid"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-1", Namespace: "fluid"}},
+		}, nil
+	}
+
+	tests := []struct {
+		name              string
+		target            []datav1alpha1.EvictTargetPath
+		getPods           func(*JuiceFSEngine, string, string) ([]corev1.Pod, error)
+		deleteErr         error
+		wantErr           bool
+		wantEvictedBytes  string
+		wantDeletedDirs   []string
+		wantDeletedCalled int
+	}{
+		{
+			name:              "evict the whole dataset",
+			getPods:           twoWorkerPods,
+			wantEvictedBytes:  "2048",
+			wantDeletedDirs:   []string{"/var/jfsCache/raw/chunks"},
+			wantDeletedCalled: 2,
+		},
+		{
+			name:              "evict the root path",
+			target:            []datav1alpha1.EvictTargetPath{{Path: "/"}},
+			getPods:           twoWorkerPods,
+			wantEvictedBytes:  "2048",
+			wantDeletedDirs:   []string{"/var/jfsCache/raw/chunks"},
+			wantDeletedCalled: 2,
+		},
+		{
+			name: "no running worker pods",
+			getPods: func(_ *JuiceFSEngine, _ string, _ string) ([]corev1.Pod, error) {
+				return []corev1.Pod{}, nil
+			},
+			wantEvictedBytes: "0",
+		},
+		{
+			name:    "evict a sub path",
+			target:  []datav1alpha1.EvictTargetPath{{Path: "/sub"}},
+			getPods: twoWorkerPods,
+			wantErr: true,
+		},
+		{
+			name: "failed to get worker pods",
+			getPods: func(_ *JuiceFSEngine, _ string, _ string) ([]corev1.Pod, error) {
+				return nil, errors.New("get pods error")
+			},
+			wantErr: true,
+		},
+		{
+			name:              "failed to delete cache dirs",
+			getPods:           twoWorkerPods,
+			deleteErr:         errors.New("delete dir error"),
+			wantErr:           true,
+			wantDeletedCalled: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleteCalled := 0
+			var deletedDirs []string
+			getRunningJuiceFSWorkerPods = tt.getPods
+			getJuiceFSCacheDirsSize = func(_ operations.JuiceFileUtils, _ []string) (int64, error) {
+				return 1024, nil
+			}
+			deleteJuiceFSCacheDirs = func(_ operations.JuiceFileUtils, dirs []string) error {
+				deleteCalled++
+				deletedDirs = dirs
+				return tt.deleteErr
+			}
+
+			e := &JuiceFSEngine{
+				name:        "test",
+				namespace:   "fluid",
+				Client:      client,
+				runtimeType: common.JuiceFSRuntime,
+				Log:         fake.NullLogger(),
+			}
+			dataEvict := &datav1alpha1.DataEvict{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-evict", Namespace: "fluid"},
+				Spec: datav1alpha1.DataEvictSpec{
+					Dataset: datav1alpha1.TargetDataset{Name: "test", Namespace: "fluid"},
+					Target:  tt.target,
+				},
+			}
+
+			infos, err := e.evictData(cruntime.ReconcileRequestContext{Log: fake.NullLogger()}, dataEvict)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("evictData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if deleteCalled != tt.wantDeletedCalled {
+				t.Errorf("evictData() deleted cache dirs %d times, want %d", deleteCalled, tt.wantDeletedCalled)
+			}
+			if tt.wantErr {
+				return
+			}
+			if infos[cdataevict.EvictedBytes] != tt.wantEvictedBytes {
+				t.Errorf("evictData() evicted bytes = %v, want %v", infos[cdataevict.EvictedBytes], tt.wantEvictedBytes)
+			}
+			if len(tt.wantDeletedDirs) != 0 && (len(deletedDirs) != len(tt.wantDeletedDirs) || deletedDirs[0] != tt.wantDeletedDirs[0]) {
+				t.Errorf("evictData() deleted cache dirs = %v, want %v", deletedDirs, tt.wantDeletedDirs)
+			}
+		})
+	}
+}
@@ -130,6 +130,7 @@ func (j *JuiceFSEngine) genDataLoadValue(image string, cacheinfo map[string]stri
 		ImagePullSecrets: imagePullSecrets,
 		Policy:           string(dataload.Spec.Policy),
 		Schedule:         dataload.Spec.Schedule,
+		CronJobPolicy:    cdataload.GenCronJobPolicy(dataload),
 		Resources:        dataload.Spec.Resources,
 	}
 
@@ -177,6 +178,7 @@ func (j *JuiceFSEngine) genDataLoadValue(image string, cacheinfo map[string]stri
 		})
 	}
 	dataloadInfo.TargetPaths = targetPaths
+	cdataload.SetTargetFilters(&dataloadInfo, dataload)
 
 	options := map[string]string{}
 
@@ -21,13 +21,9 @@ import (
 	"net/url"
 	"os"
 	"path"
-	"strconv"
 	"strings"
 
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
-	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
-	"github.com/pkg/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -103,6 +99,7 @@ func (j *JuiceFSEngine) generateDataMigrateValueFile(r cruntime.ReconcileRequest
 		ImagePullSecrets: imagePullSecrets,
 		Policy:           string(dataMigrate.Spec.Policy),
 		Schedule:         dataMigrate.Spec.Schedule,
+		CronJobPolicy:    cdatamigrate.GenCronJobPolicy(dataMigrate),
 		Resources:        dataMigrate.Spec.Resources,
 		Parallelism:      dataMigrate.Spec.Parallelism,
 	}
@@ -194,66 +191,15 @@ func (j *JuiceFSEngine) generateDataMigrateValueFile(r cruntime.ReconcileRequest
 }
 
 func addWorkerPodPreferredAntiAffinity(dataMigrateInfo *cdatamigrate.DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) {
-	releaseName := utils.GetDataMigrateReleaseName(dataMigrate.Name)
-
-	podAffinityTerm := corev1.WeightedPodAffinityTerm{
-		Weight: 100,
-		PodAffinityTerm: corev1.PodAffinityTerm{
-			LabelSelector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{
-					dataoperation.OperationLabel: fmt.Sprintf("migrate-%s-%s", dataMigrate.Namespace, releaseName),
-				},
-			},
-			TopologyKey: common.K8sNodeNameLabelKey,
-		},
-	}
-
-	// Affinity is nil
-	if dataMigrateInfo.Affinity == nil {
-		dataMigrateInfo.Affinity = &corev1.Affinity{
-			PodAntiAffinity: &corev1.PodAntiAffinity{
-				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
-					podAffinityTerm,
-				},
-			},
-		}
-		return
-	}
-	// Affinity not nil, PodAntiAffinity is nil
-	if dataMigrateInfo.Affinity.PodAntiAffinity == nil {
-		dataMigrateInfo.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
-	}
-
-	dataMigrateInfo.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution =
-		append(dataMigrateInfo.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, podAffinityTerm)
+	cdatamigrate.AddWorkerPodPreferredAntiAffinity(dataMigrateInfo, dataMigrate)
 }
 
 func (j *JuiceFSEngine) setParallelMigrateOptions(dataMigrateInfo *cdatamigrate.DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) error {
-	var err error
-	dataMigrateInfo.ParallelOptions = cdatamigrate.ParallelOptions{
-		SSHPort:                cdatamigrate.DefaultSSHPort,
-		SSHReadyTimeoutSeconds: cdatamigrate.DefaultSSHReadyTimeoutSeconds,
-		SSHSecretName:          dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHSecretName],
-	}
-
-	sshPort, exist := dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHPort]
-	if exist {
-		dataMigrateInfo.ParallelOptions.SSHPort, err = strconv.Atoi(sshPort)
-		if err != nil {
-			j.Log.Error(err, "sshPort in the parallelOptions is not a int")
-			return errors.Wrap(err, "sshPort in the parallelOptions is not a int")
-		}
-	}
-
-	sshReadyTimeoutSeconds, exist := dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHReadyTimeoutSeconds]
-	if exist {
-		dataMigrateInfo.ParallelOptions.SSHReadyTimeoutSeconds, err = strconv.Atoi(sshReadyTimeoutSeconds)
-		if err != nil {
-			j.Log.Error(err, "sshReadyTimeoutSeconds in the parallelOptions is not a int")
-			return errors.Wrap(err, "sshReadyTimeoutSeconds in the parallelOptions is not a int")
-		}
+	err := cdatamigrate.SetParallelMigrateOptions(dataMigrateInfo, dataMigrate)
+	if err != nil {
+		j.Log.Error(err, "invalid parallelOptions")
 	}
-	return nil
+	return err
 }
 
 func (j *JuiceFSEngine) genDataUrl(data datav1alpha1.DataToMigrate, targetDataset *datav1alpha1.Dataset, info *cdatamigrate.DataMigrateInfo) (dataUrl string, err error) {
@@ -29,8 +29,8 @@ import (
 )
 
 func (j JuiceFSEngine) CheckMasterReady() (ready bool, err error) {
-	// JuiceFS Runtime has no master role
-	return true, nil
+	// JuiceFS Runtime has no master role, but the workers wait for the metadata to be restored
+	return j.restoreMetadata()
 }
 
 // ShouldSetupMaster checks if a further call of func `SetupMaster` is needed.
@@ -62,6 +62,11 @@ func (j JuiceFSEngine) SetupMaster() (err error) {
 	if err != nil && apierrs.IsNotFound(err) {
 		//1. Is not found error
 		j.Log.V(1).Info("SetupMaster", "worker", workerName)
+		// restore the metadata from backup once before the workers start if the dataset indicates a restore path
+		restored, err := j.restoreMetadata()
+		if err != nil || !restored {
+			return err
+		}
 		return j.installJuiceFS()
 	} else if err != nil {
 		//2. Other errors
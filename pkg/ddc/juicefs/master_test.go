@@ -166,19 +166,23 @@ func TestJuiceFSEngine_CheckMasterReady(t *testing.T) {
 	}{
 		{
 			name:      "test",
-			fields:    fields{},
+			fields:    fields{name: "test", namespace: "fluid"},
 			wantReady: true,
 			wantErr:   false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			dataset := &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: tt.fields.name, Namespace: tt.fields.namespace},
+			}
 			j := JuiceFSEngine{
 				runtime:     tt.fields.runtime,
 				name:        tt.fields.name,
 				namespace:   tt.fields.namespace,
 				runtimeType: tt.fields.runtimeType,
 				runtimeInfo: tt.fields.runtimeInfo,
+				Client:      fake.NewFakeClientWithScheme(testScheme, dataset),
 			}
 			gotReady, err := j.CheckMasterReady()
 			if (err != nil) != tt.wantErr {
@@ -37,6 +37,9 @@ func (j *JuiceFSEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestC
 	case dataoperation.DataProcessType:
 		valueFileName, err = j.generateDataProcessValueFile(ctx, object)
 		return valueFileName, err
+	case dataoperation.DataBackupType:
+		valueFileName, err = j.generateDataBackupValueFile(ctx, object)
+		return valueFileName, err
 	default:
 		return "", errors.NewNotSupported(
 			schema.GroupResource{
@@ -45,3 +48,20 @@ func (j *JuiceFSEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestC
 			}, "JuiceFSRuntime")
 	}
 }
+
+// ExecuteDataOperation executes the data operations like DataEvict in the JuiceFS pods directly.
+func (j *JuiceFSEngine) ExecuteDataOperation(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface) (infos map[string]string, err error) {
+	operationType := operation.GetOperationType()
+	object := operation.GetOperationObject()
+
+	switch operationType {
+	case dataoperation.DataEvictType:
+		return j.evictData(ctx, object)
+	default:
+		return nil, errors.NewNotSupported(
+			schema.GroupResource{
+				Group:    object.GetObjectKind().GroupVersionKind().Group,
+				Resource: object.GetObjectKind().GroupVersionKind().Kind,
+			}, "JuiceFSRuntime")
+	}
+}
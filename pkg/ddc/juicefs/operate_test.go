@@ -101,6 +101,14 @@ func (m *mockOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
 
+func (m *mockOperation) GetRetryPolicy() dataoperation.RetryPolicy {
+	return dataoperation.RetryPolicy{}
+}
+
+func (m *mockOperation) IsSuspended() bool {
+	return false
+}
+
 var _ = Describe("GetDataOperationValueFile", func() {
 	var (
 		engine  *JuiceFSEngine
@@ -126,16 +134,16 @@ var _ = Describe("GetDataOperationValueFile", func() {
 	})
 
 	Context("when operation type is unsupported", func() {
-		It("should return NotSupported error for DataBackup type", func() {
+		It("should return NotSupported error for DataEvict type", func() {
 			operation := &mockOperation{
-				operationType: dataoperation.DataBackupType,
-				object: &datav1alpha1.DataBackup{
+				operationType: dataoperation.DataEvictType,
+				object: &datav1alpha1.DataEvict{
 					TypeMeta: metav1.TypeMeta{
-						Kind:       "DataBackup",
+						Kind:       "DataEvict",
 						APIVersion: "data.fluid.io/v1alpha1",
 					},
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      "test-backup",
+						Name:      "test-evict",
 						Namespace: "default",
 					},
 				},
@@ -260,4 +268,34 @@ var _ = Describe("GetDataOperationValueFile", func() {
 			Expect(valueFileName).To(Equal("/tmp/test-process-values.yaml"))
 		})
 	})
+
+	Context("when operation type is DataBackup", func() {
+		It("should call generateDataBackupValueFile and return its result", func() {
+			dataBackup := &datav1alpha1.DataBackup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "DataBackup",
+					APIVersion: "data.fluid.io/v1alpha1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-backup",
+					Namespace: "default",
+				},
+			}
+
+			operation := &mockOperation{
+				operationType: dataoperation.DataBackupType,
+				object:        dataBackup,
+			}
+
+			patches = gomonkey.ApplyPrivateMethod(reflect.TypeOf(engine), "generateDataBackupValueFile",
+				func(_ *JuiceFSEngine, _ cruntime.ReconcileRequestContext, _ client.Object) (string, error) {
+					return "/tmp/test-backup-values.yaml", nil
+				})
+
+			valueFileName, err := engine.GetDataOperationValueFile(ctx, operation)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(valueFileName).To(Equal("/tmp/test-backup-values.yaml"))
+		})
+	})
 })
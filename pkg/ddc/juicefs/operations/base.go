@@ -149,6 +149,32 @@ func (j JuiceFileUtils) DeleteCacheDirs(dirs []string) (err error) {
 	return
 }
 
+// GetCacheDirsSize get the total size in bytes of the cache dirs in pod
+// equal to `du -sbc dirs...`, the dirs not existing yet (e.g. nothing cached) are counted as 0
+func (j JuiceFileUtils) GetCacheDirsSize(dirs []string) (size int64, err error) {
+	var (
+		command = append([]string{"du", "-sbc"}, dirs...)
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = j.exec(command, false)
+
+	// du still reports the total when some of the dirs are not found, so parse the last line anyway
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	// [<Size> total]
+	data := strings.Fields(lines[len(lines)-1])
+	if len(data) != 2 || data[1] != "total" {
+		if err == nil {
+			err = fmt.Errorf("failed to parse %s in GetCacheDirsSize method", data)
+		}
+		j.log.Error(err, "JuiceFileUtils.GetCacheDirsSize() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+
+	return strconv.ParseInt(data[0], 10, 64)
+}
+
 // GetStatus get status of volume
 func (j JuiceFileUtils) GetStatus(source string) (status string, err error) {
 	var (
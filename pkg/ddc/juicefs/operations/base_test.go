@@ -171,6 +171,46 @@ func TestJuiceFileUtils_DeleteCacheDirs(t *testing.T) {
 	}
 }
 
+func TestJuiceFileUtils_GetCacheDirsSize(t *testing.T) {
+	ExecCommon := func(a JuiceFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "1024\t/tmp/a/raw/chunks\n2048\t/tmp/b/raw/chunks\n3072\ttotal\n", "", nil
+	}
+	ExecNotFound := func(a JuiceFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "1024\t/tmp/a/raw/chunks\n1024\ttotal\n", "du: cannot access '/tmp/b/raw/chunks': No such file or directory", errors.New("exit code 1")
+	}
+	ExecErr := func(a JuiceFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "", "", errors.New("fail to run the command")
+	}
+
+	a := JuiceFileUtils{}
+	dirs := []string{"/tmp/a/raw/chunks", "/tmp/b/raw/chunks"}
+
+	patches := gomonkey.ApplyPrivateMethod(JuiceFileUtils{}, "exec", ExecCommon)
+	defer patches.Reset()
+	size, err := a.GetCacheDirsSize(dirs)
+	if err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if size != 3072 {
+		t.Errorf("check failure, want 3072, got %d", size)
+	}
+
+	patches.ApplyPrivateMethod(JuiceFileUtils{}, "exec", ExecNotFound)
+	size, err = a.GetCacheDirsSize(dirs)
+	if err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if size != 1024 {
+		t.Errorf("check failure, want 1024, got %d", size)
+	}
+
+	patches.ApplyPrivateMethod(JuiceFileUtils{}, "exec", ExecErr)
+	_, err = a.GetCacheDirsSize(dirs)
+	if err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+}
+
 func TestJuiceFileUtils_DeleteCacheDir(t *testing.T) {
 	ExecCommon := func(a JuiceFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
 		return "juicefs rmr success", "", nil
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/security"
+)
+
+// restoreMetadata loads the metadata backup dumped by DataBackup into the metadata engine with a single job before
+// the workers are installed. It returns restored=true if there's nothing to restore or the job completes, and
+// fails if the job fails, so that the runtime won't set up on the incomplete metadata.
+func (j *JuiceFSEngine) restoreMetadata() (restored bool, err error) {
+	dataset, err := utils.GetDataset(j.Client, j.name, j.namespace)
+	if err != nil {
+		return
+	}
+	if dataset.Spec.DataRestoreLocation == nil || dataset.Spec.DataRestoreLocation.Path == "" {
+		return true, nil
+	}
+
+	job, err := kubeclient.GetJob(j.Client, j.getRestoreJobName(), j.namespace)
+	if apierrs.IsNotFound(err) {
+		runtime, err := j.getRuntime()
+		if err != nil {
+			return false, err
+		}
+		job, err = j.genRestoreJob(runtime, dataset)
+		if err != nil {
+			return false, err
+		}
+		j.Log.Info("Create the job to restore the metadata", "job", job.Name, "path", dataset.Spec.DataRestoreLocation.Path)
+		if err = j.Client.Create(context.TODO(), job); err != nil && !apierrs.IsAlreadyExists(err) {
+			return false, err
+		}
+		return false, nil
+	} else if err != nil {
+		return
+	}
+
+	condition := kubeclient.GetFinishedJobCondition(job)
+	switch {
+	case condition == nil:
+		j.Log.V(1).Info("The metadata is being restored", "job", job.Name)
+		return false, nil
+	case condition.Type == batchv1.JobFailed:
+		return false, fmt.Errorf("failed to restore the metadata from %s with job %s/%s: %s",
+			dataset.Spec.DataRestoreLocation.Path, job.Namespace, job.Name, condition.Message)
+	default:
+		return true, nil
+	}
+}
+
+// genRestoreJob builds the job which loads the metadata backup in pvc. The backup is loaded only if the volume
+// is not formatted, so that the retried job won't override the metadata.
+func (j *JuiceFSEngine) genRestoreJob(runtime *datav1alpha1.JuiceFSRuntime, dataset *datav1alpha1.Dataset) (*batchv1.Job, error) {
+	if len(dataset.Spec.Mounts) == 0 {
+		return nil, fmt.Errorf("the dataset %s/%s has no mount", j.namespace, j.name)
+	}
+	value := &JuiceFS{}
+	j.genEdition(dataset.Spec.Mounts[0], value, dataset.Spec.SharedEncryptOptions)
+
+	pvcName, path, err := parseRestoreLocation(dataset, value.Edition)
+	if err != nil {
+		return nil, err
+	}
+	metaUrl := getMetaUrlSecretKeyRef(dataset)
+	if metaUrl == nil {
+		return nil, fmt.Errorf("metaurl secret of JuiceFS %s/%s is not found, cannot restore the metadata", j.namespace, j.name)
+	}
+
+	image, imageTag, imagePullPolicy, err := j.parseJuiceFSImage(value.Edition, runtime.Spec.JuiceFSVersion.Image,
+		runtime.Spec.JuiceFSVersion.ImageTag, runtime.Spec.JuiceFSVersion.ImagePullPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	cli := common.JuiceCeCliPath
+	restoreCmd := fmt.Sprintf("%s status ${METAURL} > /dev/null 2>&1 || %s load ${METAURL} %s",
+		cli, cli, security.EscapeBashStr(j.getMetadataBackupFile(pvcName, path)))
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      j.getRestoreJobName(),
+			Namespace: j.namespace,
+			Labels: map[string]string{
+				common.LabelAnnotationDatasetId: utils.GetDatasetId(j.namespace, j.name, string(dataset.UID)),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(runtime, datav1alpha1.GroupVersion.WithKind(datav1alpha1.JuiceFSRuntimeKind)),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](3),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"sidecar.istio.io/inject": "false"},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:    corev1.RestartPolicyNever,
+					ImagePullSecrets: docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey),
+					NodeSelector:     runtime.Spec.Worker.NodeSelector,
+					Tolerations:      dataset.Spec.Tolerations,
+					Containers: []corev1.Container{{
+						Name:            "juicefs-restore",
+						Image:           fmt.Sprintf("%s:%s", image, imageTag),
+						ImagePullPolicy: corev1.PullPolicy(imagePullPolicy),
+						Command:         []string{"sh", "-c", restoreCmd},
+						Env: []corev1.EnvVar{{
+							Name:      "METAURL",
+							ValueFrom: &corev1.EnvVarSource{SecretKeyRef: metaUrl},
+						}},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      restoreVolumeName,
+							MountPath: BackupPVCMountPath,
+							ReadOnly:  true,
+						}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: restoreVolumeName,
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: pvcName,
+								ReadOnly:  true,
+							},
+						},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// parseRestoreLocation returns the pvc and the subpath of the metadata backup to restore. Only the community edition
+// supports restoring the metadata from the backup in pvc, the runtime fails to set up with the restore location
+// unsupported or malformed.
+func parseRestoreLocation(dataset *datav1alpha1.Dataset, edition string) (pvcName, path string, err error) {
+	if edition != CommunityEdition {
+		return "", "", fmt.Errorf("restoring metadata is not supported by JuiceFS %s edition", edition)
+	}
+
+	pvcName, path, err = utils.ParseBackupRestorePath(dataset.Spec.DataRestoreLocation.Path)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse the restore path %s: %v", dataset.Spec.DataRestoreLocation.Path, err)
+	}
+	if pvcName == "" {
+		// the job may run on any node, so the backup on local path of a node cannot be found by it
+		return "", "", fmt.Errorf("only restore path in the form of pvc://<pvcName>/subpath is supported by JuiceFSRuntime, but got %s",
+			dataset.Spec.DataRestoreLocation.Path)
+	}
+	return
+}
+
+// getMetaUrlSecretKeyRef returns the secret of the metaurl, the one in the mount overrides the shared one.
+func getMetaUrlSecretKeyRef(dataset *datav1alpha1.Dataset) (secretKeyRef *corev1.SecretKeySelector) {
+	encryptOptions := append([]datav1alpha1.EncryptOption{}, dataset.Spec.SharedEncryptOptions...)
+	encryptOptions = append(encryptOptions, dataset.Spec.Mounts[0].EncryptOptions...)
+	for _, encryptOption := range encryptOptions {
+		if encryptOption.Name == JuiceMetaUrl {
+			secretKeyRef = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: encryptOption.ValueFrom.SecretKeyRef.Name},
+				Key:                  encryptOption.ValueFrom.SecretKeyRef.Key,
+			}
+		}
+	}
+	return
+}
+
+func (j *JuiceFSEngine) getRestoreJobName() string {
+	return fmt.Sprintf("%s-%s-restore", j.name, j.engineImpl)
+}
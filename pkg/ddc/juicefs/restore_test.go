@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+func TestParseRestoreLocation(t *testing.T) {
+	tests := []struct {
+		name        string
+		edition     string
+		restorePath string
+		wantPVCName string
+		wantPath    string
+		wantErr     bool
+	}{
+		{
+			name:        "restore from pvc",
+			edition:     CommunityEdition,
+			restorePath: "pvc://backup-pvc/jfs/",
+			wantPVCName: "backup-pvc",
+			wantPath:    "/jfs/",
+		},
+		{
+			name:        "restore from local path is not supported",
+			edition:     CommunityEdition,
+			restorePath: "local:///tmp/backup/",
+			wantErr:     true,
+		},
+		{
+			name:        "malformed restore path",
+			edition:     CommunityEdition,
+			restorePath: "s3://backup/",
+			wantErr:     true,
+		},
+		{
+			name:        "restore in enterprise edition is not supported",
+			edition:     EnterpriseEdition,
+			restorePath: "pvc://backup-pvc/jfs/",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := &datav1alpha1.Dataset{}
+			dataset.Spec.DataRestoreLocation = &datav1alpha1.DataRestoreLocation{Path: tt.restorePath}
+
+			pvcName, path, err := parseRestoreLocation(dataset, tt.edition)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRestoreLocation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if pvcName != tt.wantPVCName || path != tt.wantPath {
+				t.Errorf("parseRestoreLocation() = (%q, %q), want (%q, %q)", pvcName, path, tt.wantPVCName, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestJuiceFSEngine_restoreMetadata(t *testing.T) {
+	newDataset := func(restorePath string) *datav1alpha1.Dataset {
+		dataset := &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo", Namespace: "fluid"},
+			Spec: datav1alpha1.DatasetSpec{
+				Mounts: []datav1alpha1.Mount{{
+					MountPoint: "juicefs:///",
+					EncryptOptions: []datav1alpha1.EncryptOption{{
+						Name: JuiceMetaUrl,
+						ValueFrom: datav1alpha1.EncryptOptionSource{
+							SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "jfs-secret", Key: "metaurl"},
+						},
+					}},
+				}},
+			},
+		}
+		if restorePath != "" {
+			dataset.Spec.DataRestoreLocation = &datav1alpha1.DataRestoreLocation{Path: restorePath}
+		}
+		return dataset
+	}
+	newJob := func(conditionType batchv1.JobConditionType) *batchv1.Job {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo-juicefs-restore", Namespace: "fluid"}}
+		if conditionType != "" {
+			job.Status.Conditions = []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+		}
+		return job
+	}
+
+	tests := []struct {
+		name         string
+		dataset      *datav1alpha1.Dataset
+		job          *batchv1.Job
+		wantRestored bool
+		wantErr      bool
+		wantJob      bool
+	}{
+		{
+			name:         "no restore location",
+			dataset:      newDataset(""),
+			wantRestored: true,
+		},
+		{
+			name:    "create the restore job",
+			dataset: newDataset("pvc://backup-pvc/jfs/"),
+			wantJob: true,
+		},
+		{
+			name:    "restore location unsupported",
+			dataset: newDataset("local:///tmp/backup/"),
+			wantErr: true,
+		},
+		{
+			name:    "restore job is running",
+			dataset: newDataset("pvc://backup-pvc/jfs/"),
+			job:     newJob(""),
+			wantJob: true,
+		},
+		{
+			name:    "restore job failed",
+			dataset: newDataset("pvc://backup-pvc/jfs/"),
+			job:     newJob(batchv1.JobFailed),
+			wantErr: true,
+			wantJob: true,
+		},
+		{
+			name:         "restore job completed",
+			dataset:      newDataset("pvc://backup-pvc/jfs/"),
+			job:          newJob(batchv1.JobComplete),
+			wantRestored: true,
+			wantJob:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			_ = datav1alpha1.AddToScheme(s)
+			_ = batchv1.AddToScheme(s)
+			_ = corev1.AddToScheme(s)
+			objs := []runtime.Object{
+				tt.dataset,
+				&datav1alpha1.JuiceFSRuntime{ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo", Namespace: "fluid"}},
+			}
+			if tt.job != nil {
+				objs = append(objs, tt.job)
+			}
+			client := fake.NewFakeClientWithScheme(s, objs...)
+			e := &JuiceFSEngine{name: "jfsdemo", namespace: "fluid", engineImpl: "juicefs", Client: client, Log: fake.NullLogger()}
+
+			restored, err := e.restoreMetadata()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("restoreMetadata() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if restored != tt.wantRestored {
+				t.Errorf("restoreMetadata() restored = %v, want %v", restored, tt.wantRestored)
+			}
+
+			job, err := kubeclient.GetJob(client, "jfsdemo-juicefs-restore", "fluid")
+			if (err == nil) != tt.wantJob {
+				t.Fatalf("restore job exists = %v, want %v", err == nil, tt.wantJob)
+			}
+			if tt.wantJob && tt.job == nil {
+				container := job.Spec.Template.Spec.Containers[0]
+				wantCmd := "/usr/local/bin/juicefs status ${METAURL} > /dev/null 2>&1 || /usr/local/bin/juicefs load ${METAURL} /pvc/jfs/metadata-backup-jfsdemo-fluid.json.gz"
+				if container.Command[2] != wantCmd {
+					t.Errorf("restore command = %q, want %q", container.Command[2], wantCmd)
+				}
+				if container.Env[0].ValueFrom.SecretKeyRef.Name != "jfs-secret" {
+					t.Errorf("unexpected metaurl env %v", container.Env)
+				}
+				if job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName != "backup-pvc" {
+					t.Errorf("unexpected restore volumes %v", job.Spec.Template.Spec.Volumes)
+				}
+			}
+		})
+	}
+}
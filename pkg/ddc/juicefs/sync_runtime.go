@@ -295,13 +295,15 @@ func (j *JuiceFSEngine) syncFuseSpec(ctx cruntime.ReconcileRequestContext, runti
 		return false, err
 	}
 
-	if fuses.Spec.UpdateStrategy.Type != appsv1.OnDeleteDaemonSetStrategyType {
+	// the fuse pods are only restarted by the daemonset in HotUpgrade, in which the fuse session is handed off
+	updateStrategy := utils.GetFuseUpdateStrategy(runtime.Spec.UpgradeStrategy)
+	if !reflect.DeepEqual(fuses.Spec.UpdateStrategy, updateStrategy) {
 		j.Log.V(1).Info("Fuse Daemonset's update strategy is not safe to sync fuse spec", "updateStrategy", fuses.Spec.UpdateStrategy.Type)
-		err = kubeclient.UpdateDaemonSetUpdateStrategy(j.Client, fuses.Name, fuses.Namespace, appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType})
+		err = kubeclient.UpdateDaemonSetUpdateStrategy(j.Client, fuses.Name, fuses.Namespace, updateStrategy)
 		if err != nil {
 			return false, err
 		}
-		j.Log.Info("syncFuseSpec: successfully updated fuse daemonset's update strategy to OnDelete", "fuse ds", types.NamespacedName{Namespace: fuses.Namespace, Name: fuses.Name})
+		j.Log.Info("syncFuseSpec: successfully updated fuse daemonset's update strategy", "fuse ds", types.NamespacedName{Namespace: fuses.Namespace, Name: fuses.Name}, "updateStrategy", updateStrategy.Type)
 		// daemonset update event would trigger a new reconciliation, so it's safe to return here
 		return false, nil
 	}
@@ -320,7 +322,8 @@ func (j *JuiceFSEngine) syncFuseSpec(ctx cruntime.ReconcileRequestContext, runti
 		return fuseChanged, nil
 	}
 
-	if fuseGenerationNeedIncrease {
+	// the mount points are kept in HotUpgrade, so the application pods don't need to be recreated
+	if fuseGenerationNeedIncrease && !utils.IsFuseHotUpgrade(runtime.Spec.UpgradeStrategy) {
 		err := j.increaseFuseGeneration(fusesToUpdate)
 		if err != nil {
 			j.Log.Error(err, "syncFuseSpec: failed to update the fuse generation on fuse daemonset", "fuse ds", types.NamespacedName{Namespace: fusesToUpdate.Namespace, Name: fusesToUpdate.Name})
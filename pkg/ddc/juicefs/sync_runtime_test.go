@@ -34,6 +34,7 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 )
@@ -340,6 +341,48 @@ var _ = Describe("JuiceFS Sync Runtime Related Tests", Label("pkg.ddc.juicefs.sy
 
 	})
 
+	When("the fuse is hot upgraded", func() {
+		BeforeEach(func() {
+			juicefsruntime.Spec.UpgradeStrategy = datav1alpha1.FuseUpgradeStrategy{Type: datav1alpha1.HotUpgradeFuseUpgradeStrategyType}
+		})
+
+		It("should switch the fuse ds to rolling update first", func() {
+			oldValue := mockJuiceFSValue(dataset, juicefsruntime)
+			latestValue := mockJuiceFSValue(dataset, juicefsruntime)
+			changed, err := engine.syncFuseSpec(cruntime.ReconcileRequestContext{}, juicefsruntime, oldValue, latestValue)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+
+			updatedDs, err := kubeclient.GetDaemonset(client, mockedObjects.FuseDs.Name, mockedObjects.FuseDs.Namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedDs.Spec.UpdateStrategy).To(Equal(utils.GetFuseUpdateStrategy(juicefsruntime.Spec.UpgradeStrategy)))
+		})
+
+		When("the fuse ds is rolling updated", func() {
+			BeforeEach(func() {
+				mockedObjects.FuseDs.Spec.UpdateStrategy = utils.GetFuseUpdateStrategy(juicefsruntime.Spec.UpgradeStrategy)
+			})
+
+			It("should update the image without increasing the fuse generation", func() {
+				juicefsruntime.Spec.Fuse.Image = "juicefs/juicefs-fuse"
+				juicefsruntime.Spec.Fuse.ImageTag = "new-tag"
+
+				oldValue := mockJuiceFSValue(dataset, juicefsruntime)
+				latestValue := mockJuiceFSValue(dataset, juicefsruntime)
+				latestValue.Fuse.Image = juicefsruntime.Spec.Fuse.Image
+				latestValue.Fuse.ImageTag = juicefsruntime.Spec.Fuse.ImageTag
+				changed, err := engine.syncFuseSpec(cruntime.ReconcileRequestContext{}, juicefsruntime, oldValue, latestValue)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(changed).To(BeTrue())
+
+				updatedDs, err := kubeclient.GetDaemonset(client, mockedObjects.FuseDs.Name, mockedObjects.FuseDs.Namespace)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedDs.Spec.Template.Spec.Containers[0].Image).To(Equal(juicefsruntime.Spec.Fuse.Image + ":" + juicefsruntime.Spec.Fuse.ImageTag))
+				Expect(updatedDs.Spec.Template.ObjectMeta.Labels).NotTo(HaveKey(common.LabelRuntimeFuseGeneration))
+			})
+		})
+	})
+
 	When("only command changes", func() {
 		It("should sync runtime properly and fuse ds's spec and fuse command will be updated", func() {
 			oldValue := mockJuiceFSValue(dataset, juicefsruntime)
@@ -18,6 +18,7 @@ package juicefs
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -53,7 +54,8 @@ func (j *JuiceFSEngine) transformFuse(runtime *datav1alpha1.JuiceFSRuntime, data
 	}
 
 	// transform envs
-	value.Fuse.Envs = runtime.Spec.Fuse.Env
+	// the envs are cloned because more envs may be appended
+	value.Fuse.Envs = slices.Clone(runtime.Spec.Fuse.Env)
 
 	// transform options
 	var tiredStoreLevel *datav1alpha1.Level
@@ -115,6 +117,9 @@ func (j *JuiceFSEngine) transformFuse(runtime *datav1alpha1.JuiceFSRuntime, data
 	// transform downwardAPIVolume for use
 	j.transformFuseDownwardAPIVolumes(runtime, value)
 
+	// transform the way to upgrade fuse
+	j.transformFuseUpgradeStrategy(runtime, value)
+
 	// set critical fuse pod to avoid eviction
 	value.Fuse.CriticalPod = common.CriticalFusePodEnabled()
 
@@ -124,6 +129,24 @@ func (j *JuiceFSEngine) transformFuse(runtime *datav1alpha1.JuiceFSRuntime, data
 	return
 }
 
+// transformFuseUpgradeStrategy sets the update strategy of the fuse daemonset. In HotUpgrade, the fuse pods on a node
+// share the socket through which the running juicefs mount process hands off the fuse session to the new one.
+func (j *JuiceFSEngine) transformFuseUpgradeStrategy(runtime *datav1alpha1.JuiceFSRuntime, value *JuiceFS) {
+	value.Fuse.UpdateStrategy = utils.GetFuseUpdateStrategy(runtime.Spec.UpgradeStrategy)
+	if !utils.IsFuseHotUpgrade(runtime.Spec.UpgradeStrategy) {
+		return
+	}
+
+	value.Fuse.HotUpgrade = true
+	volume, volumeMount, env := utils.GetFuseHandOffVolumes(runtime.Namespace, runtime.Name, common.JuiceFSRuntime)
+	value.Fuse.Volumes = append(value.Fuse.Volumes, volume)
+	value.Fuse.VolumeMounts = append(value.Fuse.VolumeMounts, volumeMount)
+	value.Fuse.Envs = append(value.Fuse.Envs, env, corev1.EnvVar{
+		Name:  JuiceFSSuperCommEnv,
+		Value: env.Value,
+	})
+}
+
 func (j *JuiceFSEngine) transformFuseNodeSelector(runtime *datav1alpha1.JuiceFSRuntime, value *JuiceFS) {
 	value.Fuse.NodeSelector = map[string]string{}
 	if len(runtime.Spec.Fuse.NodeSelector) > 0 {
@@ -22,6 +22,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,6 +32,7 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 )
 
@@ -1014,3 +1016,40 @@ func createEncryptOption(name, secretName, secretKey string) datav1alpha1.Encryp
 		},
 	}
 }
+
+var _ = Describe("TransformFuseUpgradeStrategy", func() {
+	var (
+		engine     *JuiceFSEngine
+		jfsRuntime *datav1alpha1.JuiceFSRuntime
+		value      *JuiceFS
+	)
+
+	BeforeEach(func() {
+		engine = &JuiceFSEngine{Log: fake.NullLogger()}
+		jfsRuntime = &datav1alpha1.JuiceFSRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo", Namespace: mockNamespace},
+		}
+		value = &JuiceFS{}
+	})
+
+	It("should recreate the fuse pods on delete by default", func() {
+		engine.transformFuseUpgradeStrategy(jfsRuntime, value)
+		Expect(value.Fuse.UpdateStrategy.Type).To(Equal(appsv1.OnDeleteDaemonSetStrategyType))
+		Expect(value.Fuse.HotUpgrade).To(BeFalse())
+		Expect(value.Fuse.Volumes).To(BeEmpty())
+		Expect(value.Fuse.Envs).To(BeEmpty())
+	})
+
+	It("should share the hand-off socket with the new fuse pod in HotUpgrade", func() {
+		jfsRuntime.Spec.UpgradeStrategy.Type = datav1alpha1.HotUpgradeFuseUpgradeStrategyType
+		engine.transformFuseUpgradeStrategy(jfsRuntime, value)
+
+		Expect(value.Fuse.UpdateStrategy.Type).To(Equal(appsv1.RollingUpdateDaemonSetStrategyType))
+		Expect(value.Fuse.HotUpgrade).To(BeTrue())
+
+		volume, volumeMount, env := utils.GetFuseHandOffVolumes(jfsRuntime.Namespace, jfsRuntime.Name, common.JuiceFSRuntime)
+		Expect(value.Fuse.Volumes).To(ContainElement(volume))
+		Expect(value.Fuse.VolumeMounts).To(ContainElement(volumeMount))
+		Expect(value.Fuse.Envs).To(ContainElements(env, corev1.EnvVar{Name: JuiceFSSuperCommEnv, Value: env.Value}))
+	})
+})
@@ -17,6 +17,7 @@ limitations under the License.
 package juicefs
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
@@ -63,6 +64,31 @@ type Configs struct {
 	EncryptEnvOptions  []EncryptEnvOption `json:"encryptEnvOptions,omitempty"`
 }
 
+// DataBackupValue is the value yaml file used in the JuiceFS DataBackup helm chart
+type DataBackupValue struct {
+	Name           string                 `json:"name"`
+	OwnerDatasetId string                 `json:"ownerDatasetId"`
+	Owner          *common.OwnerReference `json:"owner,omitempty"`
+	Edition        string                 `json:"edition,omitempty"`
+	Configs        Configs                `json:"configs,omitempty"`
+	DataBackup     DataBackup             `json:"dataBackup"`
+}
+
+// DataBackup describes the pod which dumps the metadata of JuiceFS into the backup path.
+type DataBackup struct {
+	Namespace        string                        `json:"namespace,omitempty"`
+	Dataset          string                        `json:"dataset,omitempty"`
+	Image            string                        `json:"image,omitempty"`
+	ImagePullPolicy  string                        `json:"imagePullPolicy,omitempty"`
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	PVCName          string                        `json:"pvcName,omitempty"`
+	Path             string                        `json:"path,omitempty"`
+	DumpCmd          string                        `json:"dumpCmd,omitempty"`
+	RunAsUser        *int64                        `json:"runAsUser,omitempty"`
+	RunAsGroup       *int64                        `json:"runAsGroup,omitempty"`
+	Affinity         *corev1.Affinity              `json:"affinity,omitempty"`
+}
+
 type EncryptEnvOption struct {
 	Name             string `json:"name"`    //  name
 	EnvName          string `json:"envName"` //  envName
@@ -114,6 +140,9 @@ type Fuse struct {
 	StatCmd       string            `json:"statCmd,omitempty"`
 	Labels        map[string]string `json:"labels,omitempty"`
 	Annotations   map[string]string `json:"annotations,omitempty"`
+
+	UpdateStrategy appsv1.DaemonSetUpdateStrategy `json:"updateStrategy,omitempty"`
+	HotUpgrade     bool                           `json:"hotUpgrade,omitempty"`
 }
 
 type cache struct {
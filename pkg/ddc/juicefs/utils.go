@@ -82,6 +82,12 @@ func (j *JuiceFSEngine) getWorkerName() (dsName string) {
 	return j.name + "-worker"
 }
 
+// GetHibernationStatefulSetNames returns the worker statefulset to scale to zero when hibernating, JuiceFSRuntime
+// has no master.
+func (j *JuiceFSEngine) GetHibernationStatefulSetNames() (worker string, master string) {
+	return j.getWorkerName(), ""
+}
+
 func (j *JuiceFSEngine) getDaemonset(name string, namespace string) (fuse *appsv1.DaemonSet, err error) {
 	fuse = &appsv1.DaemonSet{}
 	err = j.Client.Get(context.TODO(), types.NamespacedName{
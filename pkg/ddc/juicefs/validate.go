@@ -17,6 +17,7 @@ limitations under the License.
 package juicefs
 
 import (
+	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 )
@@ -34,6 +35,10 @@ func (j *JuiceFSEngine) Validate(ctx cruntime.ReconcileRequestContext) (err erro
 		return err
 	}
 
-	// TODO: impl validation logic for JuiceFSEngine
-	return nil
+	runtime, err := j.getRuntime()
+	if err != nil {
+		return err
+	}
+
+	return base.ValidateMetadataRestorePolicy(runtime.Spec.RuntimeManagement, common.JuiceFSRuntime)
 }
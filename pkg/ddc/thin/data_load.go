@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	"fmt"
+	"os"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	fluiderrors "github.com/fluid-cloudnative/fluid/pkg/errors"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// the dataset is mounted into the DataLoad job with this volume at this path
+	dataLoadDatasetVolumeName = "fluid-dataset-vol"
+	dataLoadDatasetMountPath  = "/data"
+
+	envDataLoadMountPath = "FLUID_DATALOAD_MOUNT_PATH"
+)
+
+func (t *ThinEngine) generateDataLoadValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataLoad, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		err = fmt.Errorf("object %v is not of type DataLoad", object)
+		return "", err
+	}
+
+	targetDataset, err := utils.GetDataset(t.Client, dataLoad.Spec.Dataset.Name, dataLoad.Spec.Dataset.Namespace)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get dataset")
+	}
+
+	runtime, err := t.getRuntime()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get thinruntime")
+	}
+
+	profile, err := utils.GetResolvedThinRuntimeProfile(t.Client, runtime.Spec.ThinRuntimeProfileName)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get thinruntime profile %s", runtime.Spec.ThinRuntimeProfileName)
+	}
+
+	dataLoadValue, err := t.genDataLoadValue(targetDataset, runtime, profile, dataLoad)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataLoadValue)
+	if err != nil {
+		return "", err
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-loader-values.yaml", dataLoad.Namespace, dataLoad.Name))
+	if err != nil {
+		return "", err
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return "", err
+	}
+	return valueFile.Name(), nil
+}
+
+func (t *ThinEngine) genDataLoadValue(targetDataset *datav1alpha1.Dataset, runtime *datav1alpha1.ThinRuntime,
+	profile *datav1alpha1.ThinRuntimeProfile, dataLoad *datav1alpha1.DataLoad) (value *cdataload.DataLoadValue, err error) {
+	loader := profile.Spec.Loader
+	if loader == nil {
+		return nil, fluiderrors.NewNotSupported(
+			schema.GroupResource{
+				Group:    dataLoad.GetObjectKind().GroupVersionKind().Group,
+				Resource: dataLoad.GetObjectKind().GroupVersionKind().Kind,
+			}, "ThinRuntime with profile "+profile.Name)
+	}
+
+	if len(loader.Command) == 0 && len(loader.Args) == 0 {
+		return nil, fmt.Errorf("loader command and args in thinruntime profile %s can not be both empty", profile.Name)
+	}
+
+	dataLoadInfo := cdataload.DataLoadInfo{
+		BackoffLimit:  3,
+		TargetDataset: dataLoad.Spec.Dataset.Name,
+		LoadMetadata:  dataLoad.Spec.LoadMetadata,
+		Image:         getDataLoadImage(runtime, profile),
+		Labels:        dataLoad.Spec.PodMetadata.Labels,
+		Annotations:   dataflow.InjectAffinityAnnotation(dataLoad.Annotations, dataLoad.Spec.PodMetadata.Annotations),
+		Policy:        string(dataLoad.Spec.Policy),
+		Schedule:      dataLoad.Spec.Schedule,
+		CronJobPolicy: cdataload.GenCronJobPolicy(dataLoad),
+		Resources:     dataLoad.Spec.Resources,
+		Command:       loader.Command,
+		Args:          loader.Args,
+	}
+
+	if len(dataLoadInfo.Image) == 0 {
+		return nil, fmt.Errorf("neither loader image nor fuse image is set in thinruntime profile %s", profile.Name)
+	}
+
+	// image pull secrets of runtime > profile
+	dataLoadInfo.ImagePullSecrets = profile.Spec.ImagePullSecrets
+	if len(runtime.Spec.ImagePullSecrets) != 0 {
+		dataLoadInfo.ImagePullSecrets = runtime.Spec.ImagePullSecrets
+	}
+
+	if dataLoad.Spec.Affinity != nil {
+		dataLoadInfo.Affinity = dataLoad.Spec.Affinity
+	}
+
+	// inject the node affinity by previous operation pod.
+	dataLoadInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(t.Client, dataLoad.Spec.RunAfter, dataLoad.Namespace, dataLoadInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	if dataLoad.Spec.NodeSelector != nil {
+		dataLoadInfo.NodeSelector = dataLoad.Spec.NodeSelector
+	}
+
+	if len(dataLoad.Spec.Tolerations) > 0 {
+		dataLoadInfo.Tolerations = dataLoad.Spec.Tolerations
+	}
+
+	if len(dataLoad.Spec.SchedulerName) > 0 {
+		dataLoadInfo.SchedulerName = dataLoad.Spec.SchedulerName
+	}
+
+	targetPaths := []cdataload.TargetPath{}
+	for _, target := range dataLoad.Spec.Target {
+		targetPaths = append(targetPaths, cdataload.TargetPath{
+			Path:     target.Path,
+			Replicas: target.Replicas,
+		})
+	}
+	// load the whole dataset if no target is specified
+	if len(targetPaths) == 0 {
+		targetPaths = append(targetPaths, cdataload.TargetPath{
+			Path:     "/",
+			Replicas: 1,
+		})
+	}
+	dataLoadInfo.TargetPaths = targetPaths
+
+	// FLUID_DATALOAD_METADATA, FLUID_DATALOAD_DATA_PATH and FLUID_DATALOAD_PATH_REPLICAS are generated and set in the helm job yaml.
+	dataLoadInfo.Envs = []cdataload.Env{
+		{
+			Name:  envDataLoadMountPath,
+			Value: dataLoadDatasetMountPath,
+		},
+	}
+
+	// the dataset is mounted by its pvc, and the volumes in profile are mounted by the loader's volume mounts.
+	dataLoadInfo.Volumes = []corev1.Volume{
+		{
+			Name: dataLoadDatasetVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: targetDataset.Name,
+					ReadOnly:  true,
+				},
+			},
+		},
+	}
+	dataLoadInfo.VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      dataLoadDatasetVolumeName,
+			MountPath: dataLoadDatasetMountPath,
+			ReadOnly:  true,
+		},
+	}
+
+	for _, volumeMount := range loader.VolumeMounts {
+		volume, err := findProfileVolume(profile, volumeMount.Name)
+		if err != nil {
+			return nil, err
+		}
+		dataLoadInfo.Volumes = utils.AppendOrOverrideVolume(dataLoadInfo.Volumes, *volume)
+		dataLoadInfo.VolumeMounts = utils.AppendOrOverrideVolumeMounts(dataLoadInfo.VolumeMounts, volumeMount)
+	}
+
+	// the manifest and file patterns are passed to the loader by envs
+	cdataload.SetTargetFilters(&dataLoadInfo, dataLoad)
+	cdataload.InjectTargetFilterEnvs(&dataLoadInfo)
+
+	value = &cdataload.DataLoadValue{
+		Name:           dataLoad.Name,
+		OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+		DataLoadInfo:   dataLoadInfo,
+		Owner:          transformer.GenerateOwnerReferenceFromObject(dataLoad),
+	}
+
+	return value, nil
+}
+
+// getDataLoadImage returns the loader image in profile, or the fuse image of runtime > profile if it's not set.
+func getDataLoadImage(runtime *datav1alpha1.ThinRuntime, profile *datav1alpha1.ThinRuntimeProfile) string {
+	image, tag := profile.Spec.Loader.Image, profile.Spec.Loader.ImageTag
+	if len(image) == 0 {
+		image, tag = profile.Spec.Fuse.Image, profile.Spec.Fuse.ImageTag
+		if len(runtime.Spec.Fuse.Image) != 0 {
+			image = runtime.Spec.Fuse.Image
+		}
+		if len(runtime.Spec.Fuse.ImageTag) != 0 {
+			tag = runtime.Spec.Fuse.ImageTag
+		}
+	}
+
+	if len(image) == 0 || len(tag) == 0 {
+		return image
+	}
+	return image + ":" + tag
+}
+
+func findProfileVolume(profile *datav1alpha1.ThinRuntimeProfile, name string) (*corev1.Volume, error) {
+	for i := range profile.Spec.Volumes {
+		if profile.Spec.Volumes[i].Name == name {
+			return &profile.Spec.Volumes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("failed to find the volume %s of loader in thinruntime profile %s", name, profile.Name)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ThinEngine DataLoad", Label("pkg.ddc.thin.data_load_test.go"), func() {
+	var (
+		engine   *ThinEngine
+		dataset  *datav1alpha1.Dataset
+		runtime  *datav1alpha1.ThinRuntime
+		profile  *datav1alpha1.ThinRuntimeProfile
+		dataLoad *datav1alpha1.DataLoad
+	)
+
+	BeforeEach(func() {
+		dataset = &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+		}
+		runtime = &datav1alpha1.ThinRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: datav1alpha1.ThinRuntimeSpec{
+				ThinRuntimeProfileName: "test-profile",
+			},
+		}
+		profile = &datav1alpha1.ThinRuntimeProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+			Spec: datav1alpha1.ThinRuntimeProfileSpec{
+				FileSystemType:   "test-fs",
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "profile-secret"}},
+				Fuse: datav1alpha1.ThinFuseSpec{
+					Image:    "test-fuse",
+					ImageTag: "v1",
+				},
+				Volumes: []corev1.Volume{
+					{
+						Name:         "fs-config",
+						VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "fs-config"}}},
+					},
+				},
+				Loader: &datav1alpha1.ThinLoaderSpec{
+					Image:    "test-loader",
+					ImageTag: "v2",
+					Command:  []string{"sh", "-c"},
+					Args:     []string{"warmup --paths $FLUID_DATALOAD_DATA_PATH"},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "fs-config", MountPath: "/etc/fs"},
+					},
+				},
+			},
+		}
+		dataLoad = &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-load", Namespace: "default"},
+			Spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "demo", Namespace: "default"},
+				Target: []datav1alpha1.TargetPath{
+					{Path: "/a", Replicas: 1},
+					{Path: "/b", Replicas: 2},
+				},
+			},
+		}
+		engine = &ThinEngine{
+			name:      "demo",
+			namespace: "default",
+			Client:    fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme),
+		}
+	})
+
+	It("should generate the DataLoad value with the loader in profile", func() {
+		value, err := engine.genDataLoadValue(dataset, runtime, profile, dataLoad)
+		Expect(err).NotTo(HaveOccurred())
+
+		info := value.DataLoadInfo
+		Expect(value.Name).To(Equal("test-load"))
+		Expect(info.Image).To(Equal("test-loader:v2"))
+		Expect(info.Command).To(Equal([]string{"sh", "-c"}))
+		Expect(info.Args).To(Equal([]string{"warmup --paths $FLUID_DATALOAD_DATA_PATH"}))
+		Expect(info.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{{Name: "profile-secret"}}))
+		Expect(info.TargetPaths).To(HaveLen(2))
+		Expect(info.TargetPaths[1].Path).To(Equal("/b"))
+		Expect(info.TargetPaths[1].Replicas).To(Equal(int32(2)))
+
+		Expect(info.Volumes).To(HaveLen(2))
+		Expect(info.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("demo"))
+		Expect(info.Volumes[1].Name).To(Equal("fs-config"))
+		Expect(info.VolumeMounts).To(HaveLen(2))
+		Expect(info.VolumeMounts[1].MountPath).To(Equal("/etc/fs"))
+	})
+
+	It("should fall back to the fuse image and load the whole dataset by default", func() {
+		profile.Spec.Loader.Image = ""
+		runtime.Spec.Fuse.ImageTag = "v3"
+		runtime.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "runtime-secret"}}
+		dataLoad.Spec.Target = nil
+
+		value, err := engine.genDataLoadValue(dataset, runtime, profile, dataLoad)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value.DataLoadInfo.Image).To(Equal("test-fuse:v3"))
+		Expect(value.DataLoadInfo.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{{Name: "runtime-secret"}}))
+		Expect(value.DataLoadInfo.TargetPaths).To(HaveLen(1))
+		Expect(value.DataLoadInfo.TargetPaths[0].Path).To(Equal("/"))
+	})
+
+	It("should pass the manifest and file patterns to the loader by envs", func() {
+		dataLoad.Spec.Manifest = &datav1alpha1.DataLoadManifest{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "files"}, Key: "list"},
+		}
+		dataLoad.Spec.Exclude = []string{"*.tmp"}
+
+		value, err := engine.genDataLoadValue(dataset, runtime, profile, dataLoad)
+		Expect(err).NotTo(HaveOccurred())
+		info := value.DataLoadInfo
+		Expect(info.Envs).To(ContainElements(
+			cdataload.Env{Name: cdataload.EnvManifestFile, Value: "/etc/fluid/dataload-manifest/manifest"},
+			cdataload.Env{Name: cdataload.EnvExcludePatterns, Value: "*.tmp"},
+		))
+		Expect(info.Volumes).To(HaveLen(3))
+		Expect(info.Volumes[2].ConfigMap.Name).To(Equal("files"))
+		Expect(info.VolumeMounts[2].MountPath).To(Equal(cdataload.ManifestMountDir))
+	})
+
+	It("should return a not-supported error if the loader is not set", func() {
+		profile.Spec.Loader = nil
+		_, err := engine.genDataLoadValue(dataset, runtime, profile, dataLoad)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error if the command and args are both empty", func() {
+		profile.Spec.Loader.Command = nil
+		profile.Spec.Loader.Args = nil
+		_, err := engine.genDataLoadValue(dataset, runtime, profile, dataLoad)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error if the volume of loader is not in profile", func() {
+		profile.Spec.Volumes = nil
+		_, err := engine.genDataLoadValue(dataset, runtime, profile, dataLoad)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error if the object is not a DataLoad", func() {
+		_, err := engine.generateDataLoadValueFile(cruntime.ReconcileRequestContext{}, &datav1alpha1.Dataset{})
+		Expect(err).To(HaveOccurred())
+	})
+})
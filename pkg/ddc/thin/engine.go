@@ -95,6 +95,10 @@ func buildThinEngine(id string, ctx cruntime.ReconcileRequestContext) (base.Engi
 	if err != nil {
 		return nil, errors.Wrapf(err, "error when getting thinruntime profile %s", runtime.Spec.ThinRuntimeProfileName)
 	}
+	// failures of resolving the parent profiles are reported in the runtime conditions during setup
+	if resolvedProfile, err := utils.ResolveThinRuntimeProfile(ctx.Client, runtimeProfile); err == nil {
+		runtimeProfile = resolvedProfile
+	}
 	engine.runtimeProfile = runtimeProfile
 
 	// Build and setup runtime info
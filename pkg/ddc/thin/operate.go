@@ -28,6 +28,9 @@ func (t *ThinEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestCont
 	object := operation.GetOperationObject()
 
 	switch operationType {
+	case dataoperation.DataLoadType:
+		valueFileName, err = t.generateDataLoadValueFile(ctx, object)
+		return valueFileName, err
 	case dataoperation.DataProcessType:
 		valueFileName, err = t.generateDataProcessValueFile(ctx, object)
 		return valueFileName, err
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// resolveThinRuntimeProfile merges the profile with all its parent profiles, and reports the result in the
+// ProfileResolved condition of the runtime if the profile inherits from a parent profile.
+func (t *ThinEngine) resolveThinRuntimeProfile(profile *datav1alpha1.ThinRuntimeProfile) (*datav1alpha1.ThinRuntimeProfile, error) {
+	resolved, err := utils.ResolveThinRuntimeProfile(t.Client, profile)
+	if len(profile.Spec.ParentProfileName) == 0 && err == nil {
+		return resolved, nil
+	}
+
+	var cond datav1alpha1.RuntimeCondition
+	switch {
+	case err == nil:
+		cond = utils.NewRuntimeCondition(datav1alpha1.RuntimeProfileResolved, datav1alpha1.RuntimeProfileResolvedReason,
+			"The profile is merged with its parent profiles.", corev1.ConditionTrue)
+	case errors.Is(err, utils.ErrParentThinRuntimeProfileNotFound):
+		cond = utils.NewRuntimeCondition(datav1alpha1.RuntimeProfileResolved, datav1alpha1.RuntimeParentProfileNotFoundReason,
+			err.Error(), corev1.ConditionFalse)
+	case errors.Is(err, utils.ErrThinRuntimeProfileCycle):
+		cond = utils.NewRuntimeCondition(datav1alpha1.RuntimeProfileResolved, datav1alpha1.RuntimeProfileCycleReason,
+			err.Error(), corev1.ConditionFalse)
+	case errors.Is(err, utils.ErrThinRuntimeProfileNoFileSystemType):
+		cond = utils.NewRuntimeCondition(datav1alpha1.RuntimeProfileResolved, datav1alpha1.RuntimeProfileNoFileSystemTypeReason,
+			err.Error(), corev1.ConditionFalse)
+	default:
+		return nil, err
+	}
+
+	if updateErr := t.updateProfileResolvedCondition(cond); updateErr != nil {
+		t.Log.Error(updateErr, "Failed to update the ProfileResolved condition")
+	}
+	return resolved, err
+}
+
+func (t *ThinEngine) updateProfileResolvedCondition(cond datav1alpha1.RuntimeCondition) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, err := t.getRuntime()
+		if err != nil {
+			return err
+		}
+		runtimeToUpdate := runtime.DeepCopy()
+		runtimeToUpdate.Status.Conditions = utils.UpdateRuntimeCondition(runtimeToUpdate.Status.Conditions, cond)
+		if reflect.DeepEqual(runtime.Status, runtimeToUpdate.Status) {
+			return nil
+		}
+		return t.Client.Status().Update(context.TODO(), runtimeToUpdate)
+	})
+}
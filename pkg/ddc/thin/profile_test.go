@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("ThinEngine_ResolveThinRuntimeProfile", func() {
+	var (
+		thinRuntime *datav1alpha1.ThinRuntime
+		parent      *datav1alpha1.ThinRuntimeProfile
+		profile     *datav1alpha1.ThinRuntimeProfile
+		engine      *ThinEngine
+	)
+
+	BeforeEach(func() {
+		parent = &datav1alpha1.ThinRuntimeProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "base"},
+			Spec: datav1alpha1.ThinRuntimeProfileSpec{
+				FileSystemType: "fuse.base",
+				Fuse:           datav1alpha1.ThinFuseSpec{Image: "base-fuse", ImageTag: "v1"},
+			},
+		}
+		profile = &datav1alpha1.ThinRuntimeProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+			Spec: datav1alpha1.ThinRuntimeProfileSpec{
+				ParentProfileName: parent.Name,
+				Fuse:              datav1alpha1.ThinFuseSpec{ImageTag: "v2"},
+			},
+		}
+		thinRuntime = &datav1alpha1.ThinRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"},
+			Spec:       datav1alpha1.ThinRuntimeSpec{ThinRuntimeProfileName: profile.Name},
+		}
+	})
+
+	JustBeforeEach(func() {
+		client := fake.NewFakeClientWithScheme(testScheme, []runtime.Object{thinRuntime, profile, parent}...)
+		engine = &ThinEngine{
+			name:      thinRuntime.Name,
+			namespace: thinRuntime.Namespace,
+			runtime:   thinRuntime,
+			Client:    client,
+			Log:       fake.NullLogger(),
+		}
+	})
+
+	getProfileResolvedCondition := func() *datav1alpha1.RuntimeCondition {
+		runtime, err := engine.getRuntime()
+		Expect(err).NotTo(HaveOccurred())
+		_, cond := utils.GetRuntimeCondition(runtime.Status.Conditions, datav1alpha1.RuntimeProfileResolved)
+		return cond
+	}
+
+	It("should merge the profile with its parent", func() {
+		resolved, err := engine.getThinRuntimeProfile()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved.Spec.FileSystemType).To(Equal("fuse.base"))
+		Expect(getFuseImage(thinRuntime, resolved)).To(Equal("base-fuse:v2"))
+
+		cond := getProfileResolvedCondition()
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+	})
+
+	When("the parent profile is not found", func() {
+		BeforeEach(func() {
+			profile.Spec.ParentProfileName = "not-exist"
+		})
+
+		It("should report the missing parent in the runtime condition", func() {
+			_, err := engine.getThinRuntimeProfile()
+			Expect(err).To(HaveOccurred())
+
+			cond := getProfileResolvedCondition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeParentProfileNotFoundReason))
+		})
+	})
+
+	When("the profiles inherit from each other", func() {
+		BeforeEach(func() {
+			parent.Spec.ParentProfileName = profile.Name
+		})
+
+		It("should report the cycle in the runtime condition", func() {
+			_, err := engine.getThinRuntimeProfile()
+			Expect(err).To(HaveOccurred())
+
+			cond := getProfileResolvedCondition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeProfileCycleReason))
+		})
+	})
+
+	When("no profile sets the file system type", func() {
+		BeforeEach(func() {
+			parent.Spec.FileSystemType = ""
+		})
+
+		It("should report the missing file system type in the runtime condition", func() {
+			_, err := engine.getThinRuntimeProfile()
+			Expect(err).To(HaveOccurred())
+
+			cond := getProfileResolvedCondition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeProfileNoFileSystemTypeReason))
+		})
+	})
+
+	When("the profile has no parent", func() {
+		BeforeEach(func() {
+			profile.Spec.ParentProfileName = ""
+			profile.Spec.FileSystemType = "fuse.test"
+		})
+
+		It("should not set the runtime condition", func() {
+			_, err := engine.getThinRuntimeProfile()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getProfileResolvedCondition()).To(BeNil())
+		})
+	})
+})
@@ -39,5 +39,11 @@ func (e *ReferenceDatasetEngine) Validate(ctx runtime.ReconcileRequestContext) (
 		return err
 	}
 
-	return nil
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return err
+	}
+
+	// the ThinRuntime referencing another dataset has no statefulset to hibernate
+	return base.ValidateHibernationPolicy(runtime.Spec.RuntimeManagement, "ThinRuntime referencing a dataset")
 }
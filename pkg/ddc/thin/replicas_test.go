@@ -26,11 +26,13 @@ import (
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -104,7 +106,10 @@ func TestSyncReplicas(t *testing.T) {
 				Namespace: "fluid",
 			},
 			Spec: v1alpha1.ThinRuntimeSpec{
-				Replicas: 3, // 2
+				Replicas: 3,
+				Worker: v1alpha1.ThinCompTemplateSpec{
+					Enabled: true,
+				},
 			},
 			Status: v1alpha1.RuntimeStatus{
 				DesiredWorkerNumberScheduled: 2,
@@ -121,6 +126,9 @@ func TestSyncReplicas(t *testing.T) {
 			},
 			Spec: v1alpha1.ThinRuntimeSpec{
 				Replicas: 1,
+				Worker: v1alpha1.ThinCompTemplateSpec{
+					Enabled: true,
+				},
 			},
 			Status: v1alpha1.RuntimeStatus{
 				DesiredWorkerNumberScheduled: 2,
@@ -138,6 +146,9 @@ func TestSyncReplicas(t *testing.T) {
 			},
 			Spec: v1alpha1.ThinRuntimeSpec{
 				Replicas: 2,
+				Worker: v1alpha1.ThinCompTemplateSpec{
+					Enabled: true,
+				},
 			},
 			Status: v1alpha1.RuntimeStatus{
 				DesiredWorkerNumberScheduled: 2,
@@ -155,18 +166,27 @@ func TestSyncReplicas(t *testing.T) {
 				Name:      "hbase-worker",
 				Namespace: "fluid",
 			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](2),
+			},
 		},
 		{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "hadoop-worker",
 				Namespace: "fluid",
 			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](2),
+			},
 		},
 		{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "obj-worker",
 				Namespace: "fluid",
 			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](2),
+			},
 		},
 		{
 			ObjectMeta: metav1.ObjectMeta{
@@ -231,13 +251,13 @@ func TestSyncReplicas(t *testing.T) {
 		{
 			name:      "hbase",
 			namespace: "fluid",
-			Type:      "FusesScaledOut",
+			Type:      v1alpha1.RuntimeWorkerScaledOut,
 			isErr:     false,
 		},
 		{
 			name:      "hadoop",
 			namespace: "fluid",
-			Type:      "FusesScaledIn",
+			Type:      v1alpha1.RuntimeWorkerScaledIn,
 			isErr:     false,
 		},
 		{
@@ -255,6 +275,10 @@ func TestSyncReplicas(t *testing.T) {
 		}
 
 		engine.Helper = ctrlhelper.BuildHelper(runtimeInfo, fakeClient, engine.Log)
+		engine.runtime, err = engine.getRuntime()
+		if err != nil {
+			t.Errorf("failed to get runtime, err:%s", err.Error())
+		}
 		err = engine.SyncReplicas(cruntime.ReconcileRequestContext{
 			Log:      fake.NullLogger(),
 			Recorder: record.NewFakeRecorder(300),
@@ -263,11 +287,18 @@ func TestSyncReplicas(t *testing.T) {
 			t.Errorf("sync replicas failed,err:%s", err.Error())
 		}
 		rt, _ := engine.getRuntime()
-		if len(rt.Status.Conditions) == 4 {
-			Type := rt.Status.Conditions[3].Type
-			if Type != testCase.Type {
-				t.Errorf("runtime condition want %s, got %s", testCase.Type, Type)
+		workers, _ := kubeclient.GetStatefulSet(fakeClient, engine.getWorkerName(), testCase.namespace)
+		if *workers.Spec.Replicas != rt.Spec.Replicas {
+			t.Errorf("worker replicas want %d, got %d", rt.Spec.Replicas, *workers.Spec.Replicas)
+		}
+		var scaleCondType v1alpha1.RuntimeConditionType
+		for _, cond := range rt.Status.Conditions {
+			if cond.Type == v1alpha1.RuntimeWorkerScaledOut || cond.Type == v1alpha1.RuntimeWorkerScaledIn {
+				scaleCondType = cond.Type
 			}
 		}
+		if scaleCondType != testCase.Type {
+			t.Errorf("runtime condition want %s, got %s", testCase.Type, scaleCondType)
+		}
 	}
 }
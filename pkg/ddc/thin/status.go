@@ -62,6 +62,8 @@ func (t *ThinEngine) CheckAndUpdateRuntimeStatus() (bool, error) {
 			// set node affinity
 			workerNodeAffinity := kubeclient.MergeNodeSelectorAndNodeAffinity(workers.Spec.Template.Spec.NodeSelector, workers.Spec.Template.Spec.Affinity)
 			runtimeToUpdate.Status.CacheAffinity = workerNodeAffinity
+			// the selector of the workers is used by the scale subresource
+			runtimeToUpdate.Status.Selector = t.getWorkerSelectors()
 			if runtime.Replicas() == 0 || workers.Status.ReadyReplicas > 0 {
 				runtimeReady = true
 			}
@@ -147,5 +147,6 @@ var _ = Describe("ThinEngine CheckAndUpdateRuntimeStatus", func() {
 			Operator: corev1.NodeSelectorOpIn,
 			Values:   []string{"zone-a"},
 		}))
+		Expect(updatedRuntime.Status.Selector).To(Equal("app=thin,release=workers,role=thin-worker"))
 	})
 })
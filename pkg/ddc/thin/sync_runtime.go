@@ -16,8 +16,78 @@
 
 package thin
 
-import cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+import (
+	"context"
+	"reflect"
 
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// SyncRuntime syncs the fuse upgrade strategy to the fuse daemonset. In HotUpgrade, the fuse image is synced as well,
+// so that the fuse pods are rolling updated by the daemonset and the new fuse processes take over the fuse sessions.
 func (t ThinEngine) SyncRuntime(ctx cruntime.ReconcileRequestContext) (changed bool, err error) {
-	return
+	runtime, err := t.getRuntime()
+	if err != nil {
+		return
+	}
+	profile, err := utils.GetResolvedThinRuntimeProfile(t.Client, runtime.Spec.ThinRuntimeProfileName)
+	if err != nil {
+		return
+	}
+
+	fuses, err := kubeclient.GetDaemonset(t.Client, t.getFuseName(), t.namespace)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			// the fuse is not set up yet
+			return false, nil
+		}
+		return
+	}
+
+	strategy := getFuseUpgradeStrategy(runtime, profile)
+	fusesToUpdate := fuses.DeepCopy()
+	fusesToUpdate.Spec.UpdateStrategy = utils.GetFuseUpdateStrategy(strategy)
+	if utils.IsFuseHotUpgrade(strategy) {
+		containerIdx := utils.GetContainerIndex(fusesToUpdate.Spec.Template.Spec.Containers, common.ThinFuseContainer)
+		if image := getFuseImage(runtime, profile); containerIdx >= 0 && len(image) > 0 {
+			fusesToUpdate.Spec.Template.Spec.Containers[containerIdx].Image = image
+		}
+	}
+
+	if reflect.DeepEqual(fuses.Spec, fusesToUpdate.Spec) {
+		return false, nil
+	}
+
+	t.Log.Info("syncFuseSpec: the fuse is changed, try to update fuse daemonset", "fuse ds", types.NamespacedName{Namespace: fusesToUpdate.Namespace, Name: fusesToUpdate.Name},
+		"updateStrategy", fusesToUpdate.Spec.UpdateStrategy.Type)
+	if err = t.Client.Update(context.TODO(), fusesToUpdate); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// getFuseImage returns the fuse image of the runtime, the image and tag not set in the runtime are inherited from the profile
+func getFuseImage(runtime *datav1alpha1.ThinRuntime, profile *datav1alpha1.ThinRuntimeProfile) string {
+	var image, tag string
+	if profile != nil {
+		image, tag = profile.Spec.Fuse.Image, profile.Spec.Fuse.ImageTag
+	}
+	if len(runtime.Spec.Fuse.Image) != 0 {
+		image = runtime.Spec.Fuse.Image
+	}
+	if len(runtime.Spec.Fuse.ImageTag) != 0 {
+		tag = runtime.Spec.Fuse.ImageTag
+	}
+
+	if len(image) == 0 || len(tag) == 0 {
+		return image
+	}
+	return image + ":" + tag
 }
@@ -19,18 +19,120 @@ package thin
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/fluid-cloudnative/fluid/pkg/runtime"
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 )
 
 var _ = Describe("ThinEngine_SyncRuntime", func() {
-	It("should return false for changed and no error", func() {
-		var ctx runtime.ReconcileRequestContext
-		engine := ThinEngine{}
+	const oldImage = "test-fuse:v1"
 
-		gotChanged, err := engine.SyncRuntime(ctx)
+	var (
+		thinRuntime *datav1alpha1.ThinRuntime
+		profile     *datav1alpha1.ThinRuntimeProfile
+		fuses       *appsv1.DaemonSet
+		engine      ThinEngine
+		k8sClient   client.Client
+	)
 
+	BeforeEach(func() {
+		profile = &datav1alpha1.ThinRuntimeProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+			Spec: datav1alpha1.ThinRuntimeProfileSpec{
+				FileSystemType: "fuse.test",
+				Fuse:           datav1alpha1.ThinFuseSpec{Image: "test-fuse", ImageTag: "v1"},
+			},
+		}
+		thinRuntime = &datav1alpha1.ThinRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"},
+			Spec: datav1alpha1.ThinRuntimeSpec{
+				ThinRuntimeProfileName: profile.Name,
+				Fuse:                   datav1alpha1.ThinFuseSpec{ImageTag: "v2"},
+			},
+		}
+		fuses = &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-fuse", Namespace: "fluid"},
+			Spec: appsv1.DaemonSetSpec{
+				UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: common.ThinFuseContainer, Image: oldImage}},
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = fake.NewFakeClientWithScheme(testScheme, []runtime.Object{thinRuntime, profile, fuses}...)
+		engine = ThinEngine{
+			name:      thinRuntime.Name,
+			namespace: thinRuntime.Namespace,
+			Client:    k8sClient,
+			Log:       fake.NullLogger(),
+		}
+	})
+
+	getFuses := func() *appsv1.DaemonSet {
+		ds, err := kubeclient.GetDaemonset(k8sClient, fuses.Name, fuses.Namespace)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(gotChanged).To(BeFalse())
+		return ds
+	}
+
+	It("should not change the fuse if it's recreated on upgrade", func() {
+		changed, err := engine.SyncRuntime(cruntime.ReconcileRequestContext{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(getFuses().Spec.Template.Spec.Containers[0].Image).To(Equal(oldImage))
+	})
+
+	When("the profile enables HotUpgrade", func() {
+		BeforeEach(func() {
+			profile.Spec.UpgradeStrategy.Type = datav1alpha1.HotUpgradeFuseUpgradeStrategyType
+		})
+
+		It("should rolling update the fuse to the new image", func() {
+			changed, err := engine.SyncRuntime(cruntime.ReconcileRequestContext{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			updated := getFuses()
+			Expect(updated.Spec.UpdateStrategy).To(Equal(utils.GetFuseUpdateStrategy(profile.Spec.UpgradeStrategy)))
+			Expect(updated.Spec.Template.Spec.Containers[0].Image).To(Equal("test-fuse:v2"))
+		})
+
+		When("the runtime sets the Recreate strategy", func() {
+			BeforeEach(func() {
+				thinRuntime.Spec.UpgradeStrategy.Type = datav1alpha1.RecreateFuseUpgradeStrategyType
+			})
+
+			It("should not change the fuse", func() {
+				changed, err := engine.SyncRuntime(cruntime.ReconcileRequestContext{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(changed).To(BeFalse())
+				Expect(getFuses().Spec.Template.Spec.Containers[0].Image).To(Equal(oldImage))
+			})
+		})
+	})
+
+	When("the fuse is not created yet", func() {
+		BeforeEach(func() {
+			fuses.Name = "other-fuse"
+		})
+
+		It("should skip syncing", func() {
+			changed, err := engine.SyncRuntime(cruntime.ReconcileRequestContext{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+		})
 	})
 })
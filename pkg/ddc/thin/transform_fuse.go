@@ -141,9 +141,27 @@ func (t *ThinEngine) transformFuse(runtime *datav1alpha1.ThinRuntime, profile *d
 	if err != nil {
 		return err
 	}
+
+	// 18. upgrade strategy
+	t.transformFuseUpgradeStrategy(runtime, profile, value)
 	return
 }
 
+// transformFuseUpgradeStrategy sets the update strategy of the fuse daemonset. In HotUpgrade, the fuse pods on a node
+// share the socket through which the running fuse process hands off the fuse session to the new one.
+func (t *ThinEngine) transformFuseUpgradeStrategy(runtime *datav1alpha1.ThinRuntime, profile *datav1alpha1.ThinRuntimeProfile, value *ThinValue) {
+	strategy := getFuseUpgradeStrategy(runtime, profile)
+	value.Fuse.UpdateStrategy = utils.GetFuseUpdateStrategy(strategy)
+	if !utils.IsFuseHotUpgrade(strategy) {
+		return
+	}
+
+	volume, volumeMount, env := utils.GetFuseHandOffVolumes(runtime.Namespace, runtime.Name, common.ThinRuntime)
+	value.Fuse.Volumes = append(value.Fuse.Volumes, volume)
+	value.Fuse.VolumeMounts = append(value.Fuse.VolumeMounts, volumeMount)
+	value.Fuse.Envs = append(value.Fuse.Envs, env)
+}
+
 func (t *ThinEngine) parseFuseImage(runtime *datav1alpha1.ThinRuntime, value *ThinValue) {
 	if len(runtime.Spec.Fuse.Image) != 0 {
 		value.Fuse.Image = runtime.Spec.Fuse.Image
@@ -171,6 +189,10 @@ func (t *ThinEngine) parseLifecycle(runtime *datav1alpha1.ThinRuntime, profile *
 			},
 		},
 	}
+	// the mount point is taken over by the new fuse pod in HotUpgrade, so it must not be umounted by the old one
+	if utils.IsFuseHotUpgrade(getFuseUpgradeStrategy(runtime, profile)) {
+		value.Fuse.Lifecycle.PreStop = nil
+	}
 
 	// set lifecycle from profile
 	if fuseLifecycleInProfile := profile.Spec.Fuse.Lifecycle; fuseLifecycleInProfile != nil {
@@ -192,6 +214,10 @@ func (t *ThinEngine) parseLifecycle(runtime *datav1alpha1.ThinRuntime, profile *
 			value.Fuse.Lifecycle.PreStop = fuseLifecycleInRuntime.PreStop
 		}
 	}
+
+	if value.Fuse.Lifecycle.PreStop == nil {
+		value.Fuse.Lifecycle = nil
+	}
 	return nil
 }
 
@@ -22,7 +22,9 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -363,6 +365,16 @@ var _ = Describe("ThinEngine FUSE Transformation Tests", Label("pkg.ddc.thin.tra
 			})
 		})
 
+		When("the fuse is hot upgraded", func() {
+			It("should not umount the mount point on stop", func() {
+				profile.Spec.UpgradeStrategy.Type = datav1alpha1.HotUpgradeFuseUpgradeStrategyType
+
+				err := engine.parseLifecycle(thinruntime, profile, value)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(value.Fuse.Lifecycle).To(BeNil())
+			})
+		})
+
 		When("postStart is set in profile", func() {
 			It("should return error when postStart is set in profile", func() {
 				profile.Spec.Fuse.Lifecycle = &corev1.Lifecycle{
@@ -394,6 +406,25 @@ var _ = Describe("ThinEngine FUSE Transformation Tests", Label("pkg.ddc.thin.tra
 		})
 	})
 
+	Describe("Test ThinEngine.transformFuseUpgradeStrategy", func() {
+		It("should recreate the fuse pods on delete by default", func() {
+			engine.transformFuseUpgradeStrategy(thinruntime, profile, value)
+			Expect(value.Fuse.UpdateStrategy.Type).To(Equal(appsv1.OnDeleteDaemonSetStrategyType))
+			Expect(value.Fuse.Volumes).To(BeEmpty())
+		})
+
+		It("should share the hand-off socket with the new fuse pod if the runtime enables HotUpgrade", func() {
+			thinruntime.Spec.UpgradeStrategy.Type = datav1alpha1.HotUpgradeFuseUpgradeStrategyType
+			engine.transformFuseUpgradeStrategy(thinruntime, profile, value)
+
+			Expect(value.Fuse.UpdateStrategy.Type).To(Equal(appsv1.RollingUpdateDaemonSetStrategyType))
+			volume, volumeMount, env := utils.GetFuseHandOffVolumes(thinruntime.Namespace, thinruntime.Name, common.ThinRuntime)
+			Expect(value.Fuse.Volumes).To(ContainElement(volume))
+			Expect(value.Fuse.VolumeMounts).To(ContainElement(volumeMount))
+			Expect(value.Fuse.Envs).To(ContainElement(env))
+		})
+	})
+
 	Describe("Test ThinEngine.parseHostVolumeFromDataset", func() {
 		var (
 			dataset *datav1alpha1.Dataset
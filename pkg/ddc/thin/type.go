@@ -19,6 +19,7 @@ package thin
 import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -58,31 +59,32 @@ type Worker struct {
 }
 
 type Fuse struct {
-	Enabled          bool                          `json:"enabled,omitempty"`
-	Labels           map[string]string             `json:"labels,omitempty"`
-	Annotations      map[string]string             `json:"annotations,omitempty"`
-	Image            string                        `json:"image,omitempty"`
-	ImageTag         string                        `json:"imageTag,omitempty"`
-	ImagePullPolicy  string                        `json:"imagePullPolicy,omitempty"`
-	Resources        common.Resources              `json:"resources,omitempty"`
-	Ports            []corev1.ContainerPort        `json:"ports,omitempty"`
-	CriticalPod      bool                          `json:"criticalPod,omitempty"`
-	HostNetwork      bool                          `json:"hostNetwork"`
-	HostPID          bool                          `json:"hostPID,omitempty"`
-	TargetPath       string                        `json:"targetPath,omitempty"`
-	NodeSelector     map[string]string             `json:"nodeSelector,omitempty"`
-	Envs             []corev1.EnvVar               `json:"envs,omitempty"`
-	Command          []string                      `json:"command,omitempty"`
-	Args             []string                      `json:"args,omitempty"`
-	Volumes          []corev1.Volume               `json:"volumes,omitempty"`
-	VolumeMounts     []corev1.VolumeMount          `json:"volumeMounts,omitempty"`
-	LivenessProbe    *corev1.Probe                 `json:"livenessProbe,omitempty"`
-	ReadinessProbe   *corev1.Probe                 `json:"readinessProbe,omitempty"`
-	CacheDir         string                        `json:"cacheDir,omitempty"`
-	ConfigValue      string                        `json:"configValue"`
-	ConfigStorage    string                        `json:"configStorage"`
-	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
-	Lifecycle        *corev1.Lifecycle             `json:"lifecycle,omitempty"`
+	Enabled          bool                           `json:"enabled,omitempty"`
+	Labels           map[string]string              `json:"labels,omitempty"`
+	Annotations      map[string]string              `json:"annotations,omitempty"`
+	Image            string                         `json:"image,omitempty"`
+	ImageTag         string                         `json:"imageTag,omitempty"`
+	ImagePullPolicy  string                         `json:"imagePullPolicy,omitempty"`
+	Resources        common.Resources               `json:"resources,omitempty"`
+	Ports            []corev1.ContainerPort         `json:"ports,omitempty"`
+	CriticalPod      bool                           `json:"criticalPod,omitempty"`
+	HostNetwork      bool                           `json:"hostNetwork"`
+	HostPID          bool                           `json:"hostPID,omitempty"`
+	TargetPath       string                         `json:"targetPath,omitempty"`
+	NodeSelector     map[string]string              `json:"nodeSelector,omitempty"`
+	Envs             []corev1.EnvVar                `json:"envs,omitempty"`
+	Command          []string                       `json:"command,omitempty"`
+	Args             []string                       `json:"args,omitempty"`
+	Volumes          []corev1.Volume                `json:"volumes,omitempty"`
+	VolumeMounts     []corev1.VolumeMount           `json:"volumeMounts,omitempty"`
+	LivenessProbe    *corev1.Probe                  `json:"livenessProbe,omitempty"`
+	ReadinessProbe   *corev1.Probe                  `json:"readinessProbe,omitempty"`
+	CacheDir         string                         `json:"cacheDir,omitempty"`
+	ConfigValue      string                         `json:"configValue"`
+	ConfigStorage    string                         `json:"configStorage"`
+	ImagePullSecrets []corev1.LocalObjectReference  `json:"imagePullSecrets,omitempty"`
+	Lifecycle        *corev1.Lifecycle              `json:"lifecycle,omitempty"`
+	UpdateStrategy   appsv1.DaemonSetUpdateStrategy `json:"updateStrategy,omitempty"`
 }
 
 type Config struct {
@@ -58,7 +58,22 @@ func (t *ThinEngine) getThinRuntimeProfile() (*datav1alpha1.ThinRuntimeProfile,
 	if err := t.Get(context.TODO(), key, &profile); err != nil {
 		return nil, err
 	}
-	return &profile, nil
+	return t.resolveThinRuntimeProfile(&profile)
+}
+
+// getFuseUpgradeStrategy returns the fuse upgrade strategy of the runtime, the fields not set in the runtime are
+// inherited from the profile
+func getFuseUpgradeStrategy(runtime *datav1alpha1.ThinRuntime, profile *datav1alpha1.ThinRuntimeProfile) (strategy datav1alpha1.FuseUpgradeStrategy) {
+	if profile != nil {
+		strategy = profile.Spec.UpgradeStrategy
+	}
+	if len(runtime.Spec.UpgradeStrategy.Type) != 0 {
+		strategy.Type = runtime.Spec.UpgradeStrategy.Type
+	}
+	if runtime.Spec.UpgradeStrategy.MaxSurge != nil {
+		strategy.MaxSurge = runtime.Spec.UpgradeStrategy.MaxSurge
+	}
+	return
 }
 
 func (t *ThinEngine) getFuseName() (dsName string) {
@@ -69,6 +84,12 @@ func (t *ThinEngine) getWorkerName() (dsName string) {
 	return t.name + "-worker"
 }
 
+// GetHibernationStatefulSetNames returns the worker statefulset to scale to zero when hibernating, ThinRuntime
+// has no master.
+func (t *ThinEngine) GetHibernationStatefulSetNames() (worker string, master string) {
+	return t.getWorkerName(), ""
+}
+
 func (t *ThinEngine) getTargetPath() (targetPath string) {
 	mountRoot := getMountRoot()
 	t.Log.Info("mountRoot", "path", mountRoot)
@@ -19,6 +19,7 @@ package thin
 import (
 	"fmt"
 
+	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -84,5 +85,11 @@ func (t *ThinEngine) Validate(ctx cruntime.ReconcileRequestContext) (err error)
 			return err
 		}
 	}
-	return nil
+
+	runtime, err := t.getRuntime()
+	if err != nil {
+		return err
+	}
+
+	return base.ValidateMetadataRestorePolicy(runtime.Spec.RuntimeManagement, common.ThinRuntime)
 }
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Fluid Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vineyard
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+const (
+	// the rpc endpoints of the vineyard workers are mounted into the DataLoad job from the rpc-conf configmap
+	dataLoadRPCConfVolumeName = "vineyard-rpc-conf"
+	dataLoadRPCConfMountPath  = "/etc/vineyard/rpc-conf"
+
+	envDataLoadRPCConfDir = "VINEYARD_RPC_CONF_DIR"
+)
+
+// generateDataLoadValueFile builds a DataLoadValue by extracted specifications from the given DataLoad, and
+// marshals the DataLoadValue to a temporary yaml file where stores values that'll be used by fluid dataloader helm chart
+func (e *VineyardEngine) generateDataLoadValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataLoad, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataLoad", object)
+		return "", err
+	}
+
+	if cdataload.HasTargetFilters(dataLoad) {
+		err = cdataload.NewTargetFiltersNotSupported(common.VineyardRuntime)
+		return "", err
+	}
+
+	targetDataset, err := utils.GetDataset(e.Client, dataLoad.Spec.Dataset.Name, dataLoad.Spec.Dataset.Namespace)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get dataset")
+	}
+
+	dataLoadValue, err := e.genDataLoadValue(targetDataset, dataLoad)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataLoadValue)
+	if err != nil {
+		return "", err
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-loader-values.yaml", dataLoad.Namespace, dataLoad.Name))
+	if err != nil {
+		return "", err
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return "", err
+	}
+	return valueFile.Name(), nil
+}
+
+func (e *VineyardEngine) genDataLoadValue(targetDataset *datav1alpha1.Dataset, dataLoad *datav1alpha1.DataLoad) (value *cdataload.DataLoadValue, err error) {
+	// the vineyard objects are loaded from the external files, so the target paths must be set explicitly
+	// with the uri of the files, e.g. s3://bucket/path or local:///path
+	if len(dataLoad.Spec.Target) == 0 {
+		return nil, fmt.Errorf("the target of DataLoad %s/%s must be set with the uri of the external files for %s",
+			dataLoad.Namespace, dataLoad.Name, common.VineyardRuntime)
+	}
+
+	dataLoadInfo := cdataload.DataLoadInfo{
+		BackoffLimit:     3,
+		TargetDataset:    dataLoad.Spec.Dataset.Name,
+		LoadMetadata:     dataLoad.Spec.LoadMetadata,
+		Image:            e.getLoaderImage(),
+		ImagePullSecrets: docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey),
+		Options:          dataLoad.Spec.Options,
+		Labels:           dataLoad.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataLoad.Annotations, dataLoad.Spec.PodMetadata.Annotations),
+		Policy:           string(dataLoad.Spec.Policy),
+		Schedule:         dataLoad.Spec.Schedule,
+		CronJobPolicy:    cdataload.GenCronJobPolicy(dataLoad),
+		Resources:        dataLoad.Spec.Resources,
+	}
+
+	if dataLoad.Spec.Affinity != nil {
+		dataLoadInfo.Affinity = dataLoad.Spec.Affinity
+	}
+
+	// inject the node affinity by previous operation pod.
+	dataLoadInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(e.Client, dataLoad.Spec.RunAfter, dataLoad.Namespace, dataLoadInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	if dataLoad.Spec.NodeSelector != nil {
+		dataLoadInfo.NodeSelector = dataLoad.Spec.NodeSelector
+	}
+
+	if len(dataLoad.Spec.Tolerations) > 0 {
+		dataLoadInfo.Tolerations = dataLoad.Spec.Tolerations
+	}
+
+	if len(dataLoad.Spec.SchedulerName) > 0 {
+		dataLoadInfo.SchedulerName = dataLoad.Spec.SchedulerName
+	}
+
+	targetPaths := []cdataload.TargetPath{}
+	for _, target := range dataLoad.Spec.Target {
+		if !strings.Contains(target.Path, "://") {
+			return nil, fmt.Errorf("the target path %q of DataLoad %s/%s is not a uri of the external files, e.g. s3://bucket/path",
+				target.Path, dataLoad.Namespace, dataLoad.Name)
+		}
+		targetPaths = append(targetPaths, cdataload.TargetPath{
+			Path:     target.Path,
+			Replicas: target.Replicas,
+		})
+	}
+	dataLoadInfo.TargetPaths = targetPaths
+
+	dataLoadInfo.Envs = []cdataload.Env{
+		{
+			Name:  envDataLoadRPCConfDir,
+			Value: dataLoadRPCConfMountPath,
+		},
+	}
+
+	// the rpc-conf configmap is created by the vineyard runtime, which has the same name as the dataset.
+	dataLoadInfo.Volumes = []corev1.Volume{
+		{
+			Name: dataLoadRPCConfVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: e.getRPCConfConfigMapName(),
+					},
+				},
+			},
+		},
+	}
+	dataLoadInfo.VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      dataLoadRPCConfVolumeName,
+			MountPath: dataLoadRPCConfMountPath,
+			ReadOnly:  true,
+		},
+	}
+
+	value = &cdataload.DataLoadValue{
+		Name:           dataLoad.Name,
+		OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+		DataLoadInfo:   dataLoadInfo,
+		Owner:          transformer.GenerateOwnerReferenceFromObject(dataLoad),
+	}
+
+	return value, nil
+}
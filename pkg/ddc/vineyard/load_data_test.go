@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Fluid Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vineyard
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestVineyardEngine_generateDataLoadValueFile(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "vineyard", Namespace: "fluid"},
+	}
+
+	tests := []struct {
+		name            string
+		spec            datav1alpha1.DataLoadSpec
+		wantErr         bool
+		wantTargetPaths []cdataload.TargetPath
+	}{
+		{
+			name: "load the external files",
+			spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "vineyard", Namespace: "fluid"},
+				Target:  []datav1alpha1.TargetPath{{Path: "s3://bucket/data"}, {Path: "local:///mnt/data/file"}},
+				Options: map[string]string{"endpoint_url": "http://minio:9000"},
+			},
+			wantTargetPaths: []cdataload.TargetPath{{Path: "s3://bucket/data"}, {Path: "local:///mnt/data/file"}},
+		},
+		{
+			name: "target is not set",
+			spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "vineyard", Namespace: "fluid"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "target is not a uri",
+			spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "vineyard", Namespace: "fluid"},
+				Target:  []datav1alpha1.TargetPath{{Path: "/data"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "target filters are not supported",
+			spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "vineyard", Namespace: "fluid"},
+				Target:  []datav1alpha1.TargetPath{{Path: "s3://bucket/data"}},
+				Exclude: []string{"*.tmp"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &VineyardEngine{
+				name:      "vineyard",
+				namespace: "fluid",
+				Client:    fake.NewFakeClientWithScheme(testScheme, dataset.DeepCopy()),
+				Log:       fake.NullLogger(),
+			}
+			dataLoad := &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "fluid"},
+				Spec:       tt.spec,
+			}
+
+			valueFileName, err := e.generateDataLoadValueFile(cruntime.ReconcileRequestContext{}, dataLoad)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("generateDataLoadValueFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			defer os.Remove(valueFileName)
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value cdataload.DataLoadValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+			info := value.DataLoadInfo
+			if info.Image != common.DefaultVineyardLoaderImage {
+				t.Errorf("image = %q, want %q", info.Image, common.DefaultVineyardLoaderImage)
+			}
+			if !reflect.DeepEqual(info.TargetPaths, tt.wantTargetPaths) {
+				t.Errorf("targetPaths = %v, want %v", info.TargetPaths, tt.wantTargetPaths)
+			}
+			if !reflect.DeepEqual(info.Options, tt.spec.Options) {
+				t.Errorf("options = %v, want %v", info.Options, tt.spec.Options)
+			}
+			if len(info.Volumes) != 1 || info.Volumes[0].ConfigMap == nil || info.Volumes[0].ConfigMap.Name != "vineyard-rpc-conf" {
+				t.Errorf("unexpected volumes %v", info.Volumes)
+			}
+		})
+	}
+}
+
+func TestVineyardEngine_CheckRuntimeReady(t *testing.T) {
+	tests := []struct {
+		name          string
+		readyReplicas int32
+		noWorker      bool
+		wantReady     bool
+	}{
+		{
+			name:          "worker is ready",
+			readyReplicas: 1,
+			wantReady:     true,
+		},
+		{
+			name:      "no worker is ready",
+			wantReady: false,
+		},
+		{
+			name:      "worker not found",
+			noWorker:  true,
+			wantReady: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewFakeClientWithScheme(testScheme)
+			if !tt.noWorker {
+				client = fake.NewFakeClientWithScheme(testScheme, &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "vineyard-worker", Namespace: "fluid"},
+					Status:     appsv1.StatefulSetStatus{ReadyReplicas: tt.readyReplicas},
+				})
+			}
+			e := &VineyardEngine{
+				name:      "vineyard",
+				namespace: "fluid",
+				Client:    client,
+				Log:       fake.NullLogger(),
+			}
+			if gotReady := e.CheckRuntimeReady(); gotReady != tt.wantReady {
+				t.Errorf("CheckRuntimeReady() = %v, want %v", gotReady, tt.wantReady)
+			}
+		})
+	}
+}
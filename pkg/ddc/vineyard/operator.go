@@ -25,6 +25,9 @@ func (e *VineyardEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequest
 	object := operation.GetOperationObject()
 
 	switch operationType {
+	case dataoperation.DataLoadType:
+		valueFileName, err = e.generateDataLoadValueFile(ctx, object)
+		return valueFileName, err
 	case dataoperation.DataProcessType:
 		valueFileName, err = e.generateDataProcessValueFile(ctx, object)
 		return valueFileName, err
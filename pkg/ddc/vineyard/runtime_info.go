@@ -15,11 +15,22 @@ package vineyard
 
 import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/testutil"
 )
 
+// CheckRuntimeReady checks if the runtime is ready to serve data operations, which requires at least one ready worker
 func (e *VineyardEngine) CheckRuntimeReady() (ready bool) {
-	//TODO implement me
+	workers, err := kubeclient.GetStatefulSet(e.Client, e.getWorkerName(), e.namespace)
+	if err != nil {
+		e.Log.Error(err, "Fail to get worker statefulset")
+		return false
+	}
+
+	if workers.Status.ReadyReplicas == 0 {
+		e.Log.Info("runtime not ready, no worker is ready", "worker", e.getWorkerName())
+		return false
+	}
 	return true
 }
 
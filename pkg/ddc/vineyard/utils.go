@@ -218,3 +218,19 @@ func (e *VineyardEngine) parseFuseImage(image string, tag string, imagePullPolic
 
 	return image, tag, imagePullPolicy
 }
+
+// getLoaderImage returns the image of the DataLoad job, which is the vineyard python image by default
+// and can be overridden by the environment variable.
+func (e *VineyardEngine) getLoaderImage() string {
+	image := docker.GetImageRepoFromEnv(common.VineyardLoaderImageEnv)
+	tag := docker.GetImageTagFromEnv(common.VineyardLoaderImageEnv)
+	if len(image) == 0 || len(tag) == 0 {
+		return common.DefaultVineyardLoaderImage
+	}
+	return image + ":" + tag
+}
+
+// getRPCConfConfigMapName returns the name of the configmap holding the rpc endpoints of the vineyard workers
+func (e *VineyardEngine) getRPCConfConfigMapName() string {
+	return e.name + "-rpc-conf"
+}
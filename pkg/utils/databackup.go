@@ -25,6 +25,7 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -42,6 +43,39 @@ func GetDataBackup(client client.Client, name, namespace string) (*datav1alpha1.
 	return &databackup, nil
 }
 
+// GetLatestCompletedDataBackup gets the DataBackup of the dataset completed most recently, nil is returned if there
+// is no completed DataBackup of the dataset.
+func GetLatestCompletedDataBackup(c client.Client, datasetName, namespace string) (latest *datav1alpha1.DataBackup, err error) {
+	var databackups datav1alpha1.DataBackupList
+	if err = c.List(context.TODO(), &databackups, client.InNamespace(namespace)); err != nil {
+		return
+	}
+
+	var latestCompletionTime metav1.Time
+	for i := range databackups.Items {
+		databackup := &databackups.Items[i]
+		if databackup.Spec.Dataset != datasetName || databackup.Status.Phase != common.PhaseComplete {
+			continue
+		}
+		completionTime := getDataBackupCompletionTime(databackup)
+		if latest == nil || latestCompletionTime.Before(&completionTime) {
+			latest, latestCompletionTime = databackup, completionTime
+		}
+	}
+	return
+}
+
+// getDataBackupCompletionTime returns the transition time of the Complete condition, the creation time is returned
+// if the condition is not found.
+func getDataBackupCompletionTime(databackup *datav1alpha1.DataBackup) metav1.Time {
+	for _, cond := range databackup.Status.Conditions {
+		if cond.Type == common.Complete {
+			return cond.LastTransitionTime
+		}
+	}
+	return databackup.CreationTimestamp
+}
+
 // GetAddressOfMaster return the ip and port of engine master
 func GetAddressOfMaster(pod *v1.Pod) (nodeName string, ip string, rpcPort int32) {
 	// TODO: Get address of master by calling runtime controller interface instead of reading pod object
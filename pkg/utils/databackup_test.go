@@ -18,8 +18,10 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -76,6 +78,60 @@ func TestGetDataBackup(t *testing.T) {
 
 }
 
+func TestGetLatestCompletedDataBackup(t *testing.T) {
+	newDataBackup := func(name, dataset string, phase common.Phase, completionTime time.Time) *datav1alpha1.DataBackup {
+		return &datav1alpha1.DataBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       datav1alpha1.DataBackupSpec{Dataset: dataset},
+			Status: datav1alpha1.OperationStatus{
+				Phase: phase,
+				Conditions: []datav1alpha1.Condition{
+					{Type: common.Complete, LastTransitionTime: metav1.NewTime(completionTime)},
+				},
+			},
+		}
+	}
+
+	now := time.Now()
+	s := runtime.NewScheme()
+	s.AddKnownTypes(datav1alpha1.GroupVersion, &datav1alpha1.DataBackup{}, &datav1alpha1.DataBackupList{})
+	fakeClient := fake.NewFakeClientWithScheme(s,
+		newDataBackup("old", "hbase", common.PhaseComplete, now.Add(-2*time.Hour)),
+		newDataBackup("latest", "hbase", common.PhaseComplete, now.Add(-time.Hour)),
+		newDataBackup("failed", "hbase", common.PhaseFailed, now),
+		newDataBackup("other-dataset", "spark", common.PhaseComplete, now),
+	)
+
+	testCases := map[string]struct {
+		dataset  string
+		wantName string
+	}{
+		"test get the latest completed DataBackup": {
+			dataset:  "hbase",
+			wantName: "latest",
+		},
+		"test no completed DataBackup of the dataset": {
+			dataset:  "hadoop",
+			wantName: "",
+		},
+	}
+
+	for k, item := range testCases {
+		got, err := GetLatestCompletedDataBackup(fakeClient, item.dataset, "default")
+		if err != nil {
+			t.Errorf("%s check failure, got err: %v", k, err)
+			continue
+		}
+		gotName := ""
+		if got != nil {
+			gotName = got.Name
+		}
+		if gotName != item.wantName {
+			t.Errorf("%s check failure, want DataBackup %q, got %q", k, item.wantName, gotName)
+		}
+	}
+}
+
 func TestGetAddressOfMaster(t *testing.T) {
 	mockNodeName := "idc1-host2"
 	mockIP := "129.23.1.3"
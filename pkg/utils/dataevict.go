@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetDataEvict gets the DataEvict given its name and namespace
+func GetDataEvict(client client.Client, name, namespace string) (*datav1alpha1.DataEvict, error) {
+	key := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+
+	var dataEvict datav1alpha1.DataEvict
+	if err := client.Get(context.TODO(), key, &dataEvict); err != nil {
+		return nil, err
+	}
+
+	return &dataEvict, nil
+}
+
+// GetDataEvictReleaseName returns the release name given the DataEvict's name.
+// DataEvict is executed in the runtime pods, so no helm release is installed with the name.
+func GetDataEvictReleaseName(name string) string {
+	return fmt.Sprintf("%s-evict", name)
+}
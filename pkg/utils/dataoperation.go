@@ -19,6 +19,7 @@ package utils
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
@@ -47,6 +48,68 @@ func ListDataOperationJobByCronjob(c client.Client, cronjobNamespacedName types.
 	return jobList.Items, nil
 }
 
+// MaxRecentRuns is the max number of the recent runs recorded in the status of the cron data operation
+const MaxRecentRuns = 10
+
+// UpdateRecentRuns records the runs of the cron data operation by its jobs into the operation status. The runs are
+// sorted from the newest to the oldest and truncated to MaxRecentRuns. The runs whose jobs have been cleaned up
+// by the history limits of the cronjob are kept until they are truncated.
+func UpdateRecentRuns(opStatus *datav1alpha1.OperationStatus, jobs []batchv1.Job) {
+	runs := make(map[string]datav1alpha1.OperationRun, len(opStatus.RecentRuns)+len(jobs))
+	for _, run := range opStatus.RecentRuns {
+		runs[run.JobName] = run
+	}
+	for _, job := range jobs {
+		runs[job.Name] = getOperationRunOfJob(job)
+	}
+
+	recentRuns := make([]datav1alpha1.OperationRun, 0, len(runs))
+	for _, run := range runs {
+		recentRuns = append(recentRuns, run)
+	}
+	sort.Slice(recentRuns, func(i, j int) bool {
+		if recentRuns[i].StartTime.Equal(recentRuns[j].StartTime) {
+			return recentRuns[i].JobName > recentRuns[j].JobName
+		}
+		return recentRuns[j].StartTime.Before(recentRuns[i].StartTime)
+	})
+	if len(recentRuns) > MaxRecentRuns {
+		recentRuns = recentRuns[:MaxRecentRuns]
+	}
+	opStatus.RecentRuns = recentRuns
+}
+
+func getOperationRunOfJob(job batchv1.Job) datav1alpha1.OperationRun {
+	startTime := job.CreationTimestamp.DeepCopy()
+	if job.Status.StartTime != nil {
+		startTime = job.Status.StartTime.DeepCopy()
+	}
+	run := datav1alpha1.OperationRun{
+		JobName:   job.Name,
+		Phase:     common.PhaseExecuting,
+		StartTime: startTime,
+	}
+
+	for _, condition := range job.Status.Conditions {
+		switch condition.Type {
+		case batchv1.JobComplete:
+			run.Phase = common.PhaseComplete
+		case batchv1.JobFailed:
+			run.Phase = common.PhaseFailed
+		default:
+			continue
+		}
+		completionTime := condition.LastTransitionTime.DeepCopy()
+		if job.Status.CompletionTime != nil {
+			completionTime = job.Status.CompletionTime.DeepCopy()
+		}
+		run.CompletionTime = completionTime
+		run.Duration = CalculateDuration(startTime.Time, completionTime.Time)
+		break
+	}
+	return run
+}
+
 func GetOperationStatus(obj client.Object) (*datav1alpha1.OperationStatus, error) {
 	if obj == nil {
 		return nil, nil
@@ -60,6 +123,8 @@ func GetOperationStatus(obj client.Object) (*datav1alpha1.OperationStatus, error
 		return dataBackup.Status.DeepCopy(), nil
 	} else if dataProcess, ok := obj.(*datav1alpha1.DataProcess); ok {
 		return dataProcess.Status.DeepCopy(), nil
+	} else if dataEvict, ok := obj.(*datav1alpha1.DataEvict); ok {
+		return dataEvict.Status.DeepCopy(), nil
 	}
 
 	return nil, fmt.Errorf("obj is not of any data operation type")
@@ -95,12 +160,28 @@ func GetPrecedingOperationStatus(client client.Client, opRef *datav1alpha1.Objec
 			return nil, err
 		}
 		return &object.Status, nil
+	case string(dataoperation.DataEvictType):
+		object, err := GetDataEvict(client, opRef.Name, opRefNamespace)
+		if err != nil {
+			return nil, err
+		}
+		return &object.Status, nil
 	default:
 		// TODO: Support non-builtin Kind
 		return nil, fmt.Errorf("kind %v is currently not supported for runAfter", opRef.Kind)
 	}
 }
 
+// IsDataOperationCancelRequested checks if the data operation is requested to be cancelled by the cancel annotation
+func IsDataOperationCancelRequested(obj client.Object) bool {
+	return obj.GetAnnotations()[common.AnnotationDataOperationCancel] == "true"
+}
+
+// IsDataOperationCancellable checks if the data operation in the phase can be cancelled, i.e. it hasn't finished yet
+func IsDataOperationCancellable(phase common.Phase) bool {
+	return phase == common.PhaseNone || phase == common.PhasePending || phase == common.PhaseExecuting
+}
+
 func NeedCleanUp(opStatus *datav1alpha1.OperationStatus, operation dataoperation.OperationInterface) bool {
 	if len(opStatus.Conditions) == 0 {
 		// data operation has no completion time, no need to clean up
@@ -119,8 +200,9 @@ func Timeleft(opStatus *datav1alpha1.OperationStatus, operation dataoperation.Op
 		// data operation has no completion time
 		return nil, nil
 	}
-	if opStatus.Conditions[0].Type != common.Complete && opStatus.Conditions[0].Type != common.Failed {
-		// only clean up complete or failed data operation
+	conditionType := opStatus.Conditions[0].Type
+	if conditionType != common.Complete && conditionType != common.Failed && conditionType != common.Cancelled {
+		// only clean up complete, failed or cancelled data operation
 		return nil, nil
 	}
 
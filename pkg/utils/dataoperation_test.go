@@ -17,11 +17,14 @@
 package utils
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
@@ -59,6 +62,23 @@ func TestTimeleft(t *testing.T) {
 			validRemaining: true,
 			wantErr:        false,
 		},
+		{
+			name: "get remaining time of cancelled data operation successfully",
+			dataload: datav1alpha1.DataLoad{
+				Status: datav1alpha1.OperationStatus{
+					Conditions: []datav1alpha1.Condition{
+						{
+							Type:               common.Cancelled,
+							LastProbeTime:      condtionTime,
+							LastTransitionTime: condtionTime,
+						},
+					},
+				},
+			},
+			operation:      dataoperation.BuildMockDataloadOperationReconcilerInterface(dataoperation.DataLoadType, &ttl),
+			validRemaining: true,
+			wantErr:        false,
+		},
 		{
 			name: "not set ttl",
 			dataload: datav1alpha1.DataLoad{
@@ -253,3 +273,111 @@ func TestGetParallelOperationWorkersName(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDataOperationCancelRequested(t *testing.T) {
+	testcases := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name: "no annotations",
+			want: false,
+		},
+		{
+			name:        "cancel annotation is true",
+			annotations: map[string]string{common.AnnotationDataOperationCancel: "true"},
+			want:        true,
+		},
+		{
+			name:        "cancel annotation is false",
+			annotations: map[string]string{common.AnnotationDataOperationCancel: "false"},
+			want:        false,
+		},
+	}
+	for _, test := range testcases {
+		dataLoad := &datav1alpha1.DataLoad{ObjectMeta: v1.ObjectMeta{Annotations: test.annotations}}
+		if got := IsDataOperationCancelRequested(dataLoad); got != test.want {
+			t.Errorf("%s: IsDataOperationCancelRequested() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestIsDataOperationCancellable(t *testing.T) {
+	testcases := map[common.Phase]bool{
+		common.PhaseNone:      true,
+		common.PhasePending:   true,
+		common.PhaseExecuting: true,
+		common.PhaseComplete:  false,
+		common.PhaseFailed:    false,
+		common.PhaseCancelled: false,
+	}
+	for phase, want := range testcases {
+		if got := IsDataOperationCancellable(phase); got != want {
+			t.Errorf("IsDataOperationCancellable(%q) = %v, want %v", phase, got, want)
+		}
+	}
+}
+
+func TestUpdateRecentRuns(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	newJob := func(name string, startTime time.Time, conditionType batchv1.JobConditionType) batchv1.Job {
+		job := batchv1.Job{
+			ObjectMeta: v1.ObjectMeta{Name: name, CreationTimestamp: v1.NewTime(startTime)},
+		}
+		if conditionType != "" {
+			job.Status.Conditions = []batchv1.JobCondition{
+				{Type: conditionType, Status: corev1.ConditionTrue, LastTransitionTime: v1.NewTime(startTime.Add(time.Minute))},
+			}
+		}
+		return job
+	}
+
+	opStatus := &datav1alpha1.OperationStatus{
+		RecentRuns: []datav1alpha1.OperationRun{
+			{JobName: "job-cleaned", Phase: common.PhaseComplete, StartTime: &v1.Time{Time: now.Add(-3 * time.Hour)}},
+			{JobName: "job-1", Phase: common.PhaseExecuting, StartTime: &v1.Time{Time: now.Add(-2 * time.Hour)}},
+		},
+	}
+	jobs := []batchv1.Job{
+		newJob("job-1", now.Add(-2*time.Hour), batchv1.JobFailed),
+		newJob("job-2", now.Add(-time.Hour), batchv1.JobComplete),
+		newJob("job-3", now, ""),
+	}
+	UpdateRecentRuns(opStatus, jobs)
+
+	wantRuns := []struct {
+		jobName  string
+		phase    common.Phase
+		duration string
+	}{
+		{jobName: "job-3", phase: common.PhaseExecuting},
+		{jobName: "job-2", phase: common.PhaseComplete, duration: "1m0s"},
+		{jobName: "job-1", phase: common.PhaseFailed, duration: "1m0s"},
+		{jobName: "job-cleaned", phase: common.PhaseComplete},
+	}
+	if len(opStatus.RecentRuns) != len(wantRuns) {
+		t.Fatalf("UpdateRecentRuns() got %d runs, want %d", len(opStatus.RecentRuns), len(wantRuns))
+	}
+	for i, want := range wantRuns {
+		run := opStatus.RecentRuns[i]
+		if run.JobName != want.jobName || run.Phase != want.phase || run.Duration != want.duration {
+			t.Errorf("UpdateRecentRuns() run %d = %v, want %v", i, run, want)
+		}
+		if want.phase == common.PhaseExecuting && run.CompletionTime != nil {
+			t.Errorf("UpdateRecentRuns() run %d of executing job has completion time %v", i, run.CompletionTime)
+		}
+	}
+
+	jobs = jobs[:0]
+	for i := 0; i < MaxRecentRuns+2; i++ {
+		jobs = append(jobs, newJob(fmt.Sprintf("job-new-%02d", i), now.Add(time.Duration(i+1)*time.Minute), batchv1.JobComplete))
+	}
+	UpdateRecentRuns(opStatus, jobs)
+	if len(opStatus.RecentRuns) != MaxRecentRuns {
+		t.Fatalf("UpdateRecentRuns() got %d runs, want %d", len(opStatus.RecentRuns), MaxRecentRuns)
+	}
+	if opStatus.RecentRuns[0].JobName != fmt.Sprintf("job-new-%02d", MaxRecentRuns+1) {
+		t.Errorf("UpdateRecentRuns() newest run = %s, want job-new-%02d", opStatus.RecentRuns[0].JobName, MaxRecentRuns+1)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
@@ -59,6 +60,14 @@ func CreatePersistentVolumeForRuntime(ctx context.Context,
 	}
 
 	if !found {
+		// The PVC is kept when the dataset is migrated from the runtime of another type, the PV must be bound to
+		// it by the UID, otherwise the PVC is left Lost
+		var claimUID types.UID
+		claimUID, err = getPersistentVolumeClaimUID(ctx, client, runtime.GetName(), runtime.GetNamespace())
+		if err != nil {
+			return err
+		}
+
 		pv := &corev1.PersistentVolume{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      pvName,
@@ -75,6 +84,7 @@ func CreatePersistentVolumeForRuntime(ctx context.Context,
 				ClaimRef: &corev1.ObjectReference{
 					Namespace: runtime.GetNamespace(),
 					Name:      runtime.GetName(),
+					UID:       claimUID,
 				},
 				AccessModes: accessModes,
 				Capacity: corev1.ResourceList{
@@ -214,6 +214,22 @@ var _ = Describe("Create Volume Tests", Label("pkg.utils.dataset.volume.create_t
 				Expect(pv.Spec.ClaimRef).NotTo(BeNil())
 				Expect(pv.Spec.ClaimRef.Name).To(Equal(runtimeInfo.GetName()))
 				Expect(pv.Spec.ClaimRef.Namespace).To(Equal(runtimeInfo.GetNamespace()))
+				Expect(pv.Spec.ClaimRef.UID).To(BeEmpty())
+			})
+		})
+
+		When("the pvc is kept by the runtime migration", func() {
+			BeforeEach(func() {
+				resources = append(resources, &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid", UID: "hbase-pvc-uid"},
+				})
+			})
+			It("should bind the pv to the pvc by uid", func() {
+				Expect(CreatePersistentVolumeForRuntime(context.Background(), client, runtimeInfo, "/mnt", "juicefs", log)).To(Succeed())
+				var list v1.PersistentVolumeList
+				Expect(client.List(context.TODO(), &list)).To(Succeed())
+				Expect(list.Items).To(HaveLen(1))
+				Expect(list.Items[0].Spec.ClaimRef.UID).To(BeEquivalentTo("hbase-pvc-uid"))
 			})
 		})
 
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// DeletePersistentVolumeForMigration deletes the PV of the runtime which the dataset is migrated from, and keeps
+// the PVC referenced by the applications. The PVC is bound to the PV created by the runtime migrated to again.
+// The caller must make sure no pods use the PVC.
+func DeletePersistentVolumeForMigration(ctx context.Context,
+	client client.Client,
+	runtime base.RuntimeInfoInterface,
+	log logr.Logger) (err error) {
+	pvName := runtime.GetPersistentVolumeName()
+
+	// the PV is still bound to the PVC, which blocks the deletion
+	err = kubeclient.RemovePersistentVolumeProtectionFinalizerWithContext(ctx, client, pvName)
+	if err != nil {
+		return utils.IgnoreNotFound(err)
+	}
+
+	return deleteFusePersistentVolumeIfExists(ctx, client, pvName, log)
+}
+
+// getPersistentVolumeClaimUID returns the UID of the PVC, it's empty if the PVC is not found.
+func getPersistentVolumeClaimUID(ctx context.Context, client client.Client, name, namespace string) (types.UID, error) {
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, pvc)
+	if err != nil {
+		return "", utils.IgnoreNotFound(err)
+	}
+
+	return pvc.UID, nil
+}
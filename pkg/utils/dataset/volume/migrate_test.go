@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Migrate Volume Tests", Label("pkg.utils.dataset.volume.migrate_test.go"), func() {
+	var (
+		scheme      *runtime.Scheme
+		clientObj   client.Client
+		runtimeInfo base.RuntimeInfoInterface
+		resources   []runtime.Object
+		log         logr.Logger
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		_ = v1.AddToScheme(scheme)
+		log = fake.NullLogger()
+
+		var err error
+		runtimeInfo, err = base.BuildRuntimeInfo("hadoop", "fluid", "alluxio")
+		Expect(err).To(BeNil())
+
+		resources = []runtime.Object{
+			&v1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "hadoop",
+					Namespace:   "fluid",
+					Annotations: common.GetExpectedFluidAnnotations(),
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		clientObj = fake.NewFakeClientWithScheme(scheme, resources...)
+	})
+
+	Context("Test DeletePersistentVolumeForMigration()", func() {
+		When("the PV is still bound to the PVC", func() {
+			BeforeEach(func() {
+				resources = append(resources, &v1.PersistentVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "fluid-hadoop",
+						Annotations: common.GetExpectedFluidAnnotations(),
+						Finalizers:  []string{"kubernetes.io/pv-protection"},
+					},
+				})
+			})
+			It("should delete the PV and keep the PVC", func() {
+				Expect(DeletePersistentVolumeForMigration(context.Background(), clientObj, runtimeInfo, log)).To(Succeed())
+				_, err := kubeclient.GetPersistentVolume(clientObj, "fluid-hadoop")
+				Expect(apierrs.IsNotFound(err)).To(BeTrue())
+				found, err := kubeclient.IsPersistentVolumeClaimExist(clientObj, "hadoop", "fluid", common.GetExpectedFluidAnnotations())
+				Expect(err).To(BeNil())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		When("the PV is already deleted", func() {
+			It("should succeed", func() {
+				Expect(DeletePersistentVolumeForMigration(context.Background(), clientObj, runtimeInfo, log)).To(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+const (
+	fuseHandOffVolumeName     = "fuse-handoff-socket"
+	fuseHandOffDirName        = "handoff"
+	fuseHandOffSocketFileName = "fuse.sock"
+)
+
+// IsFuseHotUpgrade returns true if the fuse pods are upgraded by handing off the fuse session
+func IsFuseHotUpgrade(strategy datav1alpha1.FuseUpgradeStrategy) bool {
+	return strategy.Type == datav1alpha1.HotUpgradeFuseUpgradeStrategyType
+}
+
+// GetFuseUpdateStrategy returns the update strategy of the fuse daemonset for the fuse upgrade strategy.
+// In HotUpgrade, the new fuse pod is started before the old one is deleted on each node, so that the new
+// fuse process is able to take over the fuse session. Otherwise, the fuse pod is only recreated when it's deleted.
+func GetFuseUpdateStrategy(strategy datav1alpha1.FuseUpgradeStrategy) appsv1.DaemonSetUpdateStrategy {
+	if !IsFuseHotUpgrade(strategy) {
+		return appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}
+	}
+
+	maxSurge := ptr.Deref(strategy.MaxSurge, intstr.FromInt32(1))
+	return appsv1.DaemonSetUpdateStrategy{
+		Type: appsv1.RollingUpdateDaemonSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+			MaxSurge:       &maxSurge,
+			MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+		},
+	}
+}
+
+// GetFuseHandOffDir returns the host dir of the hand-off socket, which is shared by the old and new fuse pods on a node
+func GetFuseHandOffDir(namespace, name, runtimeType string) string {
+	return fmt.Sprintf("%s/%s", GetRuntimeRootMetadataPath(namespace, name, runtimeType), fuseHandOffDirName)
+}
+
+// GetFuseHandOffSocketPath returns the path of the unix socket through which the fuse session is handed off
+func GetFuseHandOffSocketPath(namespace, name, runtimeType string) string {
+	return fmt.Sprintf("%s/%s", GetFuseHandOffDir(namespace, name, runtimeType), fuseHandOffSocketFileName)
+}
+
+// GetFuseHandOffVolumes returns the volume, volume mount and env to add into the fuse pod for handing off the fuse session
+func GetFuseHandOffVolumes(namespace, name, runtimeType string) (volume corev1.Volume, volumeMount corev1.VolumeMount, env corev1.EnvVar) {
+	dir := GetFuseHandOffDir(namespace, name, runtimeType)
+	volume = corev1.Volume{
+		Name: fuseHandOffVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: dir,
+				Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+			},
+		},
+	}
+	volumeMount = corev1.VolumeMount{
+		Name:      fuseHandOffVolumeName,
+		MountPath: dir,
+	}
+	env = corev1.EnvVar{
+		Name:  common.EnvFuseHandOffSocket,
+		Value: GetFuseHandOffSocketPath(namespace, name, runtimeType),
+	}
+	return
+}
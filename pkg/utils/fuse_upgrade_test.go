@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+var _ = Describe("Fuse upgrade", func() {
+	Describe("GetFuseUpdateStrategy", func() {
+		It("should not restart the fuse pods if the strategy is not set", func() {
+			strategy := GetFuseUpdateStrategy(datav1alpha1.FuseUpgradeStrategy{})
+			Expect(strategy.Type).To(Equal(appsv1.OnDeleteDaemonSetStrategyType))
+			Expect(strategy.RollingUpdate).To(BeNil())
+		})
+
+		It("should surge one node at a time by default in HotUpgrade", func() {
+			strategy := GetFuseUpdateStrategy(datav1alpha1.FuseUpgradeStrategy{
+				Type: datav1alpha1.HotUpgradeFuseUpgradeStrategyType,
+			})
+			Expect(strategy.Type).To(Equal(appsv1.RollingUpdateDaemonSetStrategyType))
+			Expect(*strategy.RollingUpdate.MaxSurge).To(Equal(intstr.FromInt32(1)))
+			Expect(*strategy.RollingUpdate.MaxUnavailable).To(Equal(intstr.FromInt32(0)))
+		})
+
+		It("should use the given maxSurge in HotUpgrade", func() {
+			strategy := GetFuseUpdateStrategy(datav1alpha1.FuseUpgradeStrategy{
+				Type:     datav1alpha1.HotUpgradeFuseUpgradeStrategyType,
+				MaxSurge: ptr.To(intstr.FromString("20%")),
+			})
+			Expect(*strategy.RollingUpdate.MaxSurge).To(Equal(intstr.FromString("20%")))
+		})
+	})
+
+	Describe("GetFuseHandOffVolumes", func() {
+		It("should share the socket dir on the host", func() {
+			volume, volumeMount, env := GetFuseHandOffVolumes("default", "demo", common.JuiceFSRuntime)
+			dir := GetRuntimeRootMetadataPath("default", "demo", common.JuiceFSRuntime) + "/handoff"
+
+			Expect(volume.HostPath).NotTo(BeNil())
+			Expect(volume.HostPath.Path).To(Equal(dir))
+			Expect(*volume.HostPath.Type).To(Equal(corev1.HostPathDirectoryOrCreate))
+			Expect(volumeMount.Name).To(Equal(volume.Name))
+			Expect(volumeMount.MountPath).To(Equal(dir))
+			Expect(env.Name).To(Equal(common.EnvFuseHandOffSocket))
+			Expect(env.Value).To(Equal(dir + "/fuse.sock"))
+		})
+	})
+})
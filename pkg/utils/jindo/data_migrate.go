@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindo
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+const (
+	// DefaultDataMigrateTimeout is the default timeout of the distributed copy in the DataMigrate job
+	DefaultDataMigrateTimeout = "30m"
+
+	// DefaultDistCpParallelism is the default number of the concurrent copy tasks of JindoDistCp in each pod
+	DefaultDistCpParallelism = "10"
+)
+
+// hadoopPropertyKeyRegexp matches the keys of Hadoop properties, the encrypt options of the external storage
+// are passed as the generic options `-D<key>=<value>` so their names must be property keys.
+var hadoopPropertyKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// GenerateDataMigrateValueFile builds a DataMigrateValue by extracted specifications from the given DataMigrate, and
+// marshals the DataMigrateValue to a temporary yaml file where stores values that'll be used by fluid datamigrate helm
+// chart. It's shared by the JindoFSx and JindoCache engines, the job runs the image of the workers of the engineImpl
+// and falls back to the defaultImage.
+func GenerateDataMigrateValueFile(r cruntime.ReconcileRequestContext, object client.Object, engineImpl, defaultImage string) (valueFileName string, err error) {
+	dataMigrate, ok := object.(*datav1alpha1.DataMigrate)
+	if !ok {
+		return "", fmt.Errorf("object %v is not a DataMigrate", object)
+	}
+
+	targetDataset, err := utils.GetTargetDatasetOfMigrate(r.Client, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+	r.Log.Info("target dataset", "dataset", targetDataset)
+
+	runtime, err := utils.GetJindoRuntime(r.Client, targetDataset.Name, targetDataset.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	imageName, imageTag := dataMigrate.Spec.Image, dataMigrate.Spec.ImageTag
+	if len(imageName) == 0 || len(imageTag) == 0 {
+		imageName, imageTag = getDataMigrateImage(r.Client, targetDataset, engineImpl, defaultImage, imageName, imageTag)
+	}
+	image := fmt.Sprintf("%s:%s", imageName, imageTag)
+
+	dataMigrateValue, err := genDataMigrateValue(r, image, engineImpl, runtime, targetDataset, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataMigrateValue)
+	if err != nil {
+		return
+	}
+	r.Log.Info("dataMigrate value", "value", string(data))
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-migrate-values.yaml", dataMigrate.Namespace, dataMigrate.Name))
+	if err != nil {
+		return
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return
+	}
+	return valueFile.Name(), nil
+}
+
+// getDataMigrateImage uses the image of the workers by default, as the DataMigrate job runs JindoDistCp of the Jindo SDK.
+func getDataMigrateImage(c client.Client, targetDataset *datav1alpha1.Dataset, engineImpl, defaultImage, imageName, imageTag string) (string, string) {
+	workerImageName, workerImageTag := docker.GetWorkerImage(c, targetDataset.Name, engineImpl, targetDataset.Namespace)
+	defaultImageInfo := strings.Split(defaultImage, ":")
+
+	if len(imageName) == 0 {
+		imageName = workerImageName
+	}
+	if len(imageName) == 0 {
+		imageName = docker.GetImageRepoFromEnv(common.JindoSmartDataImageEnv)
+	}
+	if len(imageName) == 0 {
+		imageName = defaultImageInfo[0]
+	}
+
+	if len(imageTag) == 0 {
+		imageTag = workerImageTag
+	}
+	if len(imageTag) == 0 {
+		imageTag = docker.GetImageTagFromEnv(common.JindoSmartDataImageEnv)
+	}
+	if len(imageTag) == 0 && len(defaultImageInfo) > 1 {
+		imageTag = defaultImageInfo[1]
+	}
+	return imageName, imageTag
+}
+
+// genDataMigrateValue builds a DataMigrateValue for the Fluid datamigrate Helm chart. The files to migrate are listed
+// and split across the launcher and the parallel workers when the parallelism is greater than 1, each of them copies
+// its files by JindoDistCp with concurrent copy tasks.
+func genDataMigrateValue(r cruntime.ReconcileRequestContext, image, engineImpl string, runtime *datav1alpha1.JindoRuntime,
+	targetDataset *datav1alpha1.Dataset, dataMigrate *datav1alpha1.DataMigrate) (*cdatamigrate.DataMigrateValue, error) {
+	imagePullSecrets := docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey)
+
+	dataMigrateInfo := cdatamigrate.DataMigrateInfo{
+		BackoffLimit:     3,
+		TargetDataset:    targetDataset.Name,
+		EncryptOptions:   []datav1alpha1.EncryptOption{},
+		Image:            image,
+		Options:          map[string]string{},
+		Labels:           dataMigrate.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataMigrate.Annotations, dataMigrate.Spec.PodMetadata.Annotations),
+		ImagePullSecrets: imagePullSecrets,
+		Policy:           string(dataMigrate.Spec.Policy),
+		Schedule:         dataMigrate.Spec.Schedule,
+		CronJobPolicy:    cdatamigrate.GenCronJobPolicy(dataMigrate),
+		Resources:        dataMigrate.Spec.Resources,
+		Parallelism:      dataMigrate.Spec.Parallelism,
+	}
+
+	// first set the affinity, below code will add another affinity terms.
+	if dataMigrate.Spec.Affinity != nil {
+		dataMigrateInfo.Affinity = dataMigrate.Spec.Affinity
+	}
+	// generate ssh config for parallel tasks when using parallel tasks
+	if dataMigrateInfo.Parallelism > 1 {
+		err := cdatamigrate.SetParallelMigrateOptions(&dataMigrateInfo, dataMigrate)
+		if err != nil {
+			r.Log.Error(err, "invalid parallelOptions")
+			return nil, err
+		}
+		// the launcher prefers to run on different host with the workers
+		cdatamigrate.AddWorkerPodPreferredAntiAffinity(&dataMigrateInfo, dataMigrate)
+	}
+
+	// inject the node affinity by previous operation pod.
+	var err error
+	dataMigrateInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(r.Client, dataMigrate.Spec.RunAfter, dataMigrate.Namespace, dataMigrateInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	if dataMigrate.Spec.NodeSelector != nil {
+		dataMigrateInfo.NodeSelector = dataMigrate.Spec.NodeSelector
+	}
+
+	if len(dataMigrate.Spec.Tolerations) > 0 {
+		dataMigrateInfo.Tolerations = dataMigrate.Spec.Tolerations
+	}
+
+	if len(dataMigrate.Spec.SchedulerName) > 0 {
+		dataMigrateInfo.SchedulerName = dataMigrate.Spec.SchedulerName
+	}
+
+	// set the options of JindoDistCp
+	options := map[string]string{}
+	for k, v := range dataMigrate.Spec.Options {
+		options[k] = v
+	}
+	timeout := options["timeout"]
+	delete(options, "timeout")
+	if timeout == "" {
+		timeout = DefaultDataMigrateTimeout
+	}
+	if _, found := options["parallelism"]; !found {
+		options["parallelism"] = DefaultDistCpParallelism
+	}
+	dataMigrateInfo.Options["option"] = genDistCpOptions(options)
+	dataMigrateInfo.Options["timeout"] = timeout
+	if runtime.Spec.HadoopConfig != "" {
+		dataMigrateInfo.Options["hdfsConfig"] = runtime.Spec.HadoopConfig
+	}
+
+	// set from & to
+	migrateFrom, err := genDataMigratePath(dataMigrate.Spec.From, engineImpl, targetDataset, &dataMigrateInfo)
+	if err != nil {
+		return nil, err
+	}
+	migrateTo, err := genDataMigratePath(dataMigrate.Spec.To, engineImpl, targetDataset, &dataMigrateInfo)
+	if err != nil {
+		return nil, err
+	}
+	dataMigrateInfo.MigrateFrom = migrateFrom
+	dataMigrateInfo.MigrateTo = migrateTo
+
+	dataMigrateValue := &cdatamigrate.DataMigrateValue{
+		Name:            dataMigrate.Name,
+		DataMigrateInfo: dataMigrateInfo,
+		Owner:           transformer.GenerateOwnerReferenceFromObject(dataMigrate),
+		OwnerDatasetId:  utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+	}
+	return dataMigrateValue, nil
+}
+
+// genDataMigratePath returns the uri of the data to migrate. The dataset is accessed by the jindo:// scheme, and
+// the secrets of the encrypt options of the external storage are exposed to the job by the environment variables
+// and passed to the Jindo SDK as the generic options.
+func genDataMigratePath(data datav1alpha1.DataToMigrate, engineImpl string, targetDataset *datav1alpha1.Dataset, info *cdatamigrate.DataMigrateInfo) (string, error) {
+	if data.DataSet != nil {
+		if data.DataSet.Name != targetDataset.Name || (len(data.DataSet.Namespace) > 0 && data.DataSet.Namespace != targetDataset.Namespace) {
+			return "", fmt.Errorf("%s can only migrate data between the dataset %s/%s and the external storage, but got dataset %s/%s",
+				engineImpl, targetDataset.Namespace, targetDataset.Name, data.DataSet.Namespace, data.DataSet.Name)
+		}
+		return "jindo://" + path.Join("/", data.DataSet.Path), nil
+	}
+
+	if data.ExternalStorage == nil {
+		return "", fmt.Errorf("either dataset or external storage should be set in the data to migrate")
+	}
+	if common.IsFluidNativeScheme(data.ExternalStorage.URI) {
+		return "", fmt.Errorf("%s doesn't support migrating data with the external storage %s", engineImpl, data.ExternalStorage.URI)
+	}
+	genericOptions := []string{}
+	for i, encryptOption := range data.ExternalStorage.EncryptOptions {
+		if !hadoopPropertyKeyRegexp.MatchString(encryptOption.Name) {
+			return "", fmt.Errorf("the name of encrypt option %q is not a valid hadoop property key", encryptOption.Name)
+		}
+		envName := fmt.Sprintf("EXTERNAL_ENCRYPT_OPTION_%d", i)
+		info.EncryptOptions = append(info.EncryptOptions, datav1alpha1.EncryptOption{
+			Name:      envName,
+			ValueFrom: encryptOption.ValueFrom,
+		})
+		genericOptions = append(genericOptions, fmt.Sprintf("-D%s=${%s}", encryptOption.Name, envName))
+	}
+	info.Options["genericOptions"] = strings.Join(genericOptions, " ")
+	return data.ExternalStorage.URI, nil
+}
+
+// genDistCpOptions converts the options to the flags of JindoDistCp in a stable order,
+// e.g. {"parallelism": "10", "update": ""} to "--parallelism 10 --update".
+func genDistCpOptions(options map[string]string) string {
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	flags := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if v := options[k]; v != "" {
+			flags = append(flags, fmt.Sprintf("--%s %s", k, v))
+		} else {
+			flags = append(flags, fmt.Sprintf("--%s", k))
+		}
+	}
+	return strings.Join(flags, " ")
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindo
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("GenerateDataMigrateValueFile", func() {
+	var (
+		dataset         *datav1alpha1.Dataset
+		jindoRuntime    *datav1alpha1.JindoRuntime
+		externalStorage *datav1alpha1.ExternalStorage
+	)
+
+	BeforeEach(func() {
+		dataset = &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid", UID: "dataset-uid"},
+		}
+		jindoRuntime = &datav1alpha1.JindoRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+			Spec:       datav1alpha1.JindoRuntimeSpec{HadoopConfig: "hdfs-conf"},
+		}
+		externalStorage = &datav1alpha1.ExternalStorage{
+			URI: "oss://bucket/result/",
+			EncryptOptions: []datav1alpha1.EncryptOption{
+				{
+					Name: "fs.oss.accessKeyId",
+					ValueFrom: datav1alpha1.EncryptOptionSource{
+						SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "oss-secret", Key: "ak"},
+					},
+				},
+			},
+		}
+	})
+
+	generate := func(spec datav1alpha1.DataMigrateSpec) (*cdatamigrate.DataMigrateValue, error) {
+		s := runtime.NewScheme()
+		_ = datav1alpha1.AddToScheme(s)
+		client := fake.NewFakeClientWithScheme(s, dataset, jindoRuntime)
+		dataMigrate := &datav1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "fluid"},
+			Spec:       spec,
+		}
+
+		ctx := cruntime.ReconcileRequestContext{Client: client, Log: fake.NullLogger()}
+		valueFileName, err := GenerateDataMigrateValueFile(ctx, dataMigrate, common.JindoCacheEngineImpl, "jindo:6.2.0")
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(valueFileName)
+		Expect(err).NotTo(HaveOccurred())
+		value := &cdatamigrate.DataMigrateValue{}
+		Expect(yaml.Unmarshal(data, value)).To(Succeed())
+		return value, nil
+	}
+
+	It("should migrate the dataset to the external storage with parallel tasks", func() {
+		value, err := generate(datav1alpha1.DataMigrateSpec{
+			From: datav1alpha1.DataToMigrate{
+				DataSet: &datav1alpha1.DatasetToMigrate{Name: "hbase", Namespace: "fluid", Path: "/data"},
+			},
+			To:              datav1alpha1.DataToMigrate{ExternalStorage: externalStorage},
+			Options:         map[string]string{"update": "", "parallelism": "20", "timeout": "1h"},
+			Parallelism:     3,
+			ParallelOptions: map[string]string{cdatamigrate.SSHSecretName: "ssh-secret", cdatamigrate.SSHPort: "2022"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		info := value.DataMigrateInfo
+		Expect(info.Image).To(Equal("jindo:6.2.0"))
+		Expect(info.MigrateFrom).To(Equal("jindo:///data"))
+		Expect(info.MigrateTo).To(Equal("oss://bucket/result/"))
+		Expect(info.Options).To(HaveKeyWithValue("option", "--parallelism 20 --update"))
+		Expect(info.Options).To(HaveKeyWithValue("timeout", "1h"))
+		Expect(info.Options).To(HaveKeyWithValue("genericOptions", "-Dfs.oss.accessKeyId=${EXTERNAL_ENCRYPT_OPTION_0}"))
+		Expect(info.Options).To(HaveKeyWithValue("hdfsConfig", "hdfs-conf"))
+		Expect(info.ParallelOptions.SSHPort).To(Equal(2022))
+		Expect(info.EncryptOptions).To(HaveLen(1))
+		Expect(info.EncryptOptions[0].Name).To(Equal("EXTERNAL_ENCRYPT_OPTION_0"))
+		Expect(info.EncryptOptions[0].ValueFrom.SecretKeyRef.Name).To(Equal("oss-secret"))
+		Expect(value.OwnerDatasetId).NotTo(BeEmpty())
+		Expect(value.Owner).NotTo(BeNil())
+	})
+
+	It("should migrate the external storage to the dataset with the default options", func() {
+		value, err := generate(datav1alpha1.DataMigrateSpec{
+			From: datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "oss://bucket/source"}},
+			To: datav1alpha1.DataToMigrate{
+				DataSet: &datav1alpha1.DatasetToMigrate{Name: "hbase", Namespace: "fluid"},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		info := value.DataMigrateInfo
+		Expect(info.MigrateFrom).To(Equal("oss://bucket/source"))
+		Expect(info.MigrateTo).To(Equal("jindo:///"))
+		Expect(info.Options).To(HaveKeyWithValue("option", "--parallelism "+DefaultDistCpParallelism))
+		Expect(info.Options).To(HaveKeyWithValue("timeout", DefaultDataMigrateTimeout))
+	})
+
+	DescribeTable("should reject the invalid DataMigrate",
+		func(spec datav1alpha1.DataMigrateSpec) {
+			_, err := generate(spec)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("invalid name of encrypt option", datav1alpha1.DataMigrateSpec{
+			From: datav1alpha1.DataToMigrate{
+				DataSet: &datav1alpha1.DatasetToMigrate{Name: "hbase", Namespace: "fluid"},
+			},
+			To: datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{
+				URI:            "oss://bucket/result",
+				EncryptOptions: []datav1alpha1.EncryptOption{{Name: "access key; rm -rf /"}},
+			}},
+		}),
+		Entry("fluid native external storage", datav1alpha1.DataMigrateSpec{
+			From: datav1alpha1.DataToMigrate{
+				DataSet: &datav1alpha1.DatasetToMigrate{Name: "hbase", Namespace: "fluid"},
+			},
+			To: datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "pvc://my-pvc/path"}},
+		}),
+		Entry("another dataset", datav1alpha1.DataMigrateSpec{
+			From: datav1alpha1.DataToMigrate{
+				DataSet: &datav1alpha1.DatasetToMigrate{Name: "other", Namespace: "fluid"},
+			},
+			To: datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "oss://bucket/result"}},
+		}),
+		Entry("invalid ssh port of parallel tasks", datav1alpha1.DataMigrateSpec{
+			From: datav1alpha1.DataToMigrate{
+				DataSet: &datav1alpha1.DatasetToMigrate{Name: "hbase", Namespace: "fluid"},
+			},
+			To:              datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "oss://bucket/result"}},
+			Parallelism:     2,
+			ParallelOptions: map[string]string{cdatamigrate.SSHSecretName: "ssh-secret", cdatamigrate.SSHPort: "port"},
+		}),
+	)
+})
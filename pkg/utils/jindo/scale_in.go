@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindo
+
+import (
+	"bufio"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	nodeStateDecommissioning = "DECOMMISSIONING"
+	nodeStateDecommissioned  = "DECOMMISSIONED"
+)
+
+// WorkerCommissioner decommissions and recommissions the workers by the master of Jindo
+type WorkerCommissioner interface {
+	DecommissionWorker(host string) error
+	RecommissionWorker(host string) error
+	// ReportNodes reports the details of the nodes registered in the master
+	ReportNodes() (report string, err error)
+}
+
+// DrainWorkers decommissions the workers and returns the number of drained workers. A worker is drained once the
+// master reports it's decommissioned, so that the cached blocks on it are not served any more.
+func DrainWorkers(commissioner WorkerCommissioner, workers []corev1.Pod) (drained int, err error) {
+	report, err := commissioner.ReportNodes()
+	if err != nil {
+		return
+	}
+	states := ParseNodeStates(report)
+
+	for _, worker := range workers {
+		host := worker.Status.HostIP
+		// the workers not scheduled yet have nothing to drain
+		if len(host) == 0 || states[host] == nodeStateDecommissioned {
+			drained++
+			continue
+		}
+		if states[host] == nodeStateDecommissioning {
+			continue
+		}
+		if decommissionErr := commissioner.DecommissionWorker(host); decommissionErr != nil {
+			err = decommissionErr
+		}
+	}
+	return drained, err
+}
+
+// RestoreWorkers recommissions the decommissioned workers so that the workers on the same nodes can serve again
+func RestoreWorkers(commissioner WorkerCommissioner, workers []corev1.Pod) (err error) {
+	for _, worker := range workers {
+		if len(worker.Status.HostIP) == 0 {
+			continue
+		}
+		if recommissionErr := commissioner.RecommissionWorker(worker.Status.HostIP); recommissionErr != nil {
+			err = recommissionErr
+		}
+	}
+	return
+}
+
+// ParseNodeStates parses the states of the nodes in the report of the master, which are keyed by the node hosts.
+// The report lists the nodes as below:
+//
+//	Node: 192.168.0.1:8101
+//		State: DECOMMISSIONING
+//		Used Disk Capacity: 1.00GB
+func ParseNodeStates(report string) map[string]string {
+	states := map[string]string{}
+	host := ""
+	scanner := bufio.NewScanner(strings.NewReader(report))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !found {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "Node":
+			host = value
+			if idx := strings.LastIndex(value, ":"); idx >= 0 {
+				host = value[:idx]
+			}
+		case "State":
+			if len(host) != 0 {
+				states[host] = strings.ToUpper(value)
+			}
+		}
+	}
+	return states
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindo
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeWorkerCommissioner struct {
+	report         string
+	err            error
+	decommissioned []string
+	recommissioned []string
+}
+
+func (c *fakeWorkerCommissioner) DecommissionWorker(host string) error {
+	c.decommissioned = append(c.decommissioned, host)
+	return c.err
+}
+
+func (c *fakeWorkerCommissioner) RecommissionWorker(host string) error {
+	c.recommissioned = append(c.recommissioned, host)
+	return c.err
+}
+
+func (c *fakeWorkerCommissioner) ReportNodes() (string, error) {
+	return c.report, nil
+}
+
+var _ = Describe("Jindo worker scale-in", func() {
+	const report = `Live Nodes: 3
+Decommission Nodes: 2
+Node: 192.168.0.1:8101
+	State: DECOMMISSIONED
+	Used Disk Capacity: 0B
+Node: 192.168.0.2:8101
+	State: DECOMMISSIONING
+	Used Disk Capacity: 1.00GB
+Node: 192.168.0.3:8101
+	State: LIVE
+	Used Disk Capacity: 1.00GB
+`
+	newWorker := func(name, hostIP string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     corev1.PodStatus{HostIP: hostIP},
+		}
+	}
+
+	var (
+		commissioner *fakeWorkerCommissioner
+		workers      []corev1.Pod
+	)
+
+	BeforeEach(func() {
+		commissioner = &fakeWorkerCommissioner{report: report}
+		workers = []corev1.Pod{
+			newWorker("worker-0", "192.168.0.1"),
+			newWorker("worker-1", "192.168.0.2"),
+			newWorker("worker-2", "192.168.0.3"),
+			newWorker("worker-3", ""),
+		}
+	})
+
+	It("should parse the states of the nodes", func() {
+		Expect(ParseNodeStates(report)).To(Equal(map[string]string{
+			"192.168.0.1": nodeStateDecommissioned,
+			"192.168.0.2": nodeStateDecommissioning,
+			"192.168.0.3": "LIVE",
+		}))
+	})
+
+	It("should count the workers as drained only after they're decommissioned", func() {
+		drained, err := DrainWorkers(commissioner, workers)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drained).To(Equal(2))
+		Expect(commissioner.decommissioned).To(ConsistOf("192.168.0.3"))
+	})
+
+	It("should return the error of decommissioning", func() {
+		commissioner.err = errors.New("failed to decommission")
+		drained, err := DrainWorkers(commissioner, workers)
+		Expect(err).To(HaveOccurred())
+		Expect(drained).To(Equal(2))
+	})
+
+	It("should recommission the scheduled workers", func() {
+		Expect(RestoreWorkers(commissioner, workers)).To(Succeed())
+		Expect(commissioner.recommissioned).To(ConsistOf("192.168.0.1", "192.168.0.2", "192.168.0.3"))
+	})
+})
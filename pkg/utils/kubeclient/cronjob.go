@@ -23,6 +23,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -48,3 +49,29 @@ func GetCronJobStatus(client client.Client, key types.NamespacedName) (*batchv1.
 		LastSuccessfulTime: cronjob.Status.LastSuccessfulTime,
 	}, nil
 }
+
+// UpdateCronJobSuspend suspends or resumes the CronJob given its namespace and name, it does nothing if the CronJob
+// is already in the expected state.
+func UpdateCronJobSuspend(client client.Client, key types.NamespacedName, suspend bool) error {
+	if compatibility.IsBatchV1CronJobSupported() {
+		var cronjob batchv1.CronJob
+		if err := client.Get(context.TODO(), key, &cronjob); err != nil {
+			return err
+		}
+		if ptr.Deref(cronjob.Spec.Suspend, false) == suspend {
+			return nil
+		}
+		cronjob.Spec.Suspend = ptr.To(suspend)
+		return client.Update(context.TODO(), &cronjob)
+	}
+
+	var cronjob batchv1beta1.CronJob
+	if err := client.Get(context.TODO(), key, &cronjob); err != nil {
+		return err
+	}
+	if ptr.Deref(cronjob.Spec.Suspend, false) == suspend {
+		return nil
+	}
+	cronjob.Spec.Suspend = ptr.To(suspend)
+	return client.Update(context.TODO(), &cronjob)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package kubeclient
 
 import (
+	"context"
 	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -28,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -107,3 +109,46 @@ var _ = Describe("GetCronJobStatus", func() {
 		})
 	})
 })
+
+var _ = Describe("UpdateCronJobSuspend", func() {
+	var (
+		client client.Client
+		patch  *gomonkey.Patches
+		key    types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		key = types.NamespacedName{Namespace: "default", Name: "test1"}
+		cronjob := &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		}
+		client = fake.NewFakeClientWithScheme(testScheme, cronjob)
+
+		patch = gomonkey.ApplyFunc(compatibility.IsBatchV1CronJobSupported, func() bool {
+			return true
+		})
+	})
+
+	AfterEach(func() {
+		patch.Reset()
+	})
+
+	It("should suspend and resume the CronJob", func() {
+		Expect(UpdateCronJobSuspend(client, key, true)).To(Succeed())
+
+		var cronjob batchv1.CronJob
+		Expect(client.Get(context.TODO(), key, &cronjob)).To(Succeed())
+		Expect(ptr.Deref(cronjob.Spec.Suspend, false)).To(BeTrue())
+
+		Expect(UpdateCronJobSuspend(client, key, false)).To(Succeed())
+
+		Expect(client.Get(context.TODO(), key, &cronjob)).To(Succeed())
+		Expect(ptr.Deref(cronjob.Spec.Suspend, false)).To(BeFalse())
+	})
+
+	It("should return an error when the CronJob does not exist", func() {
+		err := UpdateCronJobSuspend(client, types.NamespacedName{Namespace: "default", Name: "test-notexist"}, true)
+
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -81,6 +81,35 @@ func GetSucceedPodForJobWithContext(ctx context.Context, c client.Client, job *v
 	return nil, nil
 }
 
+// GetRunningPodForJob gets the first running pod for the job, if no running pod, return nil with no error.
+func GetRunningPodForJob(c client.Client, job *v1.Job) (*corev1.Pod, error) {
+	return GetRunningPodForJobWithContext(context.TODO(), c, job)
+}
+
+// GetRunningPodForJobWithContext gets the first running pod for the job, if no running pod, return nil with no error.
+func GetRunningPodForJobWithContext(ctx context.Context, c client.Client, job *v1.Job) (*corev1.Pod, error) {
+	var podList corev1.PodList
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error converting Job %s in namespace %s selector: %w", job.Name, job.Namespace, err)
+	}
+	err = c.List(ctx, &podList, &client.ListOptions{
+		Namespace:     job.Namespace,
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pods for Job %s in namespace %s: %w", job.Name, job.Namespace, err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodRunning {
+			return &pod, nil
+		}
+	}
+	// no running pod, return nil with no error.
+	return nil, nil
+}
+
 // GetFinishedJobCondition get the finished(succeed or failed) condition of the job
 func GetFinishedJobCondition(job *v1.Job) *v1.JobCondition {
 	// find the job final status condition. if job is resumed, the first condition type is 'Suspended'
@@ -144,6 +144,78 @@ var _ = Describe("Job related unit tests", Label("pkg.utils.kubeclient.job_test.
 		})
 	})
 
+	Describe("Test GetRunningPodForJob()", func() {
+		var jobPod *corev1.Pod
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-job",
+				Namespace: "test-ns",
+			},
+			Spec: batchv1.JobSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"job-name": "test-job",
+					},
+				},
+			},
+		}
+
+		BeforeEach(func() {
+			jobPod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-job-pod",
+					Namespace: "test-ns",
+					Labels: map[string]string{
+						"job-name": "test-job",
+					},
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+				},
+			}
+		})
+
+		When("job pod is running", func() {
+			BeforeEach(func() {
+				resources = []runtime.Object{job, jobPod}
+			})
+
+			It("should return the running pod successfully", func() {
+				gotPod, err := GetRunningPodForJob(client, job)
+				Expect(err).To(BeNil())
+				Expect(gotPod.Name).To(Equal(jobPod.Name))
+			})
+		})
+
+		When("job pod is not running", func() {
+			BeforeEach(func() {
+				jobPod.Status.Phase = corev1.PodPending
+				resources = []runtime.Object{job, jobPod}
+			})
+
+			It("should not return the pod", func() {
+				gotPod, err := GetRunningPodForJob(client, job)
+				Expect(err).To(BeNil())
+				Expect(gotPod).To(BeNil())
+			})
+		})
+
+		When("caller context is canceled", func() {
+			BeforeEach(func() {
+				resources = []runtime.Object{job, jobPod}
+			})
+
+			It("should return the context error", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				gotPod, err := GetRunningPodForJobWithContext(ctx, contextAwareClient{Client: client}, job)
+				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+				Expect(gotPod).To(BeNil())
+			})
+		})
+	})
+
 	Describe("Test UpdateJob()", func() {
 		job := &batchv1.Job{
 			ObjectMeta: metav1.ObjectMeta{
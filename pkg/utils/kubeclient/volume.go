@@ -32,6 +32,7 @@ import (
 
 const (
 	persistentVolumeClaimProtectionFinalizerName = "kubernetes.io/pvc-protection"
+	persistentVolumeProtectionFinalizerName      = "kubernetes.io/pv-protection"
 )
 
 var (
@@ -299,6 +300,29 @@ func RemoveProtectionFinalizerWithContext(ctx context.Context, client client.Cli
 	return err
 }
 
+// RemovePersistentVolumeProtectionFinalizerWithContext removes the finalizer "pv-protection" of the PersistentVolume,
+// so that a PersistentVolume still bound to its claim can be deleted.
+func RemovePersistentVolumeProtectionFinalizerWithContext(ctx context.Context, client client.Client, name string) (err error) {
+	pv, err := GetPersistentVolumeWithContext(ctx, client, name)
+	if err != nil {
+		return err
+	}
+
+	if !utils.ContainsString(pv.Finalizers, persistentVolumeProtectionFinalizerName) {
+		return nil
+	}
+
+	log.Info("Remove finalizer pv-protection", "name", name)
+	pv.SetFinalizers(utils.RemoveString(pv.Finalizers, persistentVolumeProtectionFinalizerName))
+	if err = client.Update(ctx, pv); err != nil {
+		log.Error(err, "Failed to remove finalizer",
+			"Finalizer", persistentVolumeProtectionFinalizerName)
+		return err
+	}
+
+	return err
+}
+
 // ShouldDeleteDataset return no err when no pod is using the volume
 // If cannot get PVC, cannot get PvcMountPods, or running pod is using the volume, return corresponding error
 func ShouldDeleteDataset(client client.Client, name, namespace string) (err error) {
@@ -678,6 +678,49 @@ var _ = Describe("Volume related unit tests", Label("pkg.utils.kubeclient.volume
 		})
 	})
 
+	Describe("Test RemovePersistentVolumeProtectionFinalizerWithContext()", func() {
+		var pv *v1.PersistentVolume
+
+		BeforeEach(func() {
+			pv = &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pv",
+					Finalizers: []string{
+						"kubernetes.io/pv-protection",
+						"another-finalizer",
+					},
+				},
+			}
+		})
+
+		Context("when persistent volume has protection finalizer", func() {
+			BeforeEach(func() {
+				resources = []runtime.Object{pv}
+			})
+
+			It("should remove the protection finalizer", func() {
+				err := RemovePersistentVolumeProtectionFinalizerWithContext(context.TODO(), client, pv.Name)
+				Expect(err).NotTo(HaveOccurred())
+
+				updatedPV := &v1.PersistentVolume{}
+				err = client.Get(context.TODO(), types.NamespacedName{Name: pv.Name}, updatedPV)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedPV.Finalizers).To(ConsistOf("another-finalizer"))
+			})
+		})
+
+		Context("when persistent volume does not exist", func() {
+			BeforeEach(func() {
+				resources = []runtime.Object{}
+			})
+
+			It("should return not found error", func() {
+				err := RemovePersistentVolumeProtectionFinalizerWithContext(context.TODO(), client, "non-existent-pv")
+				Expect(apierrs.IsNotFound(err)).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("Test ShouldDeleteDataset()", func() {
 		var (
 			namespace string
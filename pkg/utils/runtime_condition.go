@@ -89,7 +89,8 @@ func GetRuntimeCondition(conditions []data.RuntimeCondition,
 func isReadyTypeCondition(condition data.RuntimeCondition) bool {
 	return condition.Type == data.RuntimeMasterReady ||
 		condition.Type == data.RuntimeFusesReady ||
-		condition.Type == data.RuntimeWorkersReady
+		condition.Type == data.RuntimeWorkersReady ||
+		condition.Type == data.RuntimeProfileResolved
 }
 
 func isOnceActionTypeCondition(condition data.RuntimeCondition) bool {
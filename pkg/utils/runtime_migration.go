@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// GetRuntimeMigrationTarget returns the runtime type which the dataset is requested to migrate to,
+// it's empty if no migration is requested.
+func GetRuntimeMigrationTarget(dataset *datav1alpha1.Dataset) string {
+	if dataset == nil {
+		return ""
+	}
+	return dataset.GetAnnotations()[common.AnnotationRuntimeMigrationTarget]
+}
+
+// GetBoundRuntimeType returns the type of the runtime in the given category which the dataset is bound to,
+// it's empty if the dataset is not bound yet.
+func GetBoundRuntimeType(dataset *datav1alpha1.Dataset, category common.Category) string {
+	if dataset == nil {
+		return ""
+	}
+	_, runtime := GetRuntimeByCategory(dataset.Status.Runtimes, category)
+	if runtime == nil {
+		return ""
+	}
+	return runtime.Type
+}
+
+// IsMigratingFromRuntime checks if the dataset is requested to migrate from the runtime of the given type
+// to the runtime of another type.
+func IsMigratingFromRuntime(dataset *datav1alpha1.Dataset, runtimeType string) bool {
+	target := GetRuntimeMigrationTarget(dataset)
+	return len(target) > 0 && target != runtimeType
+}
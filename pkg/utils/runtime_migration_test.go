@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+var _ = Describe("Runtime migration", func() {
+	var dataset *datav1alpha1.Dataset
+
+	BeforeEach(func() {
+		dataset = &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Status: datav1alpha1.DatasetStatus{
+				Runtimes: []datav1alpha1.Runtime{
+					NewRuntime("demo", "default", common.AccelerateCategory, common.AlluxioRuntime, 1),
+				},
+			},
+		}
+	})
+
+	Describe("GetRuntimeMigrationTarget", func() {
+		It("should be empty if no migration is requested", func() {
+			Expect(GetRuntimeMigrationTarget(dataset)).To(BeEmpty())
+			Expect(GetRuntimeMigrationTarget(nil)).To(BeEmpty())
+		})
+
+		It("should return the runtime type in the annotation", func() {
+			dataset.Annotations = map[string]string{common.AnnotationRuntimeMigrationTarget: common.JuiceFSRuntime}
+			Expect(GetRuntimeMigrationTarget(dataset)).To(Equal(common.JuiceFSRuntime))
+		})
+	})
+
+	Describe("GetBoundRuntimeType", func() {
+		It("should return the type of the bound runtime", func() {
+			Expect(GetBoundRuntimeType(dataset, common.AccelerateCategory)).To(Equal(common.AlluxioRuntime))
+		})
+
+		It("should be empty if the dataset is not bound", func() {
+			dataset.Status.Runtimes = nil
+			Expect(GetBoundRuntimeType(dataset, common.AccelerateCategory)).To(BeEmpty())
+			Expect(GetBoundRuntimeType(nil, common.AccelerateCategory)).To(BeEmpty())
+		})
+	})
+
+	Describe("IsMigratingFromRuntime", func() {
+		It("should be false if no migration is requested", func() {
+			Expect(IsMigratingFromRuntime(dataset, common.AlluxioRuntime)).To(BeFalse())
+		})
+
+		It("should be true for the runtime type other than the target", func() {
+			dataset.Annotations = map[string]string{common.AnnotationRuntimeMigrationTarget: common.JuiceFSRuntime}
+			Expect(IsMigratingFromRuntime(dataset, common.AlluxioRuntime)).To(BeTrue())
+			Expect(IsMigratingFromRuntime(dataset, common.JuiceFSRuntime)).To(BeFalse())
+		})
+	})
+})
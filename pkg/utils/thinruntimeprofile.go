@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+var (
+	// ErrThinRuntimeProfileCycle means the inheritance chain of the ThinRuntimeProfile has a cycle
+	ErrThinRuntimeProfileCycle = errors.New("cycle in the inheritance chain of ThinRuntimeProfile")
+	// ErrParentThinRuntimeProfileNotFound means a parent in the inheritance chain of the ThinRuntimeProfile is not found
+	ErrParentThinRuntimeProfileNotFound = errors.New("parent ThinRuntimeProfile not found")
+	// ErrThinRuntimeProfileNoFileSystemType means neither the ThinRuntimeProfile nor its parents set the fileSystemType
+	ErrThinRuntimeProfileNoFileSystemType = errors.New("fileSystemType not set in ThinRuntimeProfile or its parents")
+)
+
+// GetResolvedThinRuntimeProfile gets the ThinRuntimeProfile with the given name, which is merged with all its parent profiles
+func GetResolvedThinRuntimeProfile(client client.Reader, name string) (*datav1alpha1.ThinRuntimeProfile, error) {
+	profile, err := GetThinRuntimeProfile(client, name)
+	if err != nil {
+		return nil, err
+	}
+	return ResolveThinRuntimeProfile(client, profile)
+}
+
+// ResolveThinRuntimeProfile merges the profile with all its parent profiles. The profile is merged into its parent with the
+// strategic merge semantic, so the fields not set in the profile are inherited from the parent, and the env, ports and
+// volume mounts of worker and fuse, as well as the volumes, are merged with the ones of the parent by their keys.
+// It fails if the fileSystemType is set by none of the profiles in the chain.
+func ResolveThinRuntimeProfile(client client.Reader, profile *datav1alpha1.ThinRuntimeProfile) (*datav1alpha1.ThinRuntimeProfile, error) {
+	chain := []*datav1alpha1.ThinRuntimeProfile{profile}
+	visited := map[string]bool{profile.Name: true}
+	for current := profile; len(current.Spec.ParentProfileName) != 0; {
+		parentName := current.Spec.ParentProfileName
+		if visited[parentName] {
+			return nil, fmt.Errorf("%w: %s inherits from %s again", ErrThinRuntimeProfileCycle, current.Name, parentName)
+		}
+		visited[parentName] = true
+
+		parent, err := GetThinRuntimeProfile(client, parentName)
+		if err != nil {
+			if apierrs.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: %s, the parent of %s", ErrParentThinRuntimeProfileNotFound, parentName, current.Name)
+			}
+			return nil, err
+		}
+		chain = append(chain, parent)
+		current = parent
+	}
+
+	resolved := profile.DeepCopy()
+	spec := chain[len(chain)-1].Spec
+	for i := len(chain) - 2; i >= 0; i-- {
+		merged, err := mergeThinRuntimeProfileSpec(spec, chain[i].Spec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge ThinRuntimeProfile %s into its parent: %w", chain[i].Name, err)
+		}
+		spec = merged
+	}
+	resolved.Spec = spec
+	resolved.Spec.ParentProfileName = profile.Spec.ParentProfileName
+	// the persistent volume of the runtime is created with the file system type
+	if len(resolved.Spec.FileSystemType) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrThinRuntimeProfileNoFileSystemType, profile.Name)
+	}
+	return resolved, nil
+}
+
+// mergeThinRuntimeProfileSpec merges the spec of the child profile into the one of its parent with the strategic merge semantic
+func mergeThinRuntimeProfileSpec(parent, child datav1alpha1.ThinRuntimeProfileSpec) (merged datav1alpha1.ThinRuntimeProfileSpec, err error) {
+	parentBytes, err := json.Marshal(parent)
+	if err != nil {
+		return
+	}
+	childBytes, err := json.Marshal(child)
+	if err != nil {
+		return
+	}
+
+	mergedBytes, err := strategicpatch.StrategicMergePatch(parentBytes, childBytes, datav1alpha1.ThinRuntimeProfileSpec{})
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(mergedBytes, &merged)
+	return
+}
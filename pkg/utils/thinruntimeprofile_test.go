@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("ResolveThinRuntimeProfile", func() {
+	var (
+		base  *datav1alpha1.ThinRuntimeProfile
+		child *datav1alpha1.ThinRuntimeProfile
+	)
+
+	newProfile := func(name, parent string) *datav1alpha1.ThinRuntimeProfile {
+		return &datav1alpha1.ThinRuntimeProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       datav1alpha1.ThinRuntimeProfileSpec{ParentProfileName: parent},
+		}
+	}
+
+	resolve := func(profiles ...*datav1alpha1.ThinRuntimeProfile) (*datav1alpha1.ThinRuntimeProfile, error) {
+		s := runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		objs := []runtime.Object{}
+		for _, profile := range profiles {
+			objs = append(objs, profile)
+		}
+		return GetResolvedThinRuntimeProfile(fake.NewFakeClientWithScheme(s, objs...), profiles[0].Name)
+	}
+
+	BeforeEach(func() {
+		base = newProfile("base", "")
+		base.Spec.FileSystemType = "fuse.base"
+		base.Spec.Volumes = []corev1.Volume{{Name: "config"}}
+		base.Spec.Fuse = datav1alpha1.ThinFuseSpec{
+			Image:    "base-fuse",
+			ImageTag: "v1",
+			Command:  []string{"/entrypoint.sh"},
+			Env: []corev1.EnvVar{
+				{Name: "LOG_LEVEL", Value: "info"},
+				{Name: "CACHE_DIR", Value: "/cache"},
+			},
+			Options: map[string]string{"ro": ""},
+		}
+
+		child = newProfile("child", "base")
+		child.Spec.Fuse = datav1alpha1.ThinFuseSpec{
+			Image: "child-fuse",
+			Env: []corev1.EnvVar{
+				{Name: "LOG_LEVEL", Value: "debug"},
+				{Name: "EXTRA", Value: "true"},
+			},
+			Options: map[string]string{"allow_other": ""},
+		}
+	})
+
+	It("should return the profile as is if it has no parent", func() {
+		resolved, err := resolve(base)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved.Spec).To(Equal(base.Spec))
+	})
+
+	It("should merge the profile into its parent", func() {
+		resolved, err := resolve(child, base)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved.Name).To(Equal("child"))
+		Expect(resolved.Spec.ParentProfileName).To(Equal("base"))
+		Expect(resolved.Spec.FileSystemType).To(Equal("fuse.base"))
+		Expect(resolved.Spec.Volumes).To(HaveLen(1))
+		Expect(resolved.Spec.Fuse.Image).To(Equal("child-fuse"))
+		Expect(resolved.Spec.Fuse.ImageTag).To(Equal("v1"))
+		Expect(resolved.Spec.Fuse.Command).To(Equal([]string{"/entrypoint.sh"}))
+		Expect(resolved.Spec.Fuse.Env).To(ConsistOf(
+			corev1.EnvVar{Name: "LOG_LEVEL", Value: "debug"},
+			corev1.EnvVar{Name: "CACHE_DIR", Value: "/cache"},
+			corev1.EnvVar{Name: "EXTRA", Value: "true"},
+		))
+		Expect(resolved.Spec.Fuse.Options).To(Equal(map[string]string{"ro": "", "allow_other": ""}))
+	})
+
+	It("should resolve the full chain", func() {
+		grandChild := newProfile("grand-child", "child")
+		grandChild.Spec.Fuse.ImageTag = "v2"
+
+		resolved, err := resolve(grandChild, child, base)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved.Spec.FileSystemType).To(Equal("fuse.base"))
+		Expect(resolved.Spec.Fuse.Image).To(Equal("child-fuse"))
+		Expect(resolved.Spec.Fuse.ImageTag).To(Equal("v2"))
+	})
+
+	It("should report the missing parent", func() {
+		_, err := resolve(child)
+		Expect(errors.Is(err, ErrParentThinRuntimeProfileNotFound)).To(BeTrue())
+	})
+
+	It("should report the file system type set by none of the profiles", func() {
+		base.Spec.FileSystemType = ""
+		_, err := resolve(child, base)
+		Expect(errors.Is(err, ErrThinRuntimeProfileNoFileSystemType)).To(BeTrue())
+	})
+
+	It("should report the cycle", func() {
+		base.Spec.ParentProfileName = "child"
+		_, err := resolve(child, base)
+		Expect(errors.Is(err, ErrThinRuntimeProfileCycle)).To(BeTrue())
+	})
+})
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// ValidateCacheRuntimeClass checks the completeness of the topology, the commands of the execution entries and
+// data operations, and the sanity of the component templates of the CacheRuntimeClass.
+func ValidateCacheRuntimeClass(runtimeClass *datav1alpha1.CacheRuntimeClass) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(runtimeClass.FileSystemType) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("fileSystemType"), ""))
+	}
+
+	configMaps, errs := validateExtraResources(runtimeClass.ExtraResources, field.NewPath("extraResources"))
+	allErrs = append(allErrs, errs...)
+
+	topologyPath := field.NewPath("topology")
+	topology := runtimeClass.Topology
+	if topology == nil || (topology.Master == nil && topology.Worker == nil && topology.Client == nil) {
+		allErrs = append(allErrs, field.Required(topologyPath, "at least one component should be defined"))
+	} else {
+		allErrs = append(allErrs, validateComponentDefinition(topology.Master, configMaps, topologyPath.Child("master"))...)
+		allErrs = append(allErrs, validateComponentDefinition(topology.Worker, configMaps, topologyPath.Child("worker"))...)
+		allErrs = append(allErrs, validateComponentDefinition(topology.Client, configMaps, topologyPath.Child("client"))...)
+	}
+
+	allErrs = append(allErrs, validateDataOperationSpecs(runtimeClass.DataOperationSpecs, field.NewPath("dataOperationSpecs"))...)
+	return allErrs
+}
+
+// ValidateCacheRuntimeClassUpdate validates the new CacheRuntimeClass, and checks the immutable fields are not changed
+func ValidateCacheRuntimeClassUpdate(newRuntimeClass, oldRuntimeClass *datav1alpha1.CacheRuntimeClass) field.ErrorList {
+	allErrs := ValidateCacheRuntimeClass(newRuntimeClass)
+	if newRuntimeClass.FileSystemType != oldRuntimeClass.FileSystemType {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("fileSystemType"), "field is immutable"))
+	}
+	return allErrs
+}
+
+// validateExtraResources validates the extra resources, and returns the names of the defined configmaps
+func validateExtraResources(extraResources datav1alpha1.RuntimeExtraResources, fldPath *field.Path) (sets.Set[string], field.ErrorList) {
+	allErrs := field.ErrorList{}
+	configMaps := sets.New[string]()
+	for i, cm := range extraResources.ConfigMaps {
+		idxPath := fldPath.Child("configMaps").Index(i)
+		if len(cm.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+			continue
+		}
+		if configMaps.Has(cm.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), cm.Name))
+		}
+		configMaps.Insert(cm.Name)
+	}
+	return configMaps, allErrs
+}
+
+func validateComponentDefinition(component *datav1alpha1.RuntimeComponentDefinition, configMaps sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if component == nil {
+		return allErrs
+	}
+
+	containersPath := fldPath.Child("template", "spec", "containers")
+	containers := component.Template.Spec.Containers
+	if len(containers) == 0 {
+		allErrs = append(allErrs, field.Required(containersPath, "at least one container should be defined"))
+	}
+	names := sets.New[string]()
+	for i, container := range containers {
+		idxPath := containersPath.Index(i)
+		if len(container.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else if names.Has(container.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), container.Name))
+		}
+		names.Insert(container.Name)
+
+		// the image of the first container can be set by the runtime version of the CacheRuntime
+		if i > 0 && len(container.Image) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("image"), ""))
+		}
+	}
+
+	if extraResources := component.Dependencies.ExtraResources; extraResources != nil {
+		for i, cm := range extraResources.ConfigMaps {
+			idxPath := fldPath.Child("dependencies", "extraResources", "configMaps").Index(i)
+			if !configMaps.Has(cm.Name) {
+				allErrs = append(allErrs, field.NotFound(idxPath.Child("name"), cm.Name))
+			}
+			if !filepath.IsAbs(cm.MountPath) || strings.Contains(cm.MountPath, ":") {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("mountPath"), cm.MountPath, "must be an absolute path without ':'"))
+			}
+		}
+	}
+
+	if entries := component.ExecutionEntries; entries != nil {
+		entriesPath := fldPath.Child("executionEntries")
+		allErrs = append(allErrs, validateExecutionEntry(entries.MountUFS, entriesPath.Child("mountUFS"))...)
+		allErrs = append(allErrs, validateExecutionEntry(entries.UnmountUFS, entriesPath.Child("unmountUFS"))...)
+		allErrs = append(allErrs, validateExecutionEntry(entries.SyncMetadata, entriesPath.Child("syncMetadata"))...)
+		allErrs = append(allErrs, validateExecutionEntry(entries.HealthCheck, entriesPath.Child("healthCheck"))...)
+		allErrs = append(allErrs, validateExecutionEntry(entries.ReportCapacity, entriesPath.Child("reportCapacity"))...)
+		allErrs = append(allErrs, validateExecutionEntry(entries.ReportSummary, entriesPath.Child("reportSummary"))...)
+	}
+	return allErrs
+}
+
+func validateExecutionEntry(entry *datav1alpha1.ExecutionCommonEntry, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if entry == nil {
+		return allErrs
+	}
+	if len(entry.Command) == 0 || len(entry.Command[0]) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("command"), ""))
+	}
+	if entry.TimeoutSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), entry.TimeoutSeconds, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+func validateDataOperationSpecs(specs []datav1alpha1.DataOperationSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+	for i, spec := range specs {
+		idxPath := fldPath.Index(i)
+		if names.Has(spec.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), spec.Name))
+		}
+		names.Insert(spec.Name)
+
+		if len(spec.Command) == 0 && len(spec.Args) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("command"), "either command or args should be set"))
+		}
+	}
+	return allErrs
+}
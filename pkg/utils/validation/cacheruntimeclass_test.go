@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+var _ = Describe("ValidateCacheRuntimeClass", func() {
+	var runtimeClass *datav1alpha1.CacheRuntimeClass
+
+	BeforeEach(func() {
+		runtimeClass = &datav1alpha1.CacheRuntimeClass{
+			ObjectMeta:     metav1.ObjectMeta{Name: "demo"},
+			FileSystemType: "demofs",
+			ExtraResources: datav1alpha1.RuntimeExtraResources{
+				ConfigMaps: []datav1alpha1.ConfigMapRuntimeExtraResource{{Name: "demo-config"}},
+			},
+			Topology: &datav1alpha1.RuntimeTopology{
+				Master: &datav1alpha1.RuntimeComponentDefinition{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "master", Image: "demo:v1"}},
+						},
+					},
+					Dependencies: datav1alpha1.RuntimeComponentDependencies{
+						ExtraResources: &datav1alpha1.ExtraResourcesComponentDependency{
+							ConfigMaps: []datav1alpha1.ConfigMapDependencyConfig{{Name: "demo-config", MountPath: "/etc/demo"}},
+						},
+					},
+					ExecutionEntries: &datav1alpha1.ExecutionEntries{
+						MountUFS: &datav1alpha1.ExecutionCommonEntry{Command: []string{"/mount.sh"}},
+					},
+				},
+			},
+			DataOperationSpecs: []datav1alpha1.DataOperationSpec{{Name: "DataLoad", Command: []string{"/load.sh"}}},
+		}
+	})
+
+	It("should accept a valid runtime class", func() {
+		Expect(ValidateCacheRuntimeClass(runtimeClass)).To(BeEmpty())
+	})
+
+	It("should reject the runtime class without any component", func() {
+		runtimeClass.Topology = &datav1alpha1.RuntimeTopology{}
+		errs := ValidateCacheRuntimeClass(runtimeClass)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Field).To(Equal("topology"))
+	})
+
+	It("should reject the component without containers", func() {
+		runtimeClass.Topology.Master.Template.Spec.Containers = nil
+		errs := ValidateCacheRuntimeClass(runtimeClass)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Field).To(Equal("topology.master.template.spec.containers"))
+	})
+
+	It("should reject the duplicated containers and the sidecar without image", func() {
+		runtimeClass.Topology.Master.Template.Spec.Containers = append(runtimeClass.Topology.Master.Template.Spec.Containers,
+			corev1.Container{Name: "master"})
+		errs := ValidateCacheRuntimeClass(runtimeClass)
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0].Field).To(Equal("topology.master.template.spec.containers[1].name"))
+		Expect(errs[1].Field).To(Equal("topology.master.template.spec.containers[1].image"))
+	})
+
+	It("should reject the execution entry without command", func() {
+		runtimeClass.Topology.Master.ExecutionEntries.HealthCheck = &datav1alpha1.ExecutionCommonEntry{TimeoutSeconds: -1}
+		errs := ValidateCacheRuntimeClass(runtimeClass)
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0].Field).To(Equal("topology.master.executionEntries.healthCheck.command"))
+		Expect(errs[1].Field).To(Equal("topology.master.executionEntries.healthCheck.timeout"))
+	})
+
+	It("should reject the configmap dependency not defined in extra resources", func() {
+		runtimeClass.Topology.Master.Dependencies.ExtraResources.ConfigMaps[0] = datav1alpha1.ConfigMapDependencyConfig{
+			Name: "other-config", MountPath: "etc:demo",
+		}
+		errs := ValidateCacheRuntimeClass(runtimeClass)
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0].Field).To(Equal("topology.master.dependencies.extraResources.configMaps[0].name"))
+		Expect(errs[1].Field).To(Equal("topology.master.dependencies.extraResources.configMaps[0].mountPath"))
+	})
+
+	It("should reject the data operation without command and args", func() {
+		runtimeClass.DataOperationSpecs = append(runtimeClass.DataOperationSpecs, datav1alpha1.DataOperationSpec{Name: "DataLoad"})
+		errs := ValidateCacheRuntimeClass(runtimeClass)
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0].Field).To(Equal("dataOperationSpecs[1].name"))
+		Expect(errs[1].Field).To(Equal("dataOperationSpecs[1].command"))
+	})
+
+	It("should reject changing the file system type", func() {
+		newRuntimeClass := runtimeClass.DeepCopy()
+		newRuntimeClass.FileSystemType = "otherfs"
+		errs := ValidateCacheRuntimeClassUpdate(newRuntimeClass, runtimeClass)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Field).To(Equal("fileSystemType"))
+	})
+})
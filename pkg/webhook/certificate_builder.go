@@ -30,6 +30,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -85,7 +86,7 @@ func (c *CertificateBuilder) genCA(ns, svc, certPath string) (*generator.Artifac
 	return certs, nil
 }
 
-// PatchCABundle patch the caBundle to MutatingWebhookConfiguration
+// PatchCABundle patch the caBundle to MutatingWebhookConfiguration and ValidatingWebhookConfiguration
 func (c *CertificateBuilder) PatchCABundle(webhookName string, ca []byte) error {
 
 	var m v1.MutatingWebhookConfiguration
@@ -116,5 +117,44 @@ func (c *CertificateBuilder) PatchCABundle(webhookName string, ca []byte) error
 
 	c.log.Info("finished patch MutatingWebhookConfiguration caBundle", "name", webhookName)
 
+	return c.patchValidatingCABundle(webhookName, ca)
+}
+
+// patchValidatingCABundle patch the caBundle to ValidatingWebhookConfiguration, it's skipped if the
+// ValidatingWebhookConfiguration is not installed
+func (c *CertificateBuilder) patchValidatingCABundle(webhookName string, ca []byte) error {
+
+	var m v1.ValidatingWebhookConfiguration
+
+	c.log.Info("start patch ValidatingWebhookConfiguration caBundle", "name", webhookName)
+
+	ctx := context.Background()
+
+	if err := c.Get(ctx, client.ObjectKey{Name: webhookName}, &m); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.log.Info("no ValidatingWebhookConfiguration found, skip patching", "name", webhookName)
+			return nil
+		}
+		c.log.Error(err, "fail to get validatingWebHook", "name", webhookName)
+		return err
+	}
+
+	current := m.DeepCopy()
+	for i := range m.Webhooks {
+		m.Webhooks[i].ClientConfig.CABundle = ca
+	}
+
+	if reflect.DeepEqual(m.Webhooks, current.Webhooks) {
+		c.log.Info("no need to patch the ValidatingWebhookConfiguration", "name", webhookName)
+		return nil
+	}
+
+	if err := c.Patch(ctx, &m, client.MergeFrom(current)); err != nil {
+		c.log.Error(err, "fail to patch CABundle to validatingWebHook", "name", webhookName)
+		return err
+	}
+
+	c.log.Info("finished patch ValidatingWebhookConfiguration caBundle", "name", webhookName)
+
 	return nil
 }
@@ -101,7 +101,24 @@ var _ = Describe("CertificateBuilder", func() {
 					{Name: "webhook3", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte{35, 5, 54, 4}}},
 				},
 			}
-			testScheme.AddKnownTypes(schema.GroupVersion{Group: "admissionregistration.k8s.io", Version: "v1"}, testMutatingWebhookConfiguration)
+			testScheme.AddKnownTypes(schema.GroupVersion{Group: "admissionregistration.k8s.io", Version: "v1"}, testMutatingWebhookConfiguration,
+				&admissionregistrationv1.ValidatingWebhookConfiguration{})
+		})
+
+		It("should patch CABundle for the ValidatingWebhookConfiguration with the same name", func() {
+			testValidatingWebhookConfiguration := &admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: mockWebhookName},
+				Webhooks: []admissionregistrationv1.ValidatingWebhook{
+					{Name: "webhook1", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte{3, 5, 54, 34}}},
+				},
+			}
+			client := fake.NewFakeClientWithScheme(testScheme, testMutatingWebhookConfiguration, testValidatingWebhookConfiguration)
+			cb := NewCertificateBuilder(client, log)
+			Expect(cb.PatchCABundle(mockWebhookName, []byte{1, 2, 3})).To(Succeed())
+
+			var vc admissionregistrationv1.ValidatingWebhookConfiguration
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: mockWebhookName}, &vc)).To(Succeed())
+			Expect(vc.Webhooks[0].ClientConfig.CABundle).To(Equal([]byte{1, 2, 3}))
 		})
 
 		testCases := map[string]struct {
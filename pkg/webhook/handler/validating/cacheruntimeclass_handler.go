@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/validation"
+)
+
+// CacheRuntimeClassValidatingHandler validates the CacheRuntimeClass on creating and updating, as it's read live by
+// all the CacheRuntimes of the class and a broken class breaks them all.
+type CacheRuntimeClassValidatingHandler struct {
+	Client client.Client
+	Reader client.Reader
+	// A decoder will be automatically injected
+	decoder *admission.Decoder
+}
+
+func (h *CacheRuntimeClassValidatingHandler) Setup(client client.Client, reader client.Reader, decoder *admission.Decoder) {
+	h.Client = client
+	h.Reader = reader
+	h.decoder = decoder
+}
+
+// Handle is the validating logic of CacheRuntimeClass
+func (h *CacheRuntimeClassValidatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	defer utils.TimeTrack(time.Now(), "CacheRuntimeClassValidatingHandler.Handle", "req.name", req.Name)
+
+	var setupLog = ctrl.Log.WithName("validate-cacheruntimeclass")
+	runtimeClass := &datav1alpha1.CacheRuntimeClass{}
+	if err := h.decoder.Decode(req, runtimeClass); err != nil {
+		setupLog.Error(err, "unable to decode CacheRuntimeClass from req")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var errs field.ErrorList
+	if req.Operation == admissionv1.Update {
+		oldRuntimeClass := &datav1alpha1.CacheRuntimeClass{}
+		if err := h.decoder.DecodeRaw(req.OldObject, oldRuntimeClass); err != nil {
+			setupLog.Error(err, "unable to decode the old CacheRuntimeClass from req")
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		errs = validation.ValidateCacheRuntimeClassUpdate(runtimeClass, oldRuntimeClass)
+	} else {
+		errs = validation.ValidateCacheRuntimeClass(runtimeClass)
+	}
+
+	if len(errs) > 0 {
+		setupLog.Info("deny the invalid CacheRuntimeClass", "name", runtimeClass.Name, "errors", errs.ToAggregate().Error())
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+var _ = Describe("CacheRuntimeClassValidatingHandler", func() {
+	var (
+		handler      *CacheRuntimeClassValidatingHandler
+		runtimeClass *datav1alpha1.CacheRuntimeClass
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(scheme)).To(Succeed())
+		handler = &CacheRuntimeClassValidatingHandler{}
+		handler.Setup(nil, nil, admission.NewDecoder(scheme))
+
+		runtimeClass = &datav1alpha1.CacheRuntimeClass{
+			TypeMeta:       metav1.TypeMeta{APIVersion: datav1alpha1.GroupVersion.String(), Kind: "CacheRuntimeClass"},
+			ObjectMeta:     metav1.ObjectMeta{Name: "demo"},
+			FileSystemType: "demofs",
+			Topology: &datav1alpha1.RuntimeTopology{
+				Worker: &datav1alpha1.RuntimeComponentDefinition{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "worker", Image: "demo:v1"}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	newRequest := func(operation admissionv1.Operation, obj, oldObj *datav1alpha1.CacheRuntimeClass) admission.Request {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: operation, Name: obj.Name}}
+		raw, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		req.Object = runtime.RawExtension{Raw: raw}
+		if oldObj != nil {
+			oldRaw, err := json.Marshal(oldObj)
+			Expect(err).NotTo(HaveOccurred())
+			req.OldObject = runtime.RawExtension{Raw: oldRaw}
+		}
+		return req
+	}
+
+	It("should be registered in the handler map", func() {
+		Expect(HandlerMap).To(HaveKeyWithValue(common.WebhookValidateCacheRuntimeClassPath, BeAssignableToTypeOf(&CacheRuntimeClassValidatingHandler{})))
+	})
+
+	It("should allow creating a valid runtime class", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, runtimeClass, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny creating a runtime class without any component", func() {
+		runtimeClass.Topology = nil
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, runtimeClass, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("topology"))
+	})
+
+	It("should deny updating a runtime class with a broken template", func() {
+		newRuntimeClass := runtimeClass.DeepCopy()
+		newRuntimeClass.Topology.Worker.Template.Spec.Containers[0].Name = ""
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, newRuntimeClass, runtimeClass))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("topology.worker.template.spec.containers[0].name"))
+	})
+
+	It("should deny changing the file system type", func() {
+		newRuntimeClass := runtimeClass.DeepCopy()
+		newRuntimeClass.FileSystemType = "otherfs"
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, newRuntimeClass, runtimeClass))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("fileSystemType"))
+	})
+
+	It("should return bad request if the object can not be decoded", func() {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: []byte("invalid")},
+		}}
+		resp := handler.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Code).To(BeEquivalentTo(400))
+	})
+})
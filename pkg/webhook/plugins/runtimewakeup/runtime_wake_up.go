@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimewakeup
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins/api"
+)
+
+const Name = "RuntimeWakeUp"
+
+var (
+	log = ctrl.Log.WithName(Name)
+)
+
+// RuntimeWakeUp holds the pods mounting the datasets of hibernated runtimes with a scheduling gate,
+// and requests the runtime controllers to wake up the runtimes. The gate is removed by the runtime controllers
+// once the runtimes are awake.
+type RuntimeWakeUp struct {
+	client client.Client
+	name   string
+}
+
+var _ api.MutatingHandler = &RuntimeWakeUp{}
+
+func NewPlugin(c client.Client, args string) (api.MutatingHandler, error) {
+	return &RuntimeWakeUp{
+		client: c,
+		name:   Name,
+	}, nil
+}
+
+func (p *RuntimeWakeUp) GetName() string {
+	return p.name
+}
+
+func (p *RuntimeWakeUp) Mutate(pod *corev1.Pod, runtimeInfos map[string]base.RuntimeInfoInterface) (shouldStop bool, err error) {
+	for pvcName, runtimeInfo := range runtimeInfos {
+		if runtimeInfo == nil || !supportHibernation(runtimeInfo.GetRuntimeType()) {
+			continue
+		}
+
+		status, err := base.GetDDCRuntimeStatus(p.client, runtimeInfo.GetRuntimeType(), runtimeInfo.GetName(), runtimeInfo.GetNamespace())
+		if err != nil {
+			log.Error(err, "failed to get the status of runtime, skip it", "pvc", pvcName)
+			continue
+		}
+		if !base.IsRuntimeHibernated(status) {
+			continue
+		}
+
+		log.Info("hold the pod until the hibernated runtime wakes up", "pod", pod.GetName(), "generateName", pod.GetGenerateName(),
+			"runtime", types.NamespacedName{Namespace: runtimeInfo.GetNamespace(), Name: runtimeInfo.GetName()})
+		addWakeUpSchedulingGate(pod)
+		// the runtime is woken up by the controller when it finds the gated pod even if the request fails
+		if err = p.requestWakeUp(runtimeInfo); err != nil {
+			log.Error(err, "failed to request waking up the runtime", "runtime",
+				types.NamespacedName{Namespace: runtimeInfo.GetNamespace(), Name: runtimeInfo.GetName()})
+		}
+	}
+
+	return false, nil
+}
+
+// requestWakeUp annotates the runtime with the request time to trigger the reconciliation of the runtime
+func (p *RuntimeWakeUp) requestWakeUp(runtimeInfo base.RuntimeInfoInterface) error {
+	runtime := newRuntimeObject(runtimeInfo.GetRuntimeType())
+	runtime.SetName(runtimeInfo.GetName())
+	runtime.SetNamespace(runtimeInfo.GetNamespace())
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				common.AnnotationWakeUpRequested: time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return p.client.Patch(context.TODO(), runtime, client.RawPatch(types.MergePatchType, patch))
+}
+
+func addWakeUpSchedulingGate(pod *corev1.Pod) {
+	for _, gate := range pod.Spec.SchedulingGates {
+		if gate.Name == common.SchedulingGateRuntimeWakeUp {
+			return
+		}
+	}
+	pod.Spec.SchedulingGates = append(pod.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: common.SchedulingGateRuntimeWakeUp})
+}
+
+// supportHibernation checks if the runtime type supports the hibernation policy
+func supportHibernation(runtimeType string) bool {
+	return newRuntimeObject(runtimeType) != nil
+}
+
+func newRuntimeObject(runtimeType string) client.Object {
+	switch runtimeType {
+	case common.AlluxioRuntime:
+		return &datav1alpha1.AlluxioRuntime{}
+	case common.JuiceFSRuntime:
+		return &datav1alpha1.JuiceFSRuntime{}
+	case common.ThinRuntime:
+		return &datav1alpha1.ThinRuntime{}
+	default:
+		return nil
+	}
+}
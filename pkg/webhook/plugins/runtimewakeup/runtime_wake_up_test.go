@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimewakeup
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("RuntimeWakeUp", func() {
+	const namespace = "fluid-test"
+
+	var (
+		alluxioRuntime *datav1alpha1.AlluxioRuntime
+		runtimeInfo    base.RuntimeInfoInterface
+		fakeClient     client.Client
+		plugin         *RuntimeWakeUp
+		pod            *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		alluxioRuntime = &datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: namespace},
+		}
+		pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace}}
+
+		var err error
+		runtimeInfo, err = base.BuildRuntimeInfo("hbase", namespace, common.AlluxioRuntime)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		s := runtime.NewScheme()
+		_ = datav1alpha1.AddToScheme(s)
+		fakeClient = fake.NewFakeClientWithScheme(s, alluxioRuntime)
+
+		handler, err := NewPlugin(fakeClient, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(handler.GetName()).To(Equal(Name))
+
+		var ok bool
+		plugin, ok = handler.(*RuntimeWakeUp)
+		Expect(ok).To(BeTrue())
+	})
+
+	getRuntime := func() *datav1alpha1.AlluxioRuntime {
+		got := &datav1alpha1.AlluxioRuntime{}
+		Expect(fakeClient.Get(context.TODO(), types.NamespacedName{Name: "hbase", Namespace: namespace}, got)).To(Succeed())
+		return got
+	}
+
+	Context("when the runtime is not hibernated", func() {
+		It("should not mutate the pod", func() {
+			shouldStop, err := plugin.Mutate(pod, map[string]base.RuntimeInfoInterface{"hbase": runtimeInfo})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shouldStop).To(BeFalse())
+			Expect(pod.Spec.SchedulingGates).To(BeEmpty())
+			Expect(getRuntime().Annotations).NotTo(HaveKey(common.AnnotationWakeUpRequested))
+		})
+	})
+
+	Context("when the runtime is hibernated", func() {
+		BeforeEach(func() {
+			alluxioRuntime.Status.Conditions = []datav1alpha1.RuntimeCondition{
+				utils.NewRuntimeCondition(datav1alpha1.RuntimeHibernated, datav1alpha1.RuntimeHibernatedReason, "", corev1.ConditionTrue),
+			}
+		})
+
+		It("should hold the pod and request waking up the runtime", func() {
+			shouldStop, err := plugin.Mutate(pod, map[string]base.RuntimeInfoInterface{"hbase": runtimeInfo})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shouldStop).To(BeFalse())
+			Expect(pod.Spec.SchedulingGates).To(ConsistOf(corev1.PodSchedulingGate{Name: common.SchedulingGateRuntimeWakeUp}))
+			Expect(getRuntime().Annotations).To(HaveKey(common.AnnotationWakeUpRequested))
+		})
+
+		It("should add the scheduling gate only once", func() {
+			pod.Spec.SchedulingGates = []corev1.PodSchedulingGate{{Name: common.SchedulingGateRuntimeWakeUp}}
+			_, err := plugin.Mutate(pod, map[string]base.RuntimeInfoInterface{"hbase": runtimeInfo})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pod.Spec.SchedulingGates).To(HaveLen(1))
+		})
+	})
+
+	It("should skip the runtimes not supporting hibernation", func() {
+		jindoRuntimeInfo, err := base.BuildRuntimeInfo("hbase", namespace, common.JindoRuntime)
+		Expect(err).NotTo(HaveOccurred())
+		shouldStop, err := plugin.Mutate(pod, map[string]base.RuntimeInfoInterface{"hbase": jindoRuntimeInfo})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shouldStop).To(BeFalse())
+		Expect(pod.Spec.SchedulingGates).To(BeEmpty())
+	})
+})